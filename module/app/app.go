@@ -623,6 +623,8 @@ func NewGravityApp(
 		gravity.NewAppModule(
 			gravityKeeper,
 			bankKeeper,
+			accountKeeper,
+			appCodec,
 		),
 		bech32ibc.NewAppModule(
 			appCodec,
@@ -688,6 +690,12 @@ func NewGravityApp(
 		evidence.NewAppModule(evidenceKeeper),
 		ibc.NewAppModule(&ibcKeeper),
 		ibcTransferModule,
+		gravity.NewAppModule(
+			gravityKeeper,
+			bankKeeper,
+			accountKeeper,
+			appCodec,
+		),
 	)
 	app.sm = &sm
 
@@ -11,6 +11,7 @@ import (
 	bech32ibckeeper "github.com/osmosis-labs/bech32-ibc/x/bech32ibc/keeper"
 
 	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/v2"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/v3"
 )
 
 // RegisterUpgradeHandlers registers handlers for all upgrades
@@ -35,4 +36,9 @@ func RegisterUpgradeHandlers(
 		v2.V2FixPlanName, // mercury2.0
 		v2.GetMercury2Dot0UpgradeHandler(),
 	)
+	// v2->v3 UPGRADE HANDLER SETUP
+	upgradeKeeper.SetUpgradeHandler(
+		v3.V2ToV3PlanName,
+		v3.GetV3UpgradeHandler(mm, configurator),
+	)
 }
@@ -0,0 +1,20 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// GetV3UpgradeHandler creates the handler for the v3 upgrade, which runs the gravity v2 to v3 store migration
+func GetV3UpgradeHandler(mm *module.Manager, configurator *module.Configurator) func(
+	ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap,
+) (module.VersionMap, error) {
+	if mm == nil || configurator == nil {
+		panic("Nil argument to GetV3UpgradeHandler")
+	}
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("v3 Upgrade: Running all configured module migrations (Should only see Gravity run)")
+		return mm.RunMigrations(ctx, *configurator, vmap)
+	}
+}
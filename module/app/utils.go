@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
 )
 
 // SetupSimulation creates the config, db (levelDB), temporary directory and logger for
@@ -94,6 +95,14 @@ func SimulationOperations(app Gravity, cdc codec.JSONCodec, config simtypes.Conf
 		}
 	}
 
+	// the staking simulation picks random commission rates which the MinCommissionDecorator rejects,
+	// so validator creation and edits are disabled unless the params file weights them
+	for _, op := range []string{stakingsim.OpWeightMsgCreateValidator, stakingsim.OpWeightMsgEditValidator} {
+		if _, ok := simState.AppParams[op]; !ok {
+			simState.AppParams[op] = json.RawMessage("0")
+		}
+	}
+
 	simState.ParamChanges = app.SimulationManager().GenerateParamChanges(config.Seed)
 	simState.Contents = app.SimulationManager().GetProposalContents(simState)
 	return app.SimulationManager().WeightedOperations(simState)
@@ -0,0 +1,186 @@
+// Package client is a Go SDK for bridge users: it sends tokens to Ethereum with a fee estimated from the
+// state of the pool, cancels transfers, follows a transfer until it is executed on Ethereum and resolves
+// Cosmos denoms and ERC20 contracts into each other
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// CosmosClient is the access to a Gravity Bridge node the client needs, orchestrator.GRPCCosmosClient
+// satisfies it
+type CosmosClient interface {
+	types.QueryClient
+	// BroadcastTx delivers msgs in a single transaction signed by the client's account and returns the
+	// response of the node. SendToEth finds the id of the transfer in the events of the response, which
+	// nodes only report in block mode
+	BroadcastTx(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+}
+
+// ErrTransferIDUnknown is returned by SendToEth along with the hash of the transaction when the node did not
+// report its events, as it does outside of block mode. The transfer was broadcast and is likely queued, so it
+// must not be sent again: look the transaction up by its hash instead
+var ErrTransferIDUnknown = errors.New("transfer broadcast, its id is not known yet")
+
+// Client sends and tracks the transfers of a single account
+type Client struct {
+	cosmos CosmosClient
+	sender sdk.AccAddress
+
+	mtx sync.Mutex
+	// owned holds the ids of the transfers this client queued or cancelled, for which leaving the pool
+	// without reaching the archive of executed batches proves they were removed
+	owned map[uint64]bool
+}
+
+// New returns a Client for the transfers of sender, which must be the account cosmos signs with
+func New(cosmos CosmosClient, sender sdk.AccAddress) *Client {
+	return &Client{cosmos: cosmos, sender: sender, owned: make(map[uint64]bool)}
+}
+
+// own records that txID is a transfer of the client's sender
+func (c *Client) own(txID uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.owned[txID] = true
+}
+
+// owns reports whether txID was queued or cancelled by this client
+func (c *Client) owns(txID uint64) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.owned[txID]
+}
+
+// GravityDenom returns the denom the vouchers of an Ethereum originated token are minted in on the chain,
+// gravity0x... with the checksummed contract address
+func GravityDenom(token gethcommon.Address) string {
+	ethAddress, err := types.NewEthAddress(token.Hex())
+	if err != nil {
+		// a go-ethereum address is always a valid EthAddress
+		panic(err)
+	}
+	return types.GravityDenom(*ethAddress)
+}
+
+// ERC20ToDenom returns the Cosmos denom an ERC20 is bridged to, and whether the token originates from Cosmos
+func (c *Client) ERC20ToDenom(ctx context.Context, token gethcommon.Address) (string, bool, error) {
+	res, err := c.cosmos.ERC20ToDenom(ctx, &types.QueryERC20ToDenomRequest{Erc20: token.Hex()})
+	if err != nil {
+		return "", false, err
+	}
+	return res.Denom, res.CosmosOriginated, nil
+}
+
+// DenomToERC20 returns the ERC20 a Cosmos denom is bridged to, and whether the token originates from Cosmos
+func (c *Client) DenomToERC20(ctx context.Context, denom string) (gethcommon.Address, bool, error) {
+	res, err := c.cosmos.DenomToERC20(ctx, &types.QueryDenomToERC20Request{Denom: denom})
+	if err != nil {
+		return gethcommon.Address{}, false, err
+	}
+	return gethcommon.HexToAddress(res.Erc20), res.CosmosOriginated, nil
+}
+
+// FeeEstimate is what a transfer to Ethereum costs on top of the amount sent
+type FeeEstimate struct {
+	// BridgeFee is the suggested fee for the relayer, in the denom of the transfer. It matches the average
+	// fee of the transfers waiting for a batch of the same token after the share taken by the chain
+	BridgeFee sdk.Coin
+	// ChainFee is charged by the chain on every transfer, see Params.ChainFee
+	ChainFee sdk.Coins
+	// MinAmount is the smallest amount of the token which may be sent, see Params.MinTransferAmounts
+	MinAmount sdk.Int
+}
+
+// EstimateFees estimates the fees of sending amount to Ethereum
+func (c *Client) EstimateFees(ctx context.Context, amount sdk.Coin) (FeeEstimate, error) {
+	token, _, err := c.DenomToERC20(ctx, amount.Denom)
+	if err != nil {
+		return FeeEstimate{}, fmt.Errorf("resolving %s: %w", amount.Denom, err)
+	}
+	ethAddress, err := types.NewEthAddress(token.Hex())
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	params, err := c.cosmos.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	fees, err := c.cosmos.BatchFees(ctx, &types.QueryBatchFeeRequest{})
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	relayerFee := sdk.ZeroInt()
+	for _, f := range fees.BatchFees {
+		if f.TxCount > 0 && gethcommon.HexToAddress(f.Token) == token {
+			relayerFee = f.TotalFees.QuoRaw(int64(f.TxCount))
+		}
+	}
+	// the pool holds fees after Params.BridgeFeeShare was taken, scale back up to what the sender pays
+	bridgeFee := relayerFee
+	if share := params.Params.BridgeFeeShare; !share.IsNil() && share.IsPositive() && share.LT(sdk.OneDec()) {
+		bridgeFee = relayerFee.ToDec().Quo(sdk.OneDec().Sub(share)).Ceil().TruncateInt()
+	}
+	return FeeEstimate{
+		BridgeFee: sdk.NewCoin(amount.Denom, bridgeFee),
+		ChainFee:  params.Params.ChainFee.Compute(amount),
+		MinAmount: params.Params.MinTransferAmount(*ethAddress),
+	}, nil
+}
+
+// SendToEth sends amount to dest on Ethereum and returns the id of the transfer and the hash of the
+// transaction. A nil bridgeFee is replaced by the estimate of EstimateFees. When the node does not report the
+// events of the transaction the hash comes with ErrTransferIDUnknown
+func (c *Client) SendToEth(ctx context.Context, dest gethcommon.Address, amount sdk.Coin, bridgeFee *sdk.Coin) (uint64, string, error) {
+	if bridgeFee == nil {
+		estimate, err := c.EstimateFees(ctx, amount)
+		if err != nil {
+			return 0, "", fmt.Errorf("estimating fees: %w", err)
+		}
+		if amount.Amount.LT(estimate.MinAmount) {
+			return 0, "", fmt.Errorf("%w: amount %s is below the minimum of %s", types.ErrTransferTooSmall, amount.Amount, estimate.MinAmount)
+		}
+		bridgeFee = &estimate.BridgeFee
+	}
+	res, err := c.cosmos.BroadcastTx(ctx, &types.MsgSendToEth{
+		Sender:    c.sender.String(),
+		EthDest:   dest.Hex(),
+		Amount:    amount,
+		BridgeFee: *bridgeFee,
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	for _, event := range res.Events {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if queued, ok := msg.(*types.EventTransferQueued); ok {
+			c.own(queued.TxId)
+			return queued.TxId, res.TxHash, nil
+		}
+	}
+	return 0, res.TxHash, fmt.Errorf("%w: transaction %s", ErrTransferIDUnknown, res.TxHash)
+}
+
+// CancelSendToEth cancels a transfer which has not been batched yet and refunds the amount and fee
+func (c *Client) CancelSendToEth(ctx context.Context, txID uint64) error {
+	res, err := c.cosmos.BroadcastTx(ctx, &types.MsgCancelSendToEth{TransactionId: txID, Sender: c.sender.String()})
+	if err != nil {
+		return err
+	}
+	if ApplyEvents(TransferState{TxID: txID}, res.Events).Status == TransferRemoved {
+		c.own(txID)
+	}
+	return nil
+}
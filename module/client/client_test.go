@@ -0,0 +1,141 @@
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/client"
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/orchestrator"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/ethsim"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+var (
+	_ client.CosmosClient = &orchestrator.GRPCCosmosClient{}
+	_ client.CosmosClient = ethsim.CosmosClient{}
+)
+
+const syncModeHash = "A1B2C3"
+
+// syncModeClient answers broadcasts like a node outside of block mode, without the events of the transaction
+type syncModeClient struct {
+	ethsim.CosmosClient
+}
+
+func (c syncModeClient) BroadcastTx(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if _, err := c.CosmosClient.BroadcastTx(ctx, msgs...); err != nil {
+		return nil, err
+	}
+	return &sdk.TxResponse{TxHash: syncModeHash}, nil
+}
+
+//nolint: exhaustivestruct
+func TestTransferLifecycle(t *testing.T) {
+	b := ethsim.NewBridge(t)
+	k := b.Input.GravityKeeper
+	b.StepUntil(5, func() bool {
+		return k.GetLastObservedEventNonce(b.Context()) == 2
+	})
+
+	// bring a token over so the sender holds vouchers
+	var (
+		user   = b.EthUsers[0]
+		sender = keeper.AccAddrs[0]
+		dest   = crypto.PubkeyToAddress(b.EthUsers[1].PublicKey)
+		ctx    = context.Background()
+	)
+	token, err := b.Eth.DeployERC20("Simulated Token", "SIM", 6)
+	require.NoError(t, err)
+	require.NoError(t, b.Eth.Mint(token, crypto.PubkeyToAddress(user.PublicKey), big.NewInt(1000)))
+	require.NoError(t, b.Deposit(user, token, sender, big.NewInt(1000)))
+	denom := client.GravityDenom(token)
+	b.StepUntil(5, func() bool {
+		return b.Input.BankKeeper.GetBalance(b.Context(), sender, denom).Amount.IsPositive()
+	})
+
+	cosmos := ethsim.NewCosmosClient(&b.Input)
+	c := client.New(cosmos, sender)
+
+	// denoms resolve both ways
+	resolved, cosmosOriginated, err := c.ERC20ToDenom(ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, denom, resolved)
+	require.False(t, cosmosOriginated)
+	erc20, _, err := c.DenomToERC20(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, token, erc20)
+
+	// a cancelled transfer is refunded
+	txID, _, err := c.SendToEth(ctx, dest, sdk.NewCoin(denom, sdk.NewInt(100)), &sdk.Coin{Denom: denom, Amount: sdk.NewInt(20)})
+	require.NoError(t, err)
+	state, err := c.TransferState(ctx, txID)
+	require.NoError(t, err)
+	require.Equal(t, client.TransferQueued, state.Status)
+	require.NoError(t, c.CancelSendToEth(ctx, txID))
+	state, err = c.TransferState(ctx, txID)
+	require.NoError(t, err)
+	require.Equal(t, client.TransferRemoved, state.Status)
+	require.Equal(t, sdk.NewInt(1000), b.Input.BankKeeper.GetBalance(b.Context(), sender, denom).Amount)
+
+	// without evidence of a cancel, a transfer which is not pending nor executed is unknown
+	state, err = client.New(cosmos, sender).TransferState(ctx, txID)
+	require.NoError(t, err)
+	require.Equal(t, client.TransferUnknown, state.Status)
+	state, err = c.TransferState(ctx, 1000)
+	require.NoError(t, err)
+	require.Equal(t, client.TransferUnknown, state.Status)
+
+	// a node which does not report the events of the transaction still gets the transfer broadcast once
+	_, hash, err := client.New(syncModeClient{cosmos}, sender).SendToEth(ctx, dest, sdk.NewCoin(denom, sdk.NewInt(100)), &sdk.Coin{Denom: denom, Amount: sdk.NewInt(20)})
+	require.ErrorIs(t, err, client.ErrTransferIDUnknown)
+	require.Equal(t, syncModeHash, hash)
+	pending, err := cosmos.GetPendingSendToEth(ctx, &types.QueryPendingSendToEth{SenderAddress: sender.String()})
+	require.NoError(t, err)
+	require.Len(t, pending.UnbatchedTransfers, 1)
+	_, err = cosmos.BroadcastTx(ctx, &types.MsgCancelSendToEth{TransactionId: pending.UnbatchedTransfers[0].Id, Sender: sender.String()})
+	require.NoError(t, err)
+
+	// without a fee the estimate follows the fees already waiting in the pool
+	_, _, err = c.SendToEth(ctx, dest, sdk.NewCoin(denom, sdk.NewInt(100)), &sdk.Coin{Denom: denom, Amount: sdk.NewInt(30)})
+	require.NoError(t, err)
+	estimate, err := c.EstimateFees(ctx, sdk.NewCoin(denom, sdk.NewInt(100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(denom, sdk.NewInt(30)), estimate.BridgeFee)
+	txID, _, err = c.SendToEth(ctx, dest, sdk.NewCoin(denom, sdk.NewInt(200)), nil)
+	require.NoError(t, err)
+
+	// the events of the batch request move the transfer along, as do the queries
+	res, err := cosmos.BroadcastTx(ctx, &types.MsgRequestBatch{Sender: keeper.OrchAddrs[0].String(), Denom: denom})
+	require.NoError(t, err)
+	state = client.ApplyEvents(client.TransferState{TxID: txID, Status: client.TransferQueued}, res.Events)
+	require.Equal(t, client.TransferBatched, state.Status)
+	queried, err := c.TransferState(ctx, txID)
+	require.NoError(t, err)
+	require.Equal(t, state, queried)
+
+	b.StepUntil(5, func() bool {
+		state, err := c.TransferState(ctx, txID)
+		require.NoError(t, err)
+		return state.Status == client.TransferExecuted
+	})
+	balance, err := b.Eth.ERC20Balance(token, dest)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(300), balance)
+
+	// watching a finished transfer reports its final state and returns
+	var seen []client.TransferState
+	require.NoError(t, c.WatchTransfer(ctx, txID, time.Millisecond, func(s client.TransferState) {
+		seen = append(seen, s)
+	}))
+	require.Len(t, seen, 1)
+	require.Equal(t, client.TransferExecuted, seen[0].Status)
+	require.Equal(t, queried.BatchNonce, seen[0].BatchNonce)
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// TransferStatus is a step in the lifecycle of a transfer to Ethereum
+type TransferStatus int
+
+const (
+	// TransferUnknown is the status of a transfer nothing is known about: it is not in the block yet, belongs
+	// to another sender, was mistyped, or left the pool without this client seeing it go
+	TransferUnknown TransferStatus = iota
+	// TransferQueued transfers wait in the pool for a batch, they may still be cancelled
+	TransferQueued
+	// TransferBatched transfers are part of a batch waiting to be relayed, a batch which times out returns
+	// them to the pool
+	TransferBatched
+	// TransferExecuted transfers have been paid out on Ethereum, this is final
+	TransferExecuted
+	// TransferRemoved transfers were queued or cancelled by the client and are neither pending nor in the
+	// archive of executed batches: they were cancelled and refunded, or executed so long ago that the archive
+	// has pruned them. This is final
+	TransferRemoved
+)
+
+func (s TransferStatus) String() string {
+	switch s {
+	case TransferQueued:
+		return "queued"
+	case TransferBatched:
+		return "batched"
+	case TransferExecuted:
+		return "executed"
+	case TransferRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// Final reports whether a transfer can not change status anymore
+func (s TransferStatus) Final() bool {
+	return s == TransferExecuted || s == TransferRemoved
+}
+
+// TransferState is the status of a transfer and the batch carrying it, if any
+type TransferState struct {
+	TxID   uint64
+	Status TransferStatus
+	// BatchNonce and TokenContract identify the batch of a batched or executed transfer
+	BatchNonce    uint64
+	TokenContract string
+	// BatchTimeout is the Ethereum height after which the batch of a batched transfer can not be executed
+	BatchTimeout uint64
+}
+
+// TransferState looks up the current state of one of the client's transfers. A transfer which is neither
+// pending nor executed is only reported removed when the client queued or cancelled it itself
+func (c *Client) TransferState(ctx context.Context, txID uint64) (TransferState, error) {
+	state := TransferState{TxID: txID}
+	pending, err := c.cosmos.GetPendingSendToEth(ctx, &types.QueryPendingSendToEth{SenderAddress: c.sender.String()})
+	if err != nil {
+		return state, fmt.Errorf("querying pending transfers: %w", err)
+	}
+	for _, tx := range pending.UnbatchedTransfers {
+		if tx.Id == txID {
+			state.Status = TransferQueued
+			return state, nil
+		}
+	}
+	for _, tx := range pending.TransfersInBatches {
+		if tx.Id != txID {
+			continue
+		}
+		batches, err := c.cosmos.OutgoingTxBatches(ctx, &types.QueryOutgoingTxBatchesRequest{})
+		if err != nil {
+			return state, fmt.Errorf("querying batches: %w", err)
+		}
+		state.Status = TransferBatched
+		for _, batch := range batches.Batches {
+			for _, batched := range batch.Transactions {
+				if batched.Id == txID {
+					state.BatchNonce, state.TokenContract, state.BatchTimeout = batch.BatchNonce, batch.TokenContract, batch.BatchTimeout
+				}
+			}
+		}
+		return state, nil
+	}
+
+	executed, err := c.cosmos.ExecutedBatchByTxId(ctx, &types.QueryExecutedBatchByTxIdRequest{TxId: txID})
+	switch {
+	case err == nil:
+		state.Status = TransferExecuted
+		state.BatchNonce, state.TokenContract = executed.Batch.BatchNonce, executed.Batch.TokenContract
+	case status.Code(err) == codes.NotFound && c.owns(txID):
+		state.Status = TransferRemoved
+	case status.Code(err) == codes.NotFound:
+		state.Status = TransferUnknown
+	default:
+		return state, fmt.Errorf("querying executed batches: %w", err)
+	}
+	return state, nil
+}
+
+// WatchTransfer polls the state of a transfer every interval and calls onChange with every new state,
+// starting with the current one. It returns once the transfer reaches a final status or ctx is done, a
+// transfer the client did not queue or cancel itself stays unknown once it leaves the pool unexecuted
+func (c *Client) WatchTransfer(ctx context.Context, txID uint64, interval time.Duration, onChange func(TransferState)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var last TransferState
+	for {
+		state, err := c.TransferState(ctx, txID)
+		if err != nil {
+			return err
+		}
+		if state != last {
+			onChange(state)
+			last = state
+		}
+		if state.Status.Final() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ApplyEvents advances state with the transfer lifecycle events of events.proto found in events, as
+// delivered in transaction results, block results or event subscriptions. Events about other transfers
+// are ignored
+func ApplyEvents(state TransferState, events []abci.Event) TransferState {
+	contains := func(ids []uint64) bool {
+		for _, id := range ids {
+			if id == state.TxID {
+				return true
+			}
+		}
+		return false
+	}
+	for _, event := range events {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		switch e := msg.(type) {
+		case *types.EventTransferQueued:
+			if e.TxId == state.TxID {
+				state = TransferState{TxID: state.TxID, Status: TransferQueued}
+			}
+		case *types.EventTransferCancelled:
+			if e.TxId == state.TxID {
+				state = TransferState{TxID: state.TxID, Status: TransferRemoved}
+			}
+		case *types.EventTransfersBatched:
+			if contains(e.TxIds) {
+				state = TransferState{TxID: state.TxID, Status: TransferBatched, BatchNonce: e.BatchNonce,
+					TokenContract: e.TokenContract, BatchTimeout: e.BatchTimeout}
+			}
+		case *types.EventTransfersUnbatched:
+			if contains(e.TxIds) {
+				state = TransferState{TxID: state.TxID, Status: TransferQueued}
+			}
+		case *types.EventTransfersExecuted:
+			if contains(e.TxIds) {
+				state = TransferState{TxID: state.TxID, Status: TransferExecuted, BatchNonce: e.BatchNonce, TokenContract: e.TokenContract}
+			}
+		}
+	}
+	return state
+}
@@ -71,17 +71,18 @@ type EthereumKeyOutput struct {
 	Address    string `json:"address"`
 }
 
-func runAddCmd(cmd *cobra.Command, args []string) error {
+// generateEthereumKey creates a new random Ethereum key and its hex encoded output
+func generateEthereumKey() (*ecdsa.PrivateKey, EthereumKeyOutput, error) {
 	privateKey, err := crypto.GenerateKey()
 	if err != nil {
-		return err
+		return nil, EthereumKeyOutput{}, err
 	}
 	privateKeyBytes := crypto.FromECDSA(privateKey)
 
 	publicKey := privateKey.Public()
 	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
 	if !ok {
-		return errors.New("error casting public key to ECDSA")
+		return nil, EthereumKeyOutput{}, errors.New("error casting public key to ECDSA")
 	}
 	publicKeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
 
@@ -90,6 +91,14 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		PublicKey:  hexutil.Encode(publicKeyBytes),
 		Address:    crypto.PubkeyToAddress(*publicKeyECDSA).Hex(),
 	}
+	return privateKey, keyOutput, nil
+}
+
+func runAddCmd(cmd *cobra.Command, args []string) error {
+	privateKey, keyOutput, err := generateEthereumKey()
+	if err != nil {
+		return err
+	}
 
 	if dryRun, errDryRun := cmd.Flags().GetBool(flags.FlagDryRun); !dryRun {
 		if errDryRun != nil {
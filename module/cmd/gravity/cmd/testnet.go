@@ -16,7 +16,6 @@ import (
 	tmconfig "github.com/tendermint/tendermint/config"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
@@ -34,6 +33,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	bech32ibctypes "github.com/osmosis-labs/bech32-ibc/x/bech32ibc/types"
+
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
 var (
@@ -42,21 +44,52 @@ var (
 	flagOutputDir         = "output-dir"
 	flagNodeDaemonHome    = "node-daemon-home"
 	flagStartingIPAddress = "starting-ip-address"
+	flagGravityID         = "gravity-id"
+	flagBridgeEthAddress  = "bridge-ethereum-address"
+	flagBridgeChainID     = "bridge-chain-id"
 )
 
+// TestnetManifestFile is the name of the manifest written to the output directory of the testnet command
+const TestnetManifestFile = "testnet_manifest.json"
+
+// TestnetManifest describes the bridge set up by the testnet command, it holds everything a local Ethereum
+// chain needs to deploy Gravity.sol with the genesis validator set and to sign as its validators
+type TestnetManifest struct {
+	ChainID               string             `json:"chain_id"`
+	GravityID             string             `json:"gravity_id"`
+	BridgeEthereumAddress string             `json:"bridge_ethereum_address"`
+	BridgeChainID         uint64             `json:"bridge_chain_id"`
+	Validators            []TestnetValidator `json:"validators"`
+}
+
+// TestnetValidator holds the keys of one validator of the testnet, Power is its consensus power
+type TestnetValidator struct {
+	Moniker             string `json:"moniker"`
+	NodeID              string `json:"node_id"`
+	ValidatorAddress    string `json:"validator_address"`
+	OrchestratorAddress string `json:"orchestrator_address"`
+	EthAddress          string `json:"eth_address"`
+	EthPrivateKey       string `json:"eth_private_key"`
+	Power               int64  `json:"power"`
+}
+
 // get cmd to initialize all files for tendermint testnet and application
 func testnetCmd(mbm module.BasicManager, genBalIterator banktypes.GenesisBalancesIterator) *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
 		Use:   "testnet",
-		Short: "Initialize files for a simapp testnet",
+		Short: "Initialize files for a gravity testnet",
 		Long: `testnet will create "v" number of directories and populate each with
 necessary files (private validator, genesis, config, etc.).
 
+Every validator gets an orchestrator key and an Ethereum key which are delegated to in its
+gentx, the gravity params are set from the bridge flags. The keys of every validator are
+written to ` + TestnetManifestFile + ` in the output directory for use by a local Ethereum chain.
+
 Note, strict routability for addresses is turned off in the config file.
 
 Example:
-	simd testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+	gravity testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2 --gravity-id gravity-test --bridge-chain-id 15
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -101,9 +134,23 @@ Example:
 				fmt.Printf("algo has an error")
 			}
 
+			gravityID, errgravityID := cmd.Flags().GetString(flagGravityID)
+			if errgravityID != nil {
+				fmt.Printf("gravityID has an error")
+			}
+			bridgeEthAddress, errbridgeEthAddress := cmd.Flags().GetString(flagBridgeEthAddress)
+			if errbridgeEthAddress != nil {
+				fmt.Printf("bridgeEthAddress has an error")
+			}
+			bridgeChainID, errbridgeChainID := cmd.Flags().GetUint64(flagBridgeChainID)
+			if errbridgeChainID != nil {
+				fmt.Printf("bridgeChainID has an error")
+			}
+
 			return InitTestnet(
 				clientCtx, cmd, config, mbm, genBalIterator, outputDir, chainID, minGasPrices,
-				nodeDirPrefix, nodeDaemonHome, startingIPAddress, keyringBackend, algo, numValidators,
+				nodeDirPrefix, nodeDaemonHome, startingIPAddress, keyringBackend, algo,
+				gravityID, bridgeEthAddress, bridgeChainID, numValidators,
 			)
 		},
 	}
@@ -117,6 +164,9 @@ Example:
 	cmd.Flags().String(server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom), "Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001stake)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
 	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	cmd.Flags().String(flagGravityID, gravitytypes.DefaultParams().GravityId, "The gravity id of the bridge, used to sign Ethereum messages")
+	cmd.Flags().String(flagBridgeEthAddress, gravitytypes.DefaultParams().BridgeEthereumAddress, "The address of the Gravity.sol contract on Ethereum")
+	cmd.Flags().Uint64(flagBridgeChainID, gravitytypes.DefaultParams().BridgeChainId, "The chain id of the Ethereum chain Gravity.sol is deployed on")
 
 	return cmd
 }
@@ -137,7 +187,10 @@ func InitTestnet(
 	nodeDaemonHome,
 	startingIPAddress,
 	keyringBackend,
-	algoStr string,
+	algoStr,
+	gravityID,
+	bridgeEthAddress string,
+	bridgeChainID uint64,
 	numValidators int,
 ) error {
 
@@ -145,6 +198,21 @@ func InitTestnet(
 		chainID = "chain-" + tmrand.NewRand().Str(6)
 	}
 
+	gravityParams := gravitytypes.DefaultParams()
+	gravityParams.GravityId = gravityID
+	gravityParams.BridgeEthereumAddress = bridgeEthAddress
+	gravityParams.BridgeChainId = bridgeChainID
+	if err := gravityParams.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid gravity params: %w", err)
+	}
+	manifest := TestnetManifest{
+		ChainID:               chainID,
+		GravityID:             gravityID,
+		BridgeEthereumAddress: bridgeEthAddress,
+		BridgeChainID:         bridgeChainID,
+		Validators:            make([]TestnetValidator, 0, numValidators),
+	}
+
 	nodeIDs := make([]string, numValidators)
 	valPubKeys := make([]crypto.PubKey, numValidators)
 
@@ -211,7 +279,24 @@ func InitTestnet(
 			return err
 		}
 
-		info := map[string]string{"secret": secret}
+		orchName := fmt.Sprintf("%s-orchestrator", nodeDirName)
+		orchAddr, orchSecret, err := server.GenerateSaveCoinKey(kb, orchName, true, algo)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		_, ethKey, err := generateEthereumKey()
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		info := map[string]string{
+			"secret":              secret,
+			"orchestrator_secret": orchSecret,
+			"eth_private_key":     ethKey.PrivateKey,
+		}
 
 		cliPrint, err := json.Marshal(info)
 		if err != nil {
@@ -233,7 +318,13 @@ func InitTestnet(
 		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: coins.Sort()})
 		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
 
-		valTokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+		// the orchestrator only needs enough to pay the fees of its confirms and claims
+		orchCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)))
+		genBalances = append(genBalances, banktypes.Balance{Address: orchAddr.String(), Coins: orchCoins})
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(orchAddr, nil, 0, 0))
+
+		const valPower = 100
+		valTokens := sdk.TokensFromConsensusPower(valPower, sdk.DefaultPowerReduction)
 		createValMsg, err := stakingtypes.NewMsgCreateValidator(
 			sdk.ValAddress(addr),
 			valPubKeys[i],
@@ -246,8 +337,14 @@ func InitTestnet(
 			return err
 		}
 
+		delegateKeySetMsg := &gravitytypes.MsgSetOrchestratorAddress{
+			Validator:    sdk.ValAddress(addr).String(),
+			Orchestrator: orchAddr.String(),
+			EthAddress:   ethKey.Address,
+		}
+
 		txBuilder := clientCtx.TxConfig.NewTxBuilder()
-		if err = txBuilder.SetMsgs(createValMsg); err != nil {
+		if err = txBuilder.SetMsgs(createValMsg, delegateKeySetMsg); err != nil {
 			return err
 		}
 
@@ -274,9 +371,19 @@ func InitTestnet(
 		}
 
 		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config/app.toml"), simappConfig)
+
+		manifest.Validators = append(manifest.Validators, TestnetValidator{
+			Moniker:             nodeDirName,
+			NodeID:              nodeIDs[i],
+			ValidatorAddress:    sdk.ValAddress(addr).String(),
+			OrchestratorAddress: orchAddr.String(),
+			EthAddress:          ethKey.Address,
+			EthPrivateKey:       ethKey.PrivateKey,
+			Power:               valPower,
+		})
 	}
 
-	if err := initGenFiles(clientCtx, mbm, chainID, genAccounts, genBalances, genFiles, numValidators); err != nil {
+	if err := initGenFiles(clientCtx, mbm, chainID, genAccounts, genBalances, gravityParams, genFiles, numValidators); err != nil {
 		return err
 	}
 
@@ -288,6 +395,14 @@ func InitTestnet(
 		return err
 	}
 
+	manifestBz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(TestnetManifestFile, outputDir, manifestBz); err != nil {
+		return err
+	}
+
 	cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
 	return nil
 }
@@ -295,7 +410,7 @@ func InitTestnet(
 func initGenFiles(
 	clientCtx client.Context, mbm module.BasicManager, chainID string,
 	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
-	genFiles []string, numValidators int,
+	gravityParams *gravitytypes.Params, genFiles []string, numValidators int,
 ) error {
 
 	appGenState := mbm.DefaultGenesis(clientCtx.Codec)
@@ -319,6 +434,20 @@ func initGenFiles(
 	bankGenState.Balances = genBalances
 	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
 
+	// set the bridge params in the genesis state
+	var gravityGenState gravitytypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[gravitytypes.ModuleName], &gravityGenState)
+
+	gravityGenState.Params = gravityParams
+	appGenState[gravitytypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&gravityGenState)
+
+	// the native hrp must match the account prefix or the chain halts on its first block
+	var bech32ibcGenState bech32ibctypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[bech32ibctypes.ModuleName], &bech32ibcGenState)
+
+	bech32ibcGenState.NativeHRP = sdk.GetConfig().GetBech32AccountAddrPrefix()
+	appGenState[bech32ibctypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bech32ibcGenState)
+
 	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
 	if err != nil {
 		return err
@@ -328,27 +457,11 @@ func initGenFiles(
 		GenesisTime:   time.Time{},
 		ChainID:       chainID,
 		InitialHeight: 0,
-		ConsensusParams: &tmproto.ConsensusParams{
-			Block: tmproto.BlockParams{
-				MaxBytes:   0,
-				MaxGas:     0,
-				TimeIotaMs: 0,
-			},
-			Evidence: tmproto.EvidenceParams{
-				MaxAgeNumBlocks: 0,
-				MaxAgeDuration:  0,
-				MaxBytes:        0,
-			},
-			Validator: tmproto.ValidatorParams{
-				PubKeyTypes: []string{},
-			},
-			Version: tmproto.VersionParams{
-				AppVersion: 0,
-			},
-		},
-		Validators: nil,
-		AppHash:    []byte{},
-		AppState:   appGenStateJSON,
+		// left empty so that tendermint fills in its default consensus params
+		ConsensusParams: nil,
+		Validators:      nil,
+		AppHash:         []byte{},
+		AppState:        appGenStateJSON,
 	}
 
 	// generate empty genesis files for each validator and save
@@ -385,7 +498,7 @@ func collectGenFiles(
 			return err
 		}
 
-		nodeAppState, err := genutil.GenAppStateFromConfig(clientCtx.Codec, clientCtx.TxConfig, nodeConfig, initCfg, *genDoc, genBalIterator)
+		nodeAppState, err := GenAppStateFromConfig(clientCtx.Codec, clientCtx.TxConfig, nodeConfig, initCfg, *genDoc, genBalIterator)
 		if err != nil {
 			return err
 		}
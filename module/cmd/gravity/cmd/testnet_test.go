@@ -0,0 +1,120 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	tmconfig "github.com/tendermint/tendermint/config"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	bech32ibctypes "github.com/osmosis-labs/bech32-ibc/x/bech32ibc/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestInitTestnet(t *testing.T) {
+	const (
+		numValidators    = 3
+		gravityID        = "gravity-test"
+		bridgeEthAddress = "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"
+		bridgeChainID    = uint64(15)
+	)
+	encodingConfig := app.MakeEncodingConfig()
+	cdc := encodingConfig.Marshaler
+	clientCtx := client.Context{}.WithCodec(cdc).WithTxConfig(encodingConfig.TxConfig)
+	outputDir := t.TempDir()
+
+	err := cmd.InitTestnet(
+		clientCtx, &cobra.Command{}, tmconfig.DefaultConfig(), app.ModuleBasics, banktypes.GenesisBalancesIterator{},
+		outputDir, "gravity-test-1", "0stake", "node", "gravity", "192.168.0.1", keyring.BackendTest,
+		string(hd.Secp256k1Type), gravityID, bridgeEthAddress, bridgeChainID, numValidators,
+	)
+	require.NoError(t, err)
+
+	manifestBz, err := os.ReadFile(filepath.Join(outputDir, cmd.TestnetManifestFile))
+	require.NoError(t, err)
+	var manifest cmd.TestnetManifest
+	require.NoError(t, json.Unmarshal(manifestBz, &manifest))
+	require.Equal(t, "gravity-test-1", manifest.ChainID)
+	require.Equal(t, gravityID, manifest.GravityID)
+	require.Equal(t, bridgeEthAddress, manifest.BridgeEthereumAddress)
+	require.Equal(t, bridgeChainID, manifest.BridgeChainID)
+	require.Len(t, manifest.Validators, numValidators)
+
+	validators := make(map[string]cmd.TestnetValidator, numValidators)
+	for _, val := range manifest.Validators {
+		require.NoError(t, gravitytypes.ValidateEthAddress(val.EthAddress))
+		require.NotEmpty(t, val.EthPrivateKey)
+		require.Equal(t, int64(100), val.Power)
+		validators[val.ValidatorAddress] = val
+	}
+
+	var canonical json.RawMessage
+	for i := 0; i < numValidators; i++ {
+		genDoc, err := tmtypes.GenesisDocFromFile(filepath.Join(outputDir, fmt.Sprintf("node%d", i), "gravity", "config", "genesis.json"))
+		require.NoError(t, err)
+		if canonical == nil {
+			canonical = genDoc.AppState
+		}
+		require.JSONEq(t, string(canonical), string(genDoc.AppState))
+	}
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(canonical, &appState))
+
+	var gravityGenesis gravitytypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[gravitytypes.ModuleName], &gravityGenesis))
+	require.Equal(t, gravityID, gravityGenesis.Params.GravityId)
+	require.Equal(t, bridgeEthAddress, gravityGenesis.Params.BridgeEthereumAddress)
+	require.Equal(t, bridgeChainID, gravityGenesis.Params.BridgeChainId)
+
+	var bech32ibcGenesis bech32ibctypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[bech32ibctypes.ModuleName], &bech32ibcGenesis))
+	require.Equal(t, sdk.GetConfig().GetBech32AccountAddrPrefix(), bech32ibcGenesis.NativeHRP)
+
+	// every gentx creates its validator and sets the delegate keys listed in the manifest
+	genutilGenesis := genutiltypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, genutilGenesis.GenTxs, numValidators)
+	for _, genTxBz := range genutilGenesis.GenTxs {
+		genTx, err := encodingConfig.TxConfig.TxJSONDecoder()(genTxBz)
+		require.NoError(t, err)
+		msgs := genTx.GetMsgs()
+		require.Len(t, msgs, 2)
+		delegateKeys, ok := msgs[1].(*gravitytypes.MsgSetOrchestratorAddress)
+		require.True(t, ok)
+		require.NoError(t, delegateKeys.ValidateBasic())
+		val, ok := validators[delegateKeys.Validator]
+		require.True(t, ok)
+		require.Equal(t, val.OrchestratorAddress, delegateKeys.Orchestrator)
+		require.Equal(t, val.EthAddress, delegateKeys.EthAddress)
+	}
+}
+
+//nolint: exhaustivestruct
+func TestInitTestnetInvalidBridgeAddress(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.WithCodec(encodingConfig.Marshaler).WithTxConfig(encodingConfig.TxConfig)
+	outputDir := filepath.Join(t.TempDir(), "testnet")
+
+	err := cmd.InitTestnet(
+		clientCtx, &cobra.Command{}, tmconfig.DefaultConfig(), app.ModuleBasics, banktypes.GenesisBalancesIterator{},
+		outputDir, "gravity-test-1", "0stake", "node", "gravity", "192.168.0.1", keyring.BackendTest,
+		string(hd.Secp256k1Type), "gravity-test", "not an address", 15, 1,
+	)
+	require.Error(t, err)
+	require.NoDirExists(t, outputDir)
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ValidateGravityGenesisCmd returns a command which checks the gravity state of a genesis file for inconsistencies
+// between its objects and with the bank balances of the gravity module account, these are the checks run by
+// InitGenesis but every violation is reported instead of the chain panicking at the first one
+func ValidateGravityGenesisCmd() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "validate-gravity-genesis [genesis-file]",
+		Short: "Check the gravity state of a genesis file for inconsistencies",
+		Long: fmt.Sprintf(`Check the gravity state of the genesis file at the default location, or the one passed as an
+argument, for confirms of missing batches or valsets, ids beyond the exported nonces, duplicate ERC20 mappings
+and escrowed amounts which do not match the balances of the gravity module account. Every violation is printed.
+
+Example:
+$ %s validate-gravity-genesis /path/to/genesis.json
+`, version.AppName),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			genesis := serverCtx.Config.GenesisFile()
+			if len(args) == 1 {
+				genesis = args[0]
+			}
+			genDoc, err := validateGenDoc(genesis)
+			if err != nil {
+				return err
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return errors.Wrap(err, "failed to JSON unmarshal genesis app state")
+			}
+
+			var gravityGenesis gravitytypes.GenesisState
+			if err := cdc.UnmarshalJSON(appState[gravitytypes.ModuleName], &gravityGenesis); err != nil {
+				return errors.Wrap(err, "failed to unmarshal gravity genesis state")
+			}
+			if err := gravityGenesis.ValidateBasic(); err != nil {
+				return errors.Wrap(err, "invalid gravity genesis state")
+			}
+
+			moduleAddress := authtypes.NewModuleAddress(gravitytypes.ModuleName).String()
+			var moduleBalances banktypes.Balance
+			for _, balance := range banktypes.GetGenesisStateFromAppState(cdc, appState).Balances {
+				if balance.Address == moduleAddress {
+					moduleBalances = balance
+				}
+			}
+
+			violations := gravityGenesis.ValidateConsistency(moduleBalances.Coins)
+			if len(violations) > 0 {
+				for _, violation := range violations {
+					cmd.PrintErrln(violation)
+				}
+				return fmt.Errorf("found %d violations in the gravity state of %s", len(violations), genesis)
+			}
+
+			cmd.Printf("The gravity state of %s is consistent\n", genesis)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestValidateGravityGenesisCmd(t *testing.T) {
+	tests := []struct {
+		name      string
+		confirms  []gravitytypes.MsgConfirmBatch
+		expectErr bool
+	}{
+		{
+			name:      "consistent",
+			expectErr: false,
+		},
+		{
+			name: "confirm for missing batch",
+			confirms: []gravitytypes.MsgConfirmBatch{
+				{Nonce: 1, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Orchestrator: "orchestrator"},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			encodingConfig := app.MakeEncodingConfig()
+			cdc := encodingConfig.Marshaler
+			appState := app.ModuleBasics.DefaultGenesis(cdc)
+			gravityGenesis := gravitytypes.DefaultGenesisState()
+			gravityGenesis.BatchConfirms = append(gravityGenesis.BatchConfirms, tc.confirms...)
+			appState[gravitytypes.ModuleName] = cdc.MustMarshalJSON(gravityGenesis)
+			appStateBz, err := json.Marshal(appState)
+			require.NoError(t, err)
+
+			genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+			genDoc := tmtypes.GenesisDoc{ChainID: "gravity-test", AppState: appStateBz}
+			require.NoError(t, genDoc.SaveAs(genesisFile))
+
+			clientCtx := client.Context{}.WithCodec(cdc)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			validateCmd := cmd.ValidateGravityGenesisCmd()
+			validateCmd.SetArgs([]string{genesisFile})
+			if tc.expectErr {
+				require.Error(t, validateCmd.ExecuteContext(ctx))
+			} else {
+				require.NoError(t, validateCmd.ExecuteContext(ctx))
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GRPCCosmosClient is a CosmosClient for a Gravity Bridge node: queries go through the gRPC connection of
+// the client context and messages are signed with the key of its From account and broadcast in the
+// context's broadcast mode
+type GRPCCosmosClient struct {
+	types.QueryClient
+
+	clientCtx client.Context
+	txf       tx.Factory
+
+	mtx sync.Mutex
+	// nextSequence is the sequence after the last transaction accepted by Broadcast, the account queried
+	// from the node does not reflect transactions still waiting in the mempool
+	nextSequence uint64
+}
+
+var _ CosmosClient = &GRPCCosmosClient{}
+
+// NewGRPCCosmosClient returns a GRPCCosmosClient sending transactions built by txf. The gas limit is
+// estimated by simulation when txf has SimulateAndExecute set
+func NewGRPCCosmosClient(clientCtx client.Context, txf tx.Factory) *GRPCCosmosClient {
+	return &GRPCCosmosClient{
+		QueryClient: types.NewQueryClient(clientCtx),
+		clientCtx:   clientCtx,
+		txf:         txf,
+	}
+}
+
+// Broadcast signs msgs in a single transaction and broadcasts it, a transaction rejected by the node is
+// returned as an error
+func (c *GRPCCosmosClient) Broadcast(ctx context.Context, msgs ...sdk.Msg) error {
+	_, err := c.BroadcastTx(ctx, msgs...)
+	return err
+}
+
+// BroadcastTx is Broadcast returning the response of the node, which only carries the events of the
+// transaction when the client context broadcasts in block mode
+func (c *GRPCCosmosClient) BroadcastTx(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	clientCtx := c.clientCtx
+	num, seq, err := c.txf.AccountRetriever().GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+	if err != nil {
+		return nil, fmt.Errorf("querying account %s: %w", clientCtx.GetFromAddress(), err)
+	}
+	if seq < c.nextSequence {
+		seq = c.nextSequence
+	}
+	txf := c.txf.WithAccountNumber(num).WithSequence(seq)
+
+	if txf.SimulateAndExecute() {
+		_, gas, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, fmt.Errorf("estimating gas: %w", err)
+		}
+		txf = txf.WithGas(gas)
+	}
+
+	builder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, clientCtx.GetFromName(), builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		// the sequence may have been the cause, query it again next time
+		c.nextSequence = 0
+		return res, fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	c.nextSequence = seq + 1
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+var (
+	gravityABI = types.MustParseABI(types.GravityABIJSON)
+	erc20ABI   = types.MustParseABI(types.ERC20ABIJSON)
+)
+
+// claimFromLog decodes a Gravity.sol event into the claim attesting to it, it returns nil for logs which
+// are not bridge events
+func (o *Orchestrator) claimFromLog(ctx context.Context, l ethtypes.Log) (types.EthereumClaim, error) {
+	if len(l.Topics) == 0 {
+		return nil, nil
+	}
+	event, err := gravityABI.EventByID(l.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+	fields := make(map[string]interface{})
+	if err := gravityABI.UnpackIntoMap(fields, event.Name, l.Data); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", event.Name, err)
+	}
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, l.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("decoding %s topics: %w", event.Name, err)
+	}
+
+	orchestrator := o.cfg.OrchestratorAddress.String()
+	eventNonce := fields["_eventNonce"].(*big.Int).Uint64()
+	switch event.Name {
+	case "SendToCosmosEvent":
+		token := fields["_tokenContract"].(gethcommon.Address)
+		claim := &types.MsgSendToCosmosClaim{
+			EventNonce:     eventNonce,
+			BlockHeight:    l.BlockNumber,
+			TokenContract:  token.Hex(),
+			Amount:         sdk.NewIntFromBigInt(fields["_amount"].(*big.Int)),
+			EthereumSender: fields["_sender"].(gethcommon.Address).Hex(),
+			CosmosReceiver: fields["_destination"].(string),
+			Orchestrator:   orchestrator,
+			EthTxHash:      l.TxHash.Hex(),
+		}
+		// tokens are not required to implement the metadata functions, those which do not are attested
+		// to without metadata
+		if name, symbol, decimals, err := erc20Metadata(ctx, o.eth, token); err == nil {
+			claim.TokenName, claim.TokenSymbol, claim.TokenDecimals = name, symbol, uint32(decimals)
+		} else {
+			o.cfg.Logger.Info("reporting deposit without token metadata", "token", token.Hex(), "err", err)
+		}
+		return claim, nil
+	case "TransactionBatchExecutedEvent":
+		return &types.MsgBatchSendToEthClaim{
+			EventNonce:    eventNonce,
+			BlockHeight:   l.BlockNumber,
+			BatchNonce:    fields["_batchNonce"].(*big.Int).Uint64(),
+			TokenContract: fields["_token"].(gethcommon.Address).Hex(),
+			Orchestrator:  orchestrator,
+			EthTxHash:     l.TxHash.Hex(),
+		}, nil
+	case "ERC20DeployedEvent":
+		return &types.MsgERC20DeployedClaim{
+			EventNonce:    eventNonce,
+			BlockHeight:   l.BlockNumber,
+			CosmosDenom:   fields["_cosmosDenom"].(string),
+			TokenContract: fields["_tokenContract"].(gethcommon.Address).Hex(),
+			Name:          fields["_name"].(string),
+			Symbol:        fields["_symbol"].(string),
+			Decimals:      uint64(fields["_decimals"].(uint8)),
+			Orchestrator:  orchestrator,
+		}, nil
+	case "ValsetUpdatedEvent":
+		validators := fields["_validators"].([]gethcommon.Address)
+		powers := fields["_powers"].([]*big.Int)
+		members := make([]types.BridgeValidator, len(validators))
+		for i := range validators {
+			members[i] = types.BridgeValidator{Power: powers[i].Uint64(), EthereumAddress: validators[i].Hex()}
+		}
+		return &types.MsgValsetUpdatedClaim{
+			EventNonce:   eventNonce,
+			ValsetNonce:  fields["_newValsetNonce"].(*big.Int).Uint64(),
+			BlockHeight:  l.BlockNumber,
+			Members:      members,
+			RewardAmount: sdk.NewIntFromBigInt(fields["_rewardAmount"].(*big.Int)),
+			RewardToken:  fields["_rewardToken"].(gethcommon.Address).Hex(),
+			Orchestrator: orchestrator,
+		}, nil
+	case "LogicCallEvent":
+		invalidationID := fields["_invalidationId"].([32]byte)
+		return &types.MsgLogicCallExecutedClaim{
+			EventNonce:        eventNonce,
+			BlockHeight:       l.BlockNumber,
+			InvalidationId:    invalidationID[:],
+			InvalidationNonce: fields["_invalidationNonce"].(*big.Int).Uint64(),
+			Orchestrator:      orchestrator,
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// erc20Metadata reads the name, symbol and decimals of an ERC20
+func erc20Metadata(ctx context.Context, eth bind.ContractCaller, token gethcommon.Address) (string, string, uint8, error) {
+	erc20 := bind.NewBoundContract(token, erc20ABI, eth, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+	var name, symbol, decimals []interface{}
+	if err := erc20.Call(opts, &name, "name"); err != nil {
+		return "", "", 0, fmt.Errorf("reading name of %s: %w", token.Hex(), err)
+	}
+	if err := erc20.Call(opts, &symbol, "symbol"); err != nil {
+		return "", "", 0, fmt.Errorf("reading symbol of %s: %w", token.Hex(), err)
+	}
+	if err := erc20.Call(opts, &decimals, "decimals"); err != nil {
+		return "", "", 0, fmt.Errorf("reading decimals of %s: %w", token.Hex(), err)
+	}
+	return name[0].(string), symbol[0].(string), decimals[0].(uint8), nil
+}
@@ -0,0 +1,261 @@
+// Package orchestrator implements the orchestrator role run by every Gravity Bridge validator in Go: it
+// signs the valsets, batches and logic calls the chain asks its validator to sign and attests to the events
+// emitted by Gravity.sol. The Ethereum and Cosmos sides are reached through the EthereumClient and
+// CosmosClient interfaces, so the same code runs against real nodes or local stand-ins
+package orchestrator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ErrMissedEvents is returned by ReportEvents when the events found on Ethereum do not continue from the
+// last event the validator attested to, the next call scans again from Config.StartBlock
+var ErrMissedEvents = errors.New("events missing between the last attested event and the scanned blocks")
+
+// EthereumClient is the access to an Ethereum node the orchestrator needs, an *ethclient.Client or a
+// simulated backend satisfies it
+type EthereumClient interface {
+	bind.ContractCaller
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+}
+
+// CosmosClient is the access to a Gravity Bridge node the orchestrator needs: the gravity queries and a
+// way to deliver messages signed by the orchestrator's Cosmos key
+type CosmosClient interface {
+	types.QueryClient
+	// Broadcast delivers msgs in a single transaction, returning an error if it was not accepted
+	Broadcast(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+// Config holds the settings of an Orchestrator
+type Config struct {
+	// GravityAddress is the address of the Gravity.sol deployment the chain is bridged to
+	GravityAddress gethcommon.Address
+	// OrchestratorAddress is the Cosmos delegate address confirms and claims are sent from
+	OrchestratorAddress sdk.AccAddress
+	// EthKey is the Ethereum delegate key registered for the validator, confirms are signed with it
+	EthKey *ecdsa.PrivateKey
+	// StartBlock is the Ethereum block event scanning starts from, usually the block Gravity.sol was deployed in
+	StartBlock uint64
+	// BlockDelay is the number of blocks an Ethereum block must be buried under before its events are reported
+	BlockDelay uint64
+	// BlocksToSearch caps the number of blocks scanned by a single ReportEvents, 0 means no cap
+	BlocksToSearch uint64
+	// Logger receives the errors Run recovers from, defaults to a no-op logger
+	Logger log.Logger
+}
+
+// Orchestrator signs and attests on behalf of a single validator
+type Orchestrator struct {
+	cfg    Config
+	cosmos CosmosClient
+	eth    EthereumClient
+
+	// nextBlock is the first Ethereum block the next ReportEvents scans
+	nextBlock uint64
+}
+
+// New returns an Orchestrator for cfg talking to the given clients
+func New(cfg Config, cosmos CosmosClient, eth EthereumClient) (*Orchestrator, error) {
+	if cfg.EthKey == nil {
+		return nil, errors.New("no Ethereum key")
+	}
+	if cfg.OrchestratorAddress.Empty() {
+		return nil, errors.New("no orchestrator address")
+	}
+	if cfg.GravityAddress == (gethcommon.Address{}) {
+		return nil, errors.New("no Gravity.sol address")
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = log.NewNopLogger()
+	}
+	return &Orchestrator{cfg: cfg, cosmos: cosmos, eth: eth, nextBlock: cfg.StartBlock}, nil
+}
+
+// Address returns the Cosmos delegate address of the orchestrator
+func (o *Orchestrator) Address() sdk.AccAddress {
+	return o.cfg.OrchestratorAddress
+}
+
+// EthAddress returns the Ethereum delegate address of the orchestrator
+func (o *Orchestrator) EthAddress() gethcommon.Address {
+	return crypto.PubkeyToAddress(o.cfg.EthKey.PublicKey)
+}
+
+// Run calls SignPending and ReportEvents every interval until ctx is done. Errors are logged and retried
+// in the next round
+func (o *Orchestrator) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := o.SignPending(ctx); err != nil {
+			o.cfg.Logger.Error("signing pending requests", "err", err)
+		}
+		if err := o.ReportEvents(ctx); err != nil {
+			o.cfg.Logger.Error("reporting Ethereum events", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// SignPending confirms the valsets, batch and logic call the chain reports as unsigned by the orchestrator
+// and broadcasts the confirms in one transaction. The chain only returns the oldest unsigned batch and logic
+// call, newer ones are signed by later calls
+func (o *Orchestrator) SignPending(ctx context.Context) error {
+	params, err := o.cosmos.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return fmt.Errorf("querying params: %w", err)
+	}
+	gravityID := params.Params.GravityId
+	address := o.cfg.OrchestratorAddress.String()
+	ethAddress := o.EthAddress().Hex()
+	var msgs []sdk.Msg
+
+	valsets, err := o.cosmos.LastPendingValsetRequestByAddr(ctx, &types.QueryLastPendingValsetRequestByAddrRequest{Address: address})
+	if err != nil {
+		return fmt.Errorf("querying pending valsets: %w", err)
+	}
+	for _, valset := range valsets.Valsets {
+		signature, err := types.NewEthereumSignature(valset.GetCheckpoint(gravityID), o.cfg.EthKey)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &types.MsgValsetConfirm{
+			Nonce:        valset.Nonce,
+			Orchestrator: address,
+			EthAddress:   ethAddress,
+			Signature:    hex.EncodeToString(signature),
+		})
+	}
+
+	batches, err := o.cosmos.LastPendingBatchRequestByAddr(ctx, &types.QueryLastPendingBatchRequestByAddrRequest{Address: address})
+	if err != nil {
+		return fmt.Errorf("querying pending batches: %w", err)
+	}
+	for _, batch := range batches.Batch {
+		signature, err := types.NewEthereumSignature(batch.GetCheckpoint(gravityID), o.cfg.EthKey)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: batch.TokenContract,
+			EthSigner:     ethAddress,
+			Orchestrator:  address,
+			Signature:     hex.EncodeToString(signature),
+		})
+	}
+
+	calls, err := o.cosmos.LastPendingLogicCallByAddr(ctx, &types.QueryLastPendingLogicCallByAddrRequest{Address: address})
+	if err != nil {
+		return fmt.Errorf("querying pending logic calls: %w", err)
+	}
+	for _, call := range calls.Call {
+		signature, err := types.NewEthereumSignature(call.GetCheckpoint(gravityID), o.cfg.EthKey)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+			EthSigner:         ethAddress,
+			Orchestrator:      address,
+			Signature:         hex.EncodeToString(signature),
+		})
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err := o.cosmos.Broadcast(ctx, msgs...); err != nil {
+		return fmt.Errorf("broadcasting %d confirms: %w", len(msgs), err)
+	}
+	return nil
+}
+
+// ReportEvents scans the Ethereum blocks after the last scanned one which are at least BlockDelay deep and
+// broadcasts a claim for every Gravity.sol event the validator has not attested to yet, in event nonce order
+func (o *Orchestrator) ReportEvents(ctx context.Context) error {
+	latest, err := o.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("querying latest Ethereum block: %w", err)
+	}
+	if latest.Number.Uint64() < o.cfg.BlockDelay {
+		return nil
+	}
+	end := latest.Number.Uint64() - o.cfg.BlockDelay
+	start := o.nextBlock
+	if start > end {
+		return nil
+	}
+	if o.cfg.BlocksToSearch > 0 && end-start >= o.cfg.BlocksToSearch {
+		end = start + o.cfg.BlocksToSearch - 1
+	}
+
+	lastEventNonce, err := o.cosmos.LastEventNonceByAddr(ctx, &types.QueryLastEventNonceByAddrRequest{Address: o.cfg.OrchestratorAddress.String()})
+	if err != nil {
+		return fmt.Errorf("querying last event nonce: %w", err)
+	}
+	logs, err := o.eth.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(start),
+		ToBlock:   new(big.Int).SetUint64(end),
+		Addresses: []gethcommon.Address{o.cfg.GravityAddress},
+	})
+	if err != nil {
+		return fmt.Errorf("filtering Gravity.sol logs in blocks %d to %d: %w", start, end, err)
+	}
+
+	var claims []types.EthereumClaim
+	for _, l := range logs {
+		claim, err := o.claimFromLog(ctx, l)
+		if err != nil {
+			return err
+		}
+		if claim != nil && claim.GetEventNonce() > lastEventNonce.EventNonce {
+			claims = append(claims, claim)
+		}
+	}
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].GetEventNonce() < claims[j].GetEventNonce()
+	})
+
+	// the chain only accepts claims in nonce order, a gap means a previous round was not delivered
+	if len(claims) > 0 && claims[0].GetEventNonce() != lastEventNonce.EventNonce+1 {
+		o.nextBlock = o.cfg.StartBlock
+		return fmt.Errorf("%w: last attested %d, found %d", ErrMissedEvents, lastEventNonce.EventNonce, claims[0].GetEventNonce())
+	}
+	if len(claims) > 0 {
+		msgs := make([]sdk.Msg, len(claims))
+		for i, claim := range claims {
+			msgs[i] = claim.(sdk.Msg)
+		}
+		if err := o.cosmos.Broadcast(ctx, msgs...); err != nil {
+			return fmt.Errorf("broadcasting claims for event nonces %d to %d: %w",
+				claims[0].GetEventNonce(), claims[len(claims)-1].GetEventNonce(), err)
+		}
+	}
+	o.nextBlock = end + 1
+	return nil
+}
@@ -0,0 +1,169 @@
+package orchestrator_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/orchestrator"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/ethsim"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+func lastEventNonce(t *testing.T, client orchestrator.CosmosClient, orch sdk.AccAddress) uint64 {
+	res, err := client.LastEventNonceByAddr(context.Background(), &types.QueryLastEventNonceByAddrRequest{Address: orch.String()})
+	require.NoError(t, err)
+	return res.EventNonce
+}
+
+//nolint: exhaustivestruct
+func TestSignPending(t *testing.T) {
+	b := ethsim.NewBridge(t)
+	client := ethsim.NewCosmosClient(&b.Input)
+	gravity.EndBlocker(b.Context(), b.Input.GravityKeeper)
+
+	pending := func(o *orchestrator.Orchestrator) int {
+		res, err := client.LastPendingValsetRequestByAddr(context.Background(),
+			&types.QueryLastPendingValsetRequestByAddrRequest{Address: o.Address().String()})
+		require.NoError(t, err)
+		return len(res.Valsets)
+	}
+	o := b.Orchestrators[0]
+	require.NotZero(t, pending(o))
+	require.NoError(t, o.SignPending(context.Background()))
+	require.Zero(t, pending(o))
+	require.NotZero(t, pending(b.Orchestrators[1]))
+	// nothing is left to sign
+	require.NoError(t, o.SignPending(context.Background()))
+
+	// confirms signed with a key which is not the validator's Ethereum delegate key are rejected
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	wrongKey, err := orchestrator.New(orchestrator.Config{
+		GravityAddress:      b.Gravity,
+		OrchestratorAddress: keeper.OrchAddrs[1],
+		EthKey:              key,
+	}, client, b.Eth)
+	require.NoError(t, err)
+	require.Error(t, wrongKey.SignPending(context.Background()))
+	require.NotZero(t, pending(b.Orchestrators[1]))
+}
+
+//nolint: exhaustivestruct
+func TestReportEvents(t *testing.T) {
+	b := ethsim.NewBridge(t)
+	client := ethsim.NewCosmosClient(&b.Input)
+	orch := keeper.OrchAddrs[0]
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newOrchestrator := func(startBlock uint64) *orchestrator.Orchestrator {
+		o, err := orchestrator.New(orchestrator.Config{
+			GravityAddress:      b.Gravity,
+			OrchestratorAddress: orch,
+			EthKey:              key,
+			StartBlock:          startBlock,
+			BlockDelay:          1,
+			BlocksToSearch:      1,
+		}, client, b.Eth)
+		require.NoError(t, err)
+		return o
+	}
+	o := newOrchestrator(0)
+	initial := lastEventNonce(t, client, orch)
+
+	// the deployment is in the head block, which is not buried deep enough yet
+	deployBlock := b.Eth.Blockchain().CurrentBlock().NumberU64()
+	for i := uint64(0); i < deployBlock; i++ {
+		require.NoError(t, o.ReportEvents(context.Background()))
+	}
+	require.Equal(t, initial, lastEventNonce(t, client, orch))
+	b.Eth.Commit()
+	require.NoError(t, o.ReportEvents(context.Background()))
+	require.Equal(t, initial+1, lastEventNonce(t, client, orch))
+
+	// an orchestrator starting after the first of two deposits can not attest to the second one
+	token, err := b.Eth.DeployERC20("Simulated Token", "SIM", 6)
+	require.NoError(t, err)
+	user := b.EthUsers[0]
+	require.NoError(t, b.Eth.Mint(token, crypto.PubkeyToAddress(user.PublicKey), big.NewInt(100)))
+	for i := 0; i < 2; i++ {
+		require.NoError(t, b.Deposit(user, token, keeper.AccAddrs[0], big.NewInt(50)))
+		b.Eth.Commit()
+	}
+	late := newOrchestrator(b.Eth.Blockchain().CurrentBlock().NumberU64())
+	b.Eth.Commit()
+	err = late.ReportEvents(context.Background())
+	require.True(t, errors.Is(err, orchestrator.ErrMissedEvents), err)
+	require.Equal(t, initial+1, lastEventNonce(t, client, orch))
+
+	// the orchestrator which scanned from the start attests to both, one block per call
+	for i := 0; i < 5 && lastEventNonce(t, client, orch) < initial+3; i++ {
+		require.NoError(t, o.ReportEvents(context.Background()))
+	}
+	require.Equal(t, initial+3, lastEventNonce(t, client, orch))
+}
+
+// Tests that before the claim data height orchestrators which do not report the tx hash and token metadata still
+// vote on the same attestation as an orchestrator which does, and that the data is dropped from the claim
+//nolint: exhaustivestruct
+func TestReportEventsMixedOptionalData(t *testing.T) {
+	b := ethsim.NewBridge(t)
+	defer func() { b.Input.AssertInvariants() }()
+	client := ethsim.NewCosmosClient(&b.Input)
+	k := b.Input.GravityKeeper
+	params := k.GetParams(b.Context())
+	params.ClaimDataHeight = 1000
+	k.SetParams(b.Context(), params)
+	b.StepUntil(5, func() bool { return k.GetLastObservedEventNonce(b.Context()) == 2 })
+
+	token, err := b.Eth.DeployERC20("Simulated Token", "SIM", 6)
+	require.NoError(t, err)
+	user := b.EthUsers[0]
+	require.NoError(t, b.Eth.Mint(token, crypto.PubkeyToAddress(user.PublicKey), big.NewInt(100)))
+	require.NoError(t, b.Deposit(user, token, keeper.AccAddrs[0], big.NewInt(50)))
+	b.Eth.Commit()
+
+	// the claim an orchestrator without the optional data reports
+	bare := func(orch sdk.AccAddress) *types.MsgSendToCosmosClaim {
+		return &types.MsgSendToCosmosClaim{
+			EventNonce:     3,
+			BlockHeight:    b.Eth.Blockchain().CurrentBlock().NumberU64(),
+			TokenContract:  token.Hex(),
+			Amount:         sdk.NewInt(50),
+			EthereumSender: crypto.PubkeyToAddress(user.PublicKey).Hex(),
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+			Orchestrator:   orch.String(),
+		}
+	}
+	require.NoError(t, client.Broadcast(context.Background(), bare(keeper.OrchAddrs[1])))
+	require.NoError(t, b.Orchestrators[0].ReportEvents(context.Background()))
+	require.NoError(t, client.Broadcast(context.Background(), bare(keeper.OrchAddrs[2])))
+	require.NoError(t, client.Broadcast(context.Background(), bare(keeper.OrchAddrs[3])))
+	gravity.EndBlocker(b.Context(), k)
+
+	attestations, _ := k.GetAttestationMapping(b.Context())
+	require.Len(t, attestations[3], 1)
+	att := attestations[3][0]
+	require.True(t, att.Observed)
+	require.Len(t, att.Votes, 4)
+	claim, err := k.UnpackAttestationClaim(&att)
+	require.NoError(t, err)
+	deposit := claim.(*types.MsgSendToCosmosClaim)
+	require.False(t, deposit.HasClaimData())
+	require.False(t, deposit.HasTokenMetadata())
+
+	tokenAddr, err := types.NewEthAddress(token.Hex())
+	require.NoError(t, err)
+	denom := types.GravityDenom(*tokenAddr)
+	require.Equal(t, sdk.NewInt(50), b.Input.BankKeeper.GetBalance(b.Context(), keeper.AccAddrs[0], denom).Amount)
+	_, found := b.Input.BankKeeper.GetDenomMetaData(b.Context(), denom)
+	require.False(t, found)
+}
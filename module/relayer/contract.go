@@ -0,0 +1,51 @@
+package relayer
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ErrInsufficientPower is returned when the collected signatures do not hold enough power to be accepted by
+// Gravity.sol
+var ErrInsufficientPower = errors.New("signatures do not hold enough power")
+
+var gravityABI = types.MustParseABI(types.GravityABIJSON)
+
+// AssembleSignatures orders the hex encoded signatures of checkpoint by the members of the valset Ethereum
+// currently holds, members which did not sign or whose signature is invalid get an empty signature. It fails
+// with ErrInsufficientPower if the valid signatures do not hold enough power
+func AssembleSignatures(valset types.ValsetArgs, checkpoint []byte, signers map[gethcommon.Address]string) ([]types.Signature, error) {
+	sigs := make([]types.Signature, len(valset.Validators))
+	power := new(big.Int)
+	for i, validator := range valset.Validators {
+		signature, ok := signers[validator]
+		if !ok {
+			continue
+		}
+		bz, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+		if err != nil || len(bz) != 65 {
+			continue
+		}
+		ethAddress, err := types.NewEthAddress(validator.Hex())
+		if err != nil || types.ValidateEthereumSignature(checkpoint, bz, *ethAddress) != nil {
+			continue
+		}
+		copy(sigs[i].R[:], bz[:32])
+		copy(sigs[i].S[:], bz[32:64])
+		sigs[i].V = bz[64]
+		if sigs[i].V < 27 {
+			sigs[i].V += 27
+		}
+		power.Add(power, valset.Powers[i])
+	}
+	if power.Cmp(big.NewInt(types.GravityPowerThreshold)) <= 0 {
+		return nil, ErrInsufficientPower
+	}
+	return sigs, nil
+}
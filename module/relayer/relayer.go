@@ -0,0 +1,388 @@
+// Package relayer submits the valsets, batches and logic calls signed by the validators to Gravity.sol and
+// requests batches when the fees waiting in the pool make them worth relaying. It reads the chain through
+// the gravity gRPC queries and talks to Ethereum through any bind.ContractBackend, so it runs as well
+// against an ethclient.Client as against a simulated backend
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/orchestrator"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ProfitableFunc decides whether the fees a batch pays to its relayer cover the cost of relaying it at
+// gasPrice wei per gas
+type ProfitableFunc func(fees types.BatchFees, gasPrice *big.Int) bool
+
+// Config holds the settings of a Relayer
+type Config struct {
+	// GravityAddress is the address of the Gravity.sol deployment the chain is bridged to
+	GravityAddress gethcommon.Address
+	// EthKey pays for and receives the fees of relayed transactions
+	EthKey *ecdsa.PrivateKey
+	// ChainID is the chain id of the Ethereum chain, used to sign transactions
+	ChainID *big.Int
+	// CosmosAddress sends the batch requests, RequestBatches does nothing when it is empty
+	CosmosAddress sdk.AccAddress
+	// Profitable is consulted before relaying or requesting a batch, a nil Profitable accepts every batch.
+	// Logic calls are always relayed
+	Profitable ProfitableFunc
+	// Logger receives the errors Run recovers from, defaults to a no-op logger
+	Logger log.Logger
+}
+
+// Relayer moves signed updates from the chain to Gravity.sol
+type Relayer struct {
+	cfg     Config
+	cosmos  orchestrator.CosmosClient
+	eth     bind.ContractBackend
+	gravity *bind.BoundContract
+}
+
+// New returns a Relayer for cfg reading the chain through cosmos and sending transactions through eth
+func New(cfg Config, cosmos orchestrator.CosmosClient, eth bind.ContractBackend) (*Relayer, error) {
+	if cfg.EthKey == nil {
+		return nil, errors.New("no Ethereum key")
+	}
+	if cfg.ChainID == nil {
+		return nil, errors.New("no Ethereum chain id")
+	}
+	if cfg.GravityAddress == (gethcommon.Address{}) {
+		return nil, errors.New("no Gravity.sol address")
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = log.NewNopLogger()
+	}
+	return &Relayer{
+		cfg:     cfg,
+		cosmos:  cosmos,
+		eth:     eth,
+		gravity: bind.NewBoundContract(cfg.GravityAddress, gravityABI, eth, eth, eth),
+	}, nil
+}
+
+// Run requests batches and relays every interval until ctx is done. Errors are logged and retried in the
+// next round
+func (r *Relayer) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.RequestBatches(ctx); err != nil {
+			r.cfg.Logger.Error("requesting batches", "err", err)
+		}
+		if err := r.Relay(ctx); err != nil {
+			r.cfg.Logger.Error("relaying", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// EthereumValset returns the validator set Gravity.sol currently holds, as emitted in the ValsetUpdatedEvent
+// for its last valset nonce
+func (r *Relayer) EthereumValset(ctx context.Context) (types.Valset, error) {
+	// the logs of pending transactions are not available, so the mined state has to be used here
+	nonce, err := r.callUint(ctx, false, "state_lastValsetNonce")
+	if err != nil {
+		return types.Valset{}, err
+	}
+	event := gravityABI.Events["ValsetUpdatedEvent"]
+	logs, err := r.eth.FilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []gethcommon.Address{r.cfg.GravityAddress},
+		Topics:    [][]gethcommon.Hash{{event.ID}, {gethcommon.BigToHash(nonce)}},
+	})
+	if err != nil {
+		return types.Valset{}, err
+	}
+	if len(logs) == 0 {
+		return types.Valset{}, fmt.Errorf("no ValsetUpdatedEvent for valset %d", nonce)
+	}
+	fields := make(map[string]interface{})
+	if err := gravityABI.UnpackIntoMap(fields, event.Name, logs[len(logs)-1].Data); err != nil {
+		return types.Valset{}, err
+	}
+	validators := fields["_validators"].([]gethcommon.Address)
+	powers := fields["_powers"].([]*big.Int)
+	members := make(types.BridgeValidators, len(validators))
+	for i := range validators {
+		members[i] = types.BridgeValidator{Power: powers[i].Uint64(), EthereumAddress: validators[i].Hex()}
+	}
+	return types.Valset{
+		Nonce:        nonce.Uint64(),
+		Members:      members,
+		RewardAmount: sdk.NewIntFromBigInt(fields["_rewardAmount"].(*big.Int)),
+		RewardToken:  fields["_rewardToken"].(gethcommon.Address).Hex(),
+	}, nil
+}
+
+// Relay submits the newest signed valset Gravity.sol does not have, then for every token the newest signed
+// and profitable batch and finally every signed logic call. Transactions which would revert are not sent, a
+// batch or logic call which fails to be sent is logged and skipped so that it does not hold up the others
+func (r *Relayer) Relay(ctx context.Context) error {
+	current, err := r.EthereumValset(ctx)
+	if err != nil {
+		return fmt.Errorf("reading the valset of Gravity.sol: %w", err)
+	}
+	gravityID, err := r.gravityID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := r.relayValsets(ctx, gravityID, current); err != nil {
+		return err
+	}
+	// batches and logic calls are signed against the valset Gravity.sol holds, a valset update sent above is
+	// not mined yet so they are relayed with the current valset until it is
+	if err := r.relayBatches(ctx, gravityID, current); err != nil {
+		return err
+	}
+	return r.relayLogicCalls(ctx, gravityID, current)
+}
+
+// relayValsets submits the newest valset which current signed enough of
+func (r *Relayer) relayValsets(ctx context.Context, gravityID string, current types.Valset) error {
+	res, err := r.cosmos.LastValsetRequests(ctx, &types.QueryLastValsetRequestsRequest{})
+	if err != nil {
+		return fmt.Errorf("querying valsets: %w", err)
+	}
+	// an update sent in an earlier round may still be waiting to be mined
+	pendingNonce, err := r.callUint(ctx, true, "state_lastValsetNonce")
+	if err != nil {
+		return err
+	}
+	valsets := res.Valsets
+	sort.Slice(valsets, func(i, j int) bool { return valsets[i].Nonce > valsets[j].Nonce })
+	currentArgs := types.NewValsetArgs(current)
+	for _, valset := range valsets {
+		if valset.Nonce <= pendingNonce.Uint64() {
+			break
+		}
+		confirms, err := r.cosmos.ValsetConfirmsByNonce(ctx, &types.QueryValsetConfirmsByNonceRequest{Nonce: valset.Nonce})
+		if err != nil {
+			return fmt.Errorf("querying confirms of valset %d: %w", valset.Nonce, err)
+		}
+		signers := make(map[gethcommon.Address]string, len(confirms.Confirms))
+		for _, confirm := range confirms.Confirms {
+			signers[gethcommon.HexToAddress(confirm.EthAddress)] = confirm.Signature
+		}
+		sigs, err := AssembleSignatures(currentArgs, valset.GetCheckpoint(gravityID), signers)
+		if err != nil {
+			continue
+		}
+		if err := r.transact(ctx, "updateValset", types.NewValsetArgs(valset), currentArgs, sigs); err != nil {
+			return fmt.Errorf("relaying valset %d: %w", valset.Nonce, err)
+		}
+		return nil
+	}
+	return nil
+}
+
+// relayBatches submits, for every token, the newest batch current signed enough of which has not been
+// executed, timed out or been judged unprofitable
+func (r *Relayer) relayBatches(ctx context.Context, gravityID string, current types.Valset) error {
+	res, err := r.cosmos.OutgoingTxBatches(ctx, &types.QueryOutgoingTxBatchesRequest{})
+	if err != nil {
+		return fmt.Errorf("querying batches: %w", err)
+	}
+	batches := res.Batches
+	sort.Slice(batches, func(i, j int) bool { return batches[i].BatchNonce > batches[j].BatchNonce })
+	nextBlock, err := r.nextBlock(ctx)
+	if err != nil {
+		return err
+	}
+	gasPrice, err := r.eth.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	currentArgs := types.NewValsetArgs(current)
+	relayed := make(map[string]bool)
+	for _, batch := range batches {
+		// a newer batch for the same token invalidates the older ones
+		if relayed[batch.TokenContract] || batch.BatchTimeout <= nextBlock {
+			continue
+		}
+		token := gethcommon.HexToAddress(batch.TokenContract)
+		lastNonce, err := r.callUint(ctx, true, "lastBatchNonce", token)
+		if err != nil {
+			return err
+		}
+		if batch.BatchNonce <= lastNonce.Uint64() {
+			continue
+		}
+		if !r.profitable(BatchFeesOf(batch), gasPrice) {
+			continue
+		}
+		confirms, err := r.cosmos.BatchConfirms(ctx, &types.QueryBatchConfirmsRequest{Nonce: batch.BatchNonce, ContractAddress: batch.TokenContract})
+		if err != nil {
+			return fmt.Errorf("querying confirms of batch %d of %s: %w", batch.BatchNonce, batch.TokenContract, err)
+		}
+		signers := make(map[gethcommon.Address]string, len(confirms.Confirms))
+		for _, confirm := range confirms.Confirms {
+			signers[gethcommon.HexToAddress(confirm.EthSigner)] = confirm.Signature
+		}
+		sigs, err := AssembleSignatures(currentArgs, batch.GetCheckpoint(gravityID), signers)
+		if err != nil {
+			continue
+		}
+		amounts := make([]*big.Int, len(batch.Transactions))
+		destinations := make([]gethcommon.Address, len(batch.Transactions))
+		fees := make([]*big.Int, len(batch.Transactions))
+		for i, tx := range batch.Transactions {
+			amounts[i] = tx.Erc20Token.Amount.BigInt()
+			destinations[i] = gethcommon.HexToAddress(tx.DestAddress)
+			fees[i] = tx.Erc20Fee.Amount.BigInt()
+		}
+		if err := r.transact(ctx, "submitBatch", currentArgs, sigs, amounts, destinations, fees,
+			new(big.Int).SetUint64(batch.BatchNonce), token, new(big.Int).SetUint64(batch.BatchTimeout)); err != nil {
+			r.cfg.Logger.Error("relaying batch", "nonce", batch.BatchNonce, "token", batch.TokenContract, "err", err)
+			continue
+		}
+		relayed[batch.TokenContract] = true
+	}
+	return nil
+}
+
+// relayLogicCalls submits every logic call current signed enough of which has not been executed or timed out
+func (r *Relayer) relayLogicCalls(ctx context.Context, gravityID string, current types.Valset) error {
+	res, err := r.cosmos.OutgoingLogicCalls(ctx, &types.QueryOutgoingLogicCallsRequest{})
+	if err != nil {
+		return fmt.Errorf("querying logic calls: %w", err)
+	}
+	nextBlock, err := r.nextBlock(ctx)
+	if err != nil {
+		return err
+	}
+	currentArgs := types.NewValsetArgs(current)
+	for _, call := range res.Calls {
+		if call.Timeout <= nextBlock {
+			continue
+		}
+		args := types.NewLogicCallArgs(call)
+		lastNonce, err := r.callUint(ctx, true, "lastLogicCallNonce", args.InvalidationId)
+		if err != nil {
+			return err
+		}
+		if call.InvalidationNonce <= lastNonce.Uint64() {
+			continue
+		}
+		confirms, err := r.cosmos.LogicConfirms(ctx, &types.QueryLogicConfirmsRequest{
+			InvalidationId:    call.InvalidationId,
+			InvalidationNonce: call.InvalidationNonce,
+		})
+		if err != nil {
+			return fmt.Errorf("querying confirms of logic call %x/%d: %w", call.InvalidationId, call.InvalidationNonce, err)
+		}
+		signers := make(map[gethcommon.Address]string, len(confirms.Confirms))
+		for _, confirm := range confirms.Confirms {
+			signers[gethcommon.HexToAddress(confirm.EthSigner)] = confirm.Signature
+		}
+		sigs, err := AssembleSignatures(currentArgs, call.GetCheckpoint(gravityID), signers)
+		if err != nil {
+			continue
+		}
+		if err := r.transact(ctx, "submitLogicCall", currentArgs, sigs, args); err != nil {
+			r.cfg.Logger.Error("relaying logic call", "invalidation_id", fmt.Sprintf("%x", call.InvalidationId),
+				"invalidation_nonce", call.InvalidationNonce, "err", err)
+			continue
+		}
+	}
+	return nil
+}
+
+// RequestBatches asks the chain for a batch of every token whose unbatched transactions pay profitable
+// fees according to BatchFees. The chain refuses a request which would not beat the fees of the batch
+// already waiting for that token, such refusals are logged and skipped
+func (r *Relayer) RequestBatches(ctx context.Context) error {
+	if r.cfg.CosmosAddress.Empty() {
+		return nil
+	}
+	res, err := r.cosmos.BatchFees(ctx, &types.QueryBatchFeeRequest{})
+	if err != nil {
+		return fmt.Errorf("querying batch fees: %w", err)
+	}
+	gasPrice, err := r.eth.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	for _, fees := range res.BatchFees {
+		if !r.profitable(fees, gasPrice) {
+			continue
+		}
+		denom, err := r.cosmos.ERC20ToDenom(ctx, &types.QueryERC20ToDenomRequest{Erc20: fees.Token})
+		if err != nil {
+			return fmt.Errorf("querying denom of %s: %w", fees.Token, err)
+		}
+		msg := &types.MsgRequestBatch{Sender: r.cfg.CosmosAddress.String(), Denom: denom.Denom}
+		if err := r.cosmos.Broadcast(ctx, msg); err != nil {
+			r.cfg.Logger.Info("batch request refused", "denom", denom.Denom, "err", err)
+		}
+	}
+	return nil
+}
+
+// BatchFeesOf sums up the fees a batch pays to its relayer
+func BatchFeesOf(batch types.OutgoingTxBatch) types.BatchFees {
+	total := sdk.ZeroInt()
+	for _, tx := range batch.Transactions {
+		total = total.Add(tx.Erc20Fee.Amount)
+	}
+	return types.BatchFees{Token: batch.TokenContract, TotalFees: total, TxCount: uint64(len(batch.Transactions))}
+}
+
+func (r *Relayer) profitable(fees types.BatchFees, gasPrice *big.Int) bool {
+	return r.cfg.Profitable == nil || r.cfg.Profitable(fees, gasPrice)
+}
+
+func (r *Relayer) gravityID(ctx context.Context) (string, error) {
+	params, err := r.cosmos.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return "", fmt.Errorf("querying params: %w", err)
+	}
+	return params.Params.GravityId, nil
+}
+
+// nextBlock returns the number of the block the transactions sent now are included in at the earliest
+func (r *Relayer) nextBlock(ctx context.Context) (uint64, error) {
+	head, err := r.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("querying latest Ethereum block: %w", err)
+	}
+	return head.Number.Uint64() + 1, nil
+}
+
+// callUint calls a Gravity.sol getter returning a uint256, against the pending state when pending is set so
+// transactions sent earlier in the same round are taken into account
+func (r *Relayer) callUint(ctx context.Context, pending bool, method string, args ...interface{}) (*big.Int, error) {
+	var out []interface{}
+	if err := r.gravity.Call(&bind.CallOpts{Context: ctx, Pending: pending}, &out, method, args...); err != nil {
+		return nil, fmt.Errorf("calling %s: %w", method, err)
+	}
+	return out[0].(*big.Int), nil
+}
+
+// transact sends a transaction to Gravity.sol, the gas estimation makes it fail without sending anything
+// if the call would revert
+func (r *Relayer) transact(ctx context.Context, method string, args ...interface{}) error {
+	opts, err := bind.NewKeyedTransactorWithChainID(r.cfg.EthKey, r.cfg.ChainID)
+	if err != nil {
+		return err
+	}
+	opts.Context = ctx
+	_, err = r.gravity.Transact(opts, method, args...)
+	return err
+}
@@ -0,0 +1,218 @@
+package relayer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/relayer"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/ethsim"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+//nolint: exhaustivestruct
+func TestAssembleSignatures(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	valset := types.Valset{Nonce: 1, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddressString}
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		valset.Members = append(valset.Members, types.BridgeValidator{
+			Power:           1431655766,
+			EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+	args := types.NewValsetArgs(valset)
+	checkpoint := valset.GetCheckpoint("testgravityid")
+	sign := func(key *ecdsa.PrivateKey, hash []byte) string {
+		sig, err := types.NewEthereumSignature(hash, key)
+		require.NoError(t, err)
+		return hex.EncodeToString(sig)
+	}
+	addr := func(i int) gethcommon.Address { return crypto.PubkeyToAddress(keys[i].PublicKey) }
+
+	// a single signer does not hold enough power
+	_, err := relayer.AssembleSignatures(args, checkpoint, map[gethcommon.Address]string{addr(0): sign(keys[0], checkpoint)})
+	require.True(t, errors.Is(err, relayer.ErrInsufficientPower))
+
+	// a signature over another checkpoint does not count
+	_, err = relayer.AssembleSignatures(args, checkpoint, map[gethcommon.Address]string{
+		addr(0): sign(keys[0], checkpoint),
+		addr(1): sign(keys[1], valset.GetCheckpoint("othergravityid")),
+	})
+	require.True(t, errors.Is(err, relayer.ErrInsufficientPower))
+
+	// signatures are placed at the index of their member, with V in the 27/28 form
+	sigs, err := relayer.AssembleSignatures(args, checkpoint, map[gethcommon.Address]string{
+		addr(0): sign(keys[0], checkpoint),
+		addr(2): sign(keys[2], checkpoint),
+	})
+	require.NoError(t, err)
+	require.Len(t, sigs, 3)
+	require.Contains(t, []uint8{27, 28}, sigs[0].V)
+	require.Equal(t, types.Signature{}, sigs[1])
+	require.Contains(t, []uint8{27, 28}, sigs[2].V)
+}
+
+// minFees accepts the batches paying at least min of their token
+func minFees(min int64) relayer.ProfitableFunc {
+	return func(fees types.BatchFees, _ *big.Int) bool {
+		return fees.TotalFees.GTE(sdk.NewInt(min))
+	}
+}
+
+//nolint: exhaustivestruct
+func TestRelayProfitableBatches(t *testing.T) {
+	b := ethsim.NewBridge(t)
+	k := b.Input.GravityKeeper
+	b.StepUntil(5, func() bool {
+		return k.GetLastObservedEventNonce(b.Context()) == 2
+	})
+
+	// bring a token over and queue a transfer back paying a fee of 50
+	var (
+		user     = b.EthUsers[0]
+		userAddr = crypto.PubkeyToAddress(user.PublicKey)
+		sender   = keeper.AccAddrs[0]
+		dest     = crypto.PubkeyToAddress(b.EthUsers[1].PublicKey)
+	)
+	token, err := b.Eth.DeployERC20("Simulated Token", "SIM", 6)
+	require.NoError(t, err)
+	require.NoError(t, b.Eth.Mint(token, userAddr, big.NewInt(1000)))
+	require.NoError(t, b.Deposit(user, token, sender, big.NewInt(1000)))
+	ethAddr, err := types.NewEthAddress(token.Hex())
+	require.NoError(t, err)
+	denom := types.GravityDenom(*ethAddr)
+	b.StepUntil(5, func() bool {
+		return b.Input.BankKeeper.GetBalance(b.Context(), sender, denom).Amount.IsPositive()
+	})
+	_, err = gravity.NewHandler(k)(b.Context(), &types.MsgSendToEth{
+		Sender:    sender.String(),
+		EthDest:   dest.Hex(),
+		Amount:    sdk.NewCoin(denom, sdk.NewInt(100)),
+		BridgeFee: sdk.NewCoin(denom, sdk.NewInt(50)),
+	})
+	require.NoError(t, err)
+
+	cosmos := ethsim.NewCosmosClient(&b.Input)
+	newRelayer := func(profitable relayer.ProfitableFunc) *relayer.Relayer {
+		r, err := relayer.New(relayer.Config{
+			GravityAddress: b.Gravity,
+			EthKey:         b.Relayer,
+			ChainID:        b.Eth.Blockchain().Config().ChainID,
+			CosmosAddress:  keeper.OrchAddrs[0],
+			Profitable:     profitable,
+		}, cosmos, b.Eth)
+		require.NoError(t, err)
+		return r
+	}
+	strict, lenient := newRelayer(minFees(100)), newRelayer(minFees(50))
+	ctx := context.Background()
+
+	// the fees in the pool only satisfy the lenient relayer
+	require.NoError(t, strict.RequestBatches(ctx))
+	require.Empty(t, k.GetOutgoingTxBatches(b.Context()))
+	require.NoError(t, lenient.RequestBatches(ctx))
+	require.Len(t, k.GetOutgoingTxBatches(b.Context()), 1)
+	for _, o := range b.Orchestrators {
+		require.NoError(t, o.SignPending(ctx))
+	}
+
+	balance := func(addr gethcommon.Address) *big.Int {
+		balance, err := b.Eth.ERC20Balance(token, addr)
+		require.NoError(t, err)
+		return balance
+	}
+	require.NoError(t, strict.Relay(ctx))
+	b.Eth.Commit()
+	require.Zero(t, balance(dest).Sign())
+
+	require.NoError(t, lenient.Relay(ctx))
+	b.Eth.Commit()
+	require.Equal(t, big.NewInt(100), balance(dest))
+	require.Equal(t, big.NewInt(50), balance(crypto.PubkeyToAddress(b.Relayer.PublicKey)))
+
+	// the executed batch is not relayed again
+	require.NoError(t, lenient.Relay(ctx))
+	b.Eth.Commit()
+	require.Equal(t, big.NewInt(100), balance(dest))
+}
+
+//nolint: exhaustivestruct
+func TestRelaySkipsFailingBatch(t *testing.T) {
+	b := ethsim.NewBridge(t)
+	k := b.Input.GravityKeeper
+	b.StepUntil(5, func() bool {
+		return k.GetLastObservedEventNonce(b.Context()) == 2
+	})
+
+	var (
+		user     = b.EthUsers[0]
+		userAddr = crypto.PubkeyToAddress(user.PublicKey)
+		sender   = keeper.AccAddrs[0]
+		dest     = crypto.PubkeyToAddress(b.EthUsers[1].PublicKey)
+		h        = gravity.NewHandler(k)
+	)
+	token, err := b.Eth.DeployERC20("Simulated Token", "SIM", 6)
+	require.NoError(t, err)
+	require.NoError(t, b.Eth.Mint(token, userAddr, big.NewInt(1000)))
+	require.NoError(t, b.Deposit(user, token, sender, big.NewInt(1000)))
+	ethAddr, err := types.NewEthAddress(token.Hex())
+	require.NoError(t, err)
+	denom := types.GravityDenom(*ethAddr)
+	b.StepUntil(5, func() bool {
+		return b.Input.BankKeeper.GetBalance(b.Context(), sender, denom).Amount.IsPositive()
+	})
+
+	// vouchers of a token which has no contract on Ethereum, so its batch reverts
+	missing, err := types.NewEthAddress("0x1111111111111111111111111111111111111111")
+	require.NoError(t, err)
+	missingDenom := types.GravityDenom(*missing)
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(missingDenom, 1000))
+	require.NoError(t, b.Input.BankKeeper.MintCoins(b.Context(), types.ModuleName, vouchers))
+	require.NoError(t, b.Input.BankKeeper.SendCoinsFromModuleToAccount(b.Context(), types.ModuleName, sender, vouchers))
+
+	// the working batch gets the lower nonce, so the failing one is tried first
+	for _, d := range []string{denom, missingDenom} {
+		_, err = h(b.Context(), &types.MsgSendToEth{
+			Sender:    sender.String(),
+			EthDest:   dest.Hex(),
+			Amount:    sdk.NewCoin(d, sdk.NewInt(100)),
+			BridgeFee: sdk.NewCoin(d, sdk.NewInt(50)),
+		})
+		require.NoError(t, err)
+		_, err = h(b.Context(), &types.MsgRequestBatch{Sender: keeper.OrchAddrs[0].String(), Denom: d})
+		require.NoError(t, err)
+	}
+	// each round signs one pending batch
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		for _, o := range b.Orchestrators {
+			require.NoError(t, o.SignPending(ctx))
+		}
+	}
+
+	r, err := relayer.New(relayer.Config{
+		GravityAddress: b.Gravity,
+		EthKey:         b.Relayer,
+		ChainID:        b.Eth.Blockchain().Config().ChainID,
+	}, ethsim.NewCosmosClient(&b.Input), b.Eth)
+	require.NoError(t, err)
+	require.NoError(t, r.Relay(ctx))
+	b.Eth.Commit()
+	balance, err := b.Eth.ERC20Balance(token, dest)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), balance)
+}
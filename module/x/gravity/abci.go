@@ -10,14 +10,19 @@ import (
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	circuitBreaker(ctx, k)
 	params := k.GetParams(ctx)
 	slashing(ctx, k)
 	attestationTally(ctx, k)
+	releaseRateLimitedDeposits(ctx, k, params)
 	cleanupTimedOutBatches(ctx, k)
 	cleanupTimedOutLogicCalls(ctx, k)
 	createValsets(ctx, k)
 	pruneValsets(ctx, k, params)
 	pruneAttestations(ctx, k)
+	k.ExpireMerkleAirdrops(ctx)
+	k.PruneExecutedBatchArchive(ctx)
+	k.PruneBridgeSlashingEvents(ctx)
 }
 
 func createValsets(ctx sdk.Context, k keeper.Keeper) {
@@ -96,6 +101,35 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	logicCallSlashing(ctx, k, params)
 }
 
+// circuitBreaker halts the bridge, rather than the whole chain, when the module escrow no longer matches the
+// transfers in flight or a token sees an inflow spike. Once tripped the bridge stays halted, even if governance
+// sets BridgeActive again, until an UnhaltBridgeProposal passes
+func circuitBreaker(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	if _, tripped := k.GetCircuitBreakerTrip(ctx); tripped {
+		if params.BridgeActive {
+			params.BridgeActive = false
+			k.SetParams(ctx, params)
+		}
+		return
+	}
+	if !params.BridgeActive {
+		return
+	}
+	if reason, tokenContract, trip := k.CheckCircuitBreaker(ctx); trip {
+		k.TripCircuitBreaker(ctx, reason, tokenContract)
+	}
+}
+
+// releaseRateLimitedDeposits credits deposits which were queued for exceeding their token's inflow limit as
+// capacity frees up, like attestations these are not processed while the bridge is halted
+func releaseRateLimitedDeposits(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	if !params.BridgeActive {
+		return
+	}
+	k.ProcessRateLimitedDeposits(ctx)
+}
+
 // Iterate over all attestations currently being voted on in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
@@ -153,7 +187,7 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 	batches := k.GetOutgoingTxBatches(ctx)
 	for _, batch := range batches {
 		if batch.BatchTimeout < ethereumHeight {
-			err := k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+			err := k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce, types.UnbatchReasonTimeout)
 			if err != nil {
 				panic("Failed to cancel outgoing txbatch!")
 			}
@@ -206,7 +240,8 @@ func prepValsetConfirms(ctx sdk.Context, k keeper.Keeper, nonce uint64) map[stri
 	return ret
 }
 
-// valsetSlashing slashes validators who have not signed validator sets during the signing window
+// valsetSlashing slashes validators who have not signed validator sets during the signing window,
+// the decision of who owed a signature is made by SignatureOwed so that it matches the MissedSignatures query
 func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// don't slash in the beginning before there aren't even SignedValsetsWindow blocks yet
 	if uint64(ctx.BlockHeight()) <= params.SignedValsetsWindow {
@@ -215,87 +250,30 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 
 	unslashedValsets := k.GetUnSlashedValsets(ctx, params.SignedValsetsWindow)
 
-	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	unbondingValidators := getUnbondingValidators(ctx, k)
+	// Bonded validators and unbonding validators (who must still sign valsets so they can leave the set)
+	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	for _, valAddr := range getUnbondingValidators(ctx, k) {
+		addr, err := sdk.ValAddressFromBech32(valAddr)
+		if err != nil {
+			panic(err)
+		}
+		validator, found := k.StakingKeeper.GetValidator(ctx, addr)
+		if !found {
+			panic("Unable to find validator!")
+		}
+		validators = append(validators, validator)
+	}
 
 	for _, vs := range unslashedValsets {
 		confirms := prepValsetConfirms(ctx, k, vs.Nonce)
 
-		// SLASH BONDED VALIDTORS who didn't attest valset request
-
-		for _, val := range currentBondedSet {
-			consAddr, err := val.GetConsAddr()
-			if err != nil {
-				panic("Failed to get validator consensus addr")
-			}
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-
-			//  Slash validator ONLY if he joined before valset is created
-			startedBeforeValsetCreated := valSigningInfo.StartHeight < int64(vs.Height)
-
-			if exist && startedBeforeValsetCreated {
-				// Check if validator has confirmed valset or not
-				_, found := confirms[val.GetOperator().String()]
-				// slash validators for not confirming valsets
-				if !found {
-					// refresh validator before slashing/jailing
-					val = updateValidator(ctx, k, val.GetOperator())
-					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
-						ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyValsetSignatureSlashing,
-								Address: consAddr.String(),
-							},
-						)
-
-						k.StakingKeeper.Jail(ctx, consAddr)
-					}
-
-				}
-			}
-		}
-
-		// SLASH UNBONDING VALIDATORS who didn't attest valset request
-
-		for _, valAddr := range unbondingValidators {
-			addr, err := sdk.ValAddressFromBech32(valAddr)
-			if err != nil {
-				panic(err)
-			}
-			validator, found := k.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
-			if !found {
-				panic("Unable to find validator!")
-			}
-			valConsAddr, err := validator.GetConsAddr()
-			if err != nil {
-				panic(err)
+		for _, val := range validators {
+			if !k.SignatureOwed(ctx, params, val, types.SIGN_TYPE_ORCHESTRATOR_SIGNED_MULTI_SIG_UPDATE, vs.Height) {
+				continue
 			}
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
-
-			// Only slash validators who joined after valset is created and they are unbonding and UNBOND_SLASHING_WINDOW hasn't passed
-			startedBeforeValsetCreated := valSigningInfo.StartHeight < int64(vs.Height)
-			unbondingPeriodEndsAfterSlashingPeriod := vs.Height < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow
-
-			if exist && startedBeforeValsetCreated && validator.IsUnbonding() && unbondingPeriodEndsAfterSlashingPeriod {
-				// Check if validator has confirmed valset or not
-				_, found := confirms[validator.GetOperator().String()]
-
-				// slash validators for not confirming valsets
-				if !found {
-					// refresh validator before slashing/jailing
-					validator = updateValidator(ctx, k, validator.GetOperator())
-					if !validator.IsJailed() {
-						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
-						ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyValsetSignatureSlashing,
-								Address: valConsAddr.String(),
-							},
-						)
-						k.StakingKeeper.Jail(ctx, valConsAddr)
-					}
-				}
+			// Check if validator has confirmed valset or not
+			if _, found := confirms[val.GetOperator().String()]; !found {
+				slashValidator(ctx, k, val.GetOperator(), params.SlashFractionValset, types.AttributeKeyValsetSignatureSlashing)
 			}
 		}
 		// then we set the latest slashed valset  nonce
@@ -303,6 +281,29 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
+// slashValidator slashes and jails a validator for a missing signature, the validator is refreshed first
+// so that we never slash a validator twice in the same block
+func slashValidator(ctx sdk.Context, k keeper.Keeper, valAddr sdk.ValAddress, fraction sdk.Dec, slashType string) {
+	// refresh validator before slashing/jailing
+	val := updateValidator(ctx, k, valAddr)
+	if val.IsJailed() {
+		return
+	}
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		panic("Failed to get validator consensus addr")
+	}
+	k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), fraction)
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventSignatureSlashing{
+			Type:    slashType,
+			Address: consAddr.String(),
+		},
+	)
+	k.StakingKeeper.Jail(ctx, consAddr)
+	k.SetBridgeSlashingEvent(ctx, valAddr, slashType)
+}
+
 // updateValidator is a very specific utility function, used to update the validator object during
 // slashing loops. This allows us to load the validators list at the start of our slashing and only
 // pull in individual validators as needed to check that we are not jailing them twice, or slashing
@@ -377,32 +378,12 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
 		confirms := prepBatchConfirms(ctx, k, batch)
 		for _, val := range currentBondedSet {
-			consAddr, err := val.GetConsAddr()
-			if err != nil {
-				panic(err)
+			if !k.SignatureOwed(ctx, params, val, types.SIGN_TYPE_ORCHESTRATOR_SIGNED_WITHDRAW_BATCH, batch.Block) {
+				continue
 			}
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-
-			// Don't slash validators who joined after batch is created
-			startedBeforeBatchCreated := valSigningInfo.StartHeight < int64(batch.Block)
-			if exist && startedBeforeBatchCreated {
-				// check if validator confirmed the batch
-				_, found := confirms[val.GetOperator().String()]
-				// slashing for not confirming the batch
-				if !found {
-					// refresh validator before slashing/jailing
-					val = updateValidator(ctx, k, val.GetOperator())
-					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch)
-						ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyBatchSignatureSlashing,
-								Address: consAddr.String(),
-							},
-						)
-						k.StakingKeeper.Jail(ctx, consAddr)
-					}
-				}
+			// slashing for not confirming the batch
+			if _, found := confirms[val.GetOperator().String()]; !found {
+				slashValidator(ctx, k, val.GetOperator(), params.SlashFractionBatch, types.AttributeKeyBatchSignatureSlashing)
 			}
 		}
 		// then we set the latest slashed batch block
@@ -438,10 +419,10 @@ func prepLogicCallConfirms(ctx sdk.Context, k keeper.Keeper, call types.Outgoing
 // because validator set updates must succeed as validators leave the set, logicCalls will just be re-created
 func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// We look through the full bonded set (the active set)
-	// and we slash users who haven't signed a batch confirmation that is >15hrs in blocks old
+	// and we slash users who haven't signed a logic call confirmation within the window
 	var maxHeight uint64
 
-	// don't slash in the beginning before there aren't even SignedBatchesWindow blocks yet
+	// don't slash in the beginning before there aren't even SignedLogicCallsWindow blocks yet
 	if uint64(ctx.BlockHeight()) > params.SignedLogicCallsWindow {
 		maxHeight = uint64(ctx.BlockHeight()) - params.SignedLogicCallsWindow
 	} else {
@@ -452,34 +433,15 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight)
 	for _, call := range unslashedLogicCalls {
-
-		// SLASH BONDED VALIDTORS who didn't attest batch requests
+		// SLASH BONDED VALIDTORS who didn't attest logic calls
 		confirms := prepLogicCallConfirms(ctx, k, call)
 		for _, val := range currentBondedSet {
-			// Don't slash validators who joined after batch is created
-			consAddr, err := val.GetConsAddr()
-			if err != nil {
-				panic(err)
+			if !k.SignatureOwed(ctx, params, val, types.SIGN_TYPE_ORCHESTRATOR_SIGNED_LOGIC_CALL, call.Block) {
+				continue
 			}
-			valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
-			startedBeforeCallCreated := valSigningInfo.StartHeight < int64(call.Block)
-			if exist && startedBeforeCallCreated {
-				// check that the validator confirmed the logic call
-				_, found := confirms[val.GetOperator().String()]
-				if !found {
-					// refresh validator before slashing/jailing
-					val = updateValidator(ctx, k, val.GetOperator())
-					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionLogicCall)
-						ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyLogicCallSignatureSlashing,
-								Address: consAddr.String(),
-							},
-						)
-						k.StakingKeeper.Jail(ctx, consAddr)
-					}
-				}
+			// check that the validator confirmed the logic call
+			if _, found := confirms[val.GetOperator().String()]; !found {
+				slashValidator(ctx, k, val.GetOperator(), params.SlashFractionLogicCall, types.AttributeKeyLogicCallSignatureSlashing)
 			}
 		}
 		// then we set the latest slashed logic call block
@@ -341,6 +341,7 @@ func TestNonValidatorBatchConfirm(t *testing.T) {
 	})
 	require.NoError(t, err)
 	pk.StoreBatch(ctx, *batch)
+	ctx.KVStore(input.GravityStoreKey).Set(types.KeyLastOutgoingBatchID, types.UInt64Bytes(batch.BatchNonce))
 	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))
 	assert.True(t, len(unslashedBatches) == 1 && unslashedBatches[0].BatchNonce == 1)
 
@@ -394,6 +395,7 @@ func TestBatchSlashing(t *testing.T) {
 	})
 	require.NoError(t, err)
 	pk.StoreBatch(ctx, *batch)
+	ctx.KVStore(input.GravityStoreKey).Set(types.KeyLastOutgoingBatchID, types.UInt64Bytes(batch.BatchNonce))
 	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))
 	assert.True(t, len(unslashedBatches) == 1 && unslashedBatches[0].BatchNonce == 1)
 
@@ -444,6 +446,120 @@ func TestBatchSlashing(t *testing.T) {
 
 }
 
+// Validators which rejoined the active set or registered their delegate keys after a batch was created
+// did not owe a signature for it, the MissedSignatures query must agree with what is slashed
+func TestBatchSlashing_SigningObligations(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  0,
+		Transactions:  []types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
+	})
+	require.NoError(t, err)
+	pk.StoreBatch(ctx, *batch)
+	ctx.KVStore(input.GravityStoreKey).Set(types.KeyLastOutgoingBatchID, types.UInt64Bytes(batch.BatchNonce))
+
+	// the first validator owes a signature, the second was bonded again after the batch was created
+	// and the third registered its delegate keys after the batch was created
+	pk.SetValidatorBondedHeight(ctx, keeper.ValAddrs[1], batch.Block)
+	pk.SetDelegateKeyRegistrationHeight(ctx, keeper.ValAddrs[2], batch.Block+1)
+	for i, orch := range keeper.OrchAddrs {
+		if i < 3 {
+			continue
+		}
+		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: keeper.TokenContractAddrs[0],
+			EthSigner:     keeper.EthAddrs[i].String(),
+			Orchestrator:  orch.String(),
+			Signature:     "",
+		})
+	}
+
+	missedBatches := func(val sdk.ValAddress) (out []types.MissedSignature) {
+		res, err := pk.MissedSignatures(sdk.WrapSDKContext(ctx), &types.QueryMissedSignaturesRequest{ValidatorAddress: val.String()})
+		require.NoError(t, err)
+		for _, missed := range res.MissedSignatures {
+			if missed.SignType == types.SIGN_TYPE_ORCHESTRATOR_SIGNED_WITHDRAW_BATCH {
+				out = append(out, missed)
+			}
+		}
+		return out
+	}
+	missed := missedBatches(keeper.ValAddrs[0])
+	require.Len(t, missed, 1)
+	assert.Equal(t, batch.BatchNonce, missed[0].Nonce)
+	assert.Equal(t, batch.Block+params.SignedBatchesWindow+1, missed[0].SlashingHeight)
+	assert.LessOrEqual(t, missed[0].SlashingHeight, uint64(ctx.BlockHeight()))
+	for i := 1; i < len(keeper.ValAddrs); i++ {
+		assert.Empty(t, missedBatches(keeper.ValAddrs[i]))
+	}
+
+	EndBlocker(ctx, pk)
+
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	for i := 1; i < len(keeper.ValAddrs); i++ {
+		require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i]).IsJailed())
+	}
+}
+
+// Logic calls must not be slashed until SignedLogicCallsWindow blocks after they were created
+func TestLogicCallSigningWindow(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedLogicCallsWindow) + 2)
+
+	call := types.OutgoingLogicCall{
+		Transfers:            []types.ERC20Token{},
+		Fees:                 []types.ERC20Token{},
+		LogicContractAddress: keeper.TokenContractAddrs[0],
+		Payload:              []byte("payload"),
+		Timeout:              10000,
+		InvalidationId:       []byte("invalidation"),
+		InvalidationNonce:    1,
+		Block:                uint64(ctx.BlockHeight()) - 1,
+	}
+	pk.SetOutgoingLogicCall(ctx, call)
+
+	// the signing window is still open so the call is not ready to slash
+	require.Empty(t, pk.GetUnSlashedLogicCalls(ctx, uint64(ctx.BlockHeight())-params.SignedLogicCallsWindow))
+	require.Len(t, pk.GetUnSlashedLogicCalls(ctx, call.Block+1), 1)
+
+	validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).(stakingtypes.Validator)
+	missed := pk.GetMissedSignatures(ctx, validator)
+	require.Len(t, missed, 1)
+	assert.Equal(t, types.SIGN_TYPE_ORCHESTRATOR_SIGNED_LOGIC_CALL, missed[0].SignType)
+	assert.Equal(t, call.InvalidationNonce, missed[0].Nonce)
+	assert.Equal(t, call.Block+params.SignedLogicCallsWindow+1, missed[0].SlashingHeight)
+
+	// once the slashing cursor has moved past the call it is no longer reported
+	slashedCtx, _ := ctx.CacheContext()
+	pk.SetLastSlashedLogicCallBlock(slashedCtx, call.Block)
+	require.Empty(t, pk.GetMissedSignatures(slashedCtx, validator))
+
+	pk.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+		InvalidationId:    fmt.Sprintf("%x", call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+		EthSigner:         keeper.EthAddrs[0].String(),
+		Orchestrator:      keeper.OrchAddrs[0].String(),
+		Signature:         "",
+	})
+	require.Empty(t, pk.GetMissedSignatures(ctx, validator))
+}
+
 func TestValsetEmission(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
@@ -641,3 +757,176 @@ func TestValsetPruning(t *testing.T) {
 	require.Nil(t, pk.GetValset(ctx, firstValsetNonce))
 	require.Equal(t, 0, len(pk.GetValsetConfirms(ctx, firstValsetNonce)))
 }
+
+// Tests that an inflow spike trips the circuit breaker and that only an UnhaltBridgeProposal resumes the bridge
+func TestCircuitBreakerInflowSpike(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	pk := input.GravityKeeper
+
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	params := pk.GetParams(ctx)
+	params.RateLimits = []types.TokenRateLimit{{
+		TokenContract:           tokenContract,
+		Window:                  10,
+		InflowLimit:             sdk.ZeroInt(),
+		OutflowLimit:            sdk.ZeroInt(),
+		CircuitBreakerThreshold: sdk.NewInt(100),
+	}}
+	pk.SetParams(ctx, params)
+
+	deposit := func(nonce uint64, amount int64) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  tokenContract,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+		}
+		require.NoError(t, pk.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+
+	// below the threshold nothing happens
+	deposit(1, 60)
+	EndBlocker(ctx, pk)
+	require.True(t, pk.GetParams(ctx).BridgeActive)
+
+	deposit(2, 60)
+	EndBlocker(ctx, pk)
+	require.False(t, pk.GetParams(ctx).BridgeActive)
+	trip, tripped := pk.GetCircuitBreakerTrip(ctx)
+	require.True(t, tripped)
+	require.Equal(t, tokenContract, trip.TokenContract)
+	require.Equal(t, uint64(ctx.BlockHeight()), trip.BlockHeight)
+
+	// re-enabling the bridge through params is not enough
+	params = pk.GetParams(ctx)
+	params.BridgeActive = true
+	pk.SetParams(ctx, params)
+	EndBlocker(ctx, pk)
+	require.False(t, pk.GetParams(ctx).BridgeActive)
+
+	// governance can resume the bridge while the spike is still within the window, the reviewed inflow no longer
+	// counts towards the threshold
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, pk.HandleUnhaltBridgeProposal(ctx, &types.UnhaltBridgeProposal{
+		Title:       "Unhalt",
+		Description: "Resume the bridge",
+		TargetNonce: 2,
+	}))
+	require.True(t, pk.GetParams(ctx).BridgeActive)
+	EndBlocker(ctx, pk)
+	require.True(t, pk.GetParams(ctx).BridgeActive)
+	_, tripped = pk.GetCircuitBreakerTrip(ctx)
+	require.False(t, tripped)
+
+	// inflow after the unhalt is counted from zero
+	deposit(3, 60)
+	EndBlocker(ctx, pk)
+	require.True(t, pk.GetParams(ctx).BridgeActive)
+	deposit(4, 60)
+	EndBlocker(ctx, pk)
+	require.False(t, pk.GetParams(ctx).BridgeActive)
+}
+
+// Tests that deposits queued by the inflow limit do not count towards the circuit breaker threshold, so that
+// unhalting the bridge with a full queue does not trip the circuit breaker again as the queue drains
+func TestCircuitBreakerUnhaltWithQueuedDeposits(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	pk := input.GravityKeeper
+
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	contract, err := types.NewEthAddress(tokenContract)
+	require.NoError(t, err)
+	params := pk.GetParams(ctx)
+	params.RateLimits = []types.TokenRateLimit{{
+		TokenContract:           tokenContract,
+		Window:                  10,
+		InflowLimit:             sdk.NewInt(100),
+		OutflowLimit:            sdk.ZeroInt(),
+		CircuitBreakerThreshold: sdk.NewInt(150),
+	}}
+	pk.SetParams(ctx, params)
+
+	// one deposit fills the window and four more are queued
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  tokenContract,
+			Amount:         sdk.NewInt(100),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: keeper.AccAddrs[0].String(),
+		}
+		require.NoError(t, pk.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	require.Len(t, pk.GetRateLimitedDeposits(ctx, *contract), 4)
+
+	pk.TripCircuitBreaker(ctx, "halted for testing", "")
+	EndBlocker(ctx, pk)
+	require.False(t, pk.GetParams(ctx).BridgeActive)
+
+	require.NoError(t, pk.HandleUnhaltBridgeProposal(ctx, &types.UnhaltBridgeProposal{
+		Title:       "Unhalt",
+		Description: "Resume the bridge",
+		TargetNonce: 5,
+	}))
+
+	// the queue drains one window at a time without tripping the circuit breaker
+	for i := 0; i < 4; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		EndBlocker(ctx, pk)
+		require.True(t, pk.GetParams(ctx).BridgeActive)
+		require.Len(t, pk.GetRateLimitedDeposits(ctx, *contract), 3-i)
+	}
+	_, tripped := pk.GetCircuitBreakerTrip(ctx)
+	require.False(t, tripped)
+	balance := input.BankKeeper.GetBalance(ctx, keeper.AccAddrs[0], types.GravityDenom(*contract))
+	require.Equal(t, sdk.NewInt(500), balance.Amount)
+}
+
+// Tests that an UnhaltBridgeProposal which cannot reset the attestations fails and leaves the bridge halted
+func TestCircuitBreakerUnhaltInvalidNonce(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+
+	pk.TripCircuitBreaker(ctx, "halted for testing", "")
+	require.Error(t, pk.HandleUnhaltBridgeProposal(ctx, &types.UnhaltBridgeProposal{
+		Title:       "Unhalt",
+		Description: "Resume the bridge",
+		TargetNonce: 0,
+	}))
+	require.False(t, pk.GetParams(ctx).BridgeActive)
+	_, tripped := pk.GetCircuitBreakerTrip(ctx)
+	require.True(t, tripped)
+}
+
+// Tests that a mismatch between the module escrow and the transfers waiting to leave Cosmos trips the circuit
+// breaker instead of halting the chain
+func TestCircuitBreakerEscrowMismatch(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+
+	// the balances are only checked every CircuitBreakerBalanceCheckInterval blocks
+	height := uint64(ctx.BlockHeight())
+	ctx = ctx.WithBlockHeight(int64(height - height%keeper.CircuitBreakerBalanceCheckInterval + keeper.CircuitBreakerBalanceCheckInterval))
+	EndBlocker(ctx, pk)
+	require.True(t, pk.GetParams(ctx).BridgeActive)
+
+	// vouchers appearing in the module without a matching transfer
+	token, err := types.NewInternalERC20Token(sdk.NewInt(100), "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token.GravityCoin())))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	EndBlocker(ctx, pk)
+	require.True(t, pk.GetParams(ctx).BridgeActive)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + keeper.CircuitBreakerBalanceCheckInterval - 1)
+	EndBlocker(ctx, pk)
+	require.False(t, pk.GetParams(ctx).BridgeActive)
+	_, tripped := pk.GetCircuitBreakerTrip(ctx)
+	require.True(t, tripped)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -30,6 +31,18 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetPendingSendToEth(),
 		GetCmdPendingIbcAutoForwards(),
 		GetCmdQueryParams(),
+		CmdGetMissedSignatures(),
+		CmdGetValidatorBridgeStatus(),
+		CmdGetERC20DeploymentRequests(),
+		CmdGetERC20Migrations(),
+		CmdGetRateLimitCapacity(),
+		CmdGetUnhaltBridgeImpact(),
+		CmdGetMerkleAirdrops(),
+		CmdGetAirdropClaimed(),
+		CmdGetDepositReceipts(),
+		CmdGetBatchExecution(),
+		CmdGetExecutedBatch(),
+		CmdGetExecutedBatchByTxId(),
 	}...)
 
 	return gravityQueryCmd
@@ -256,3 +269,322 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetMissedSignatures() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "missed-signatures [bech32 validator address]",
+		Short: "Query valsets, batches and logic calls a validator must sign but has not, and when it may be slashed for them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMissedSignaturesRequest{
+				ValidatorAddress: args[0],
+			}
+
+			res, err := queryClient.MissedSignatures(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetValidatorBridgeStatus() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "validator-bridge-status [bech32 validator address]",
+		Short: "Query a validator's delegate keys, oracle progress, missing signatures and past gravity slashing",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorBridgeStatusRequest{
+				ValidatorAddress: args[0],
+			}
+
+			res, err := queryClient.ValidatorBridgeStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetERC20DeploymentRequests() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-deployment-requests",
+		Short: "Query the pending and completed governance requests to deploy ERC20s for Cosmos originated denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryERC20DeploymentRequestsRequest{}
+
+			res, err := queryClient.ERC20DeploymentRequests(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetERC20Migrations() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "erc20-migrations",
+		Short: "Query deprecated Cosmos originated ERC20s, their replacements and migration windows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryERC20MigrationsRequest{}
+
+			res, err := queryClient.ERC20Migrations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetRateLimitCapacity() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "rate-limit-capacity [optional token contract]",
+		Short: "Query the remaining inflow and outflow capacity and queued deposits of rate limited tokens",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitCapacityRequest{}
+			if len(args) == 1 {
+				req.TokenContract = args[0]
+			}
+
+			res, err := queryClient.RateLimitCapacity(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGetUnhaltBridgeImpact simulates an UnhaltBridgeProposal so its effects can be reviewed before voting
+func CmdGetUnhaltBridgeImpact() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "unhalt-bridge-impact [target nonce]",
+		Short: "Query the attestations, orchestrator nonces, IBC auto-forwards and batches an UnhaltBridgeProposal would affect",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			targetNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "target nonce")
+			}
+
+			req := &types.QueryUnhaltBridgeImpactRequest{TargetNonce: targetNonce}
+
+			res, err := queryClient.UnhaltBridgeImpact(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetMerkleAirdrops() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "merkle-airdrops",
+		Short: "Query the Merkle airdrops which are still open for claiming",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MerkleAirdrops(cmd.Context(), &types.QueryMerkleAirdropsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAirdropClaimed() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "airdrop-claimed [airdrop id] [address]",
+		Short: "Query whether an address has claimed its share of a Merkle airdrop",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "airdrop id")
+			}
+
+			req := &types.QueryAirdropClaimedRequest{AirdropId: airdropID, Address: args[1]}
+
+			res, err := queryClient.AirdropClaimed(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDepositReceipts() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-receipts [eth tx hash]",
+		Short: "Query the attestations and Cosmos credits of the deposits made by an Ethereum transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDepositReceiptsRequest{EthTxHash: strings.ToLower(args[0])}
+
+			res, err := queryClient.DepositReceipts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBatchExecution() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "batch-execution [token contract] [batch nonce]",
+		Short: "Query the Ethereum transaction which executed a batch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "batch nonce")
+			}
+
+			req := &types.QueryBatchExecutionRequest{TokenContract: args[0], BatchNonce: nonce}
+
+			res, err := queryClient.BatchExecution(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetExecutedBatch() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "executed-batch [batch nonce]",
+		Short: "Query an executed batch from the executed batch archive by its nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "batch nonce")
+			}
+
+			req := &types.QueryExecutedBatchByNonceRequest{BatchNonce: nonce}
+
+			res, err := queryClient.ExecutedBatchByNonce(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetExecutedBatchByTxId() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "executed-batch-by-tx-id [tx id]",
+		Short: "Query the executed batch which delivered a SendToEth transaction from the executed batch archive",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			txID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "tx id")
+			}
+
+			req := &types.QueryExecutedBatchByTxIdRequest{TxId: txID}
+
+			res, err := queryClient.ExecutedBatchByTxId(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
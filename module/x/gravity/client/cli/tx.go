@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -39,7 +40,12 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
 		CmdGovIbcMetadataProposal(),
+		CmdGovERC20MetadataProposal(),
+		CmdGovRequestERC20DeploymentProposal(),
+		CmdGovDeprecateERC20Proposal(),
 		CmdGovAirdropProposal(),
+		CmdGovAirdropV2Proposal(),
+		CmdClaimAirdrop(),
 		CmdGovUnhaltBridgeProposal(),
 		CmdExecutePendingIbcAutoForwards(),
 	}...)
@@ -113,6 +119,197 @@ func CmdGovIbcMetadataProposal() *cobra.Command {
 	return cmd
 }
 
+func CmdGovERC20MetadataProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-erc20-metadata [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to set or override the Metadata of the voucher for an Ethereum originated ERC20",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.ERC20MetadataProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if proposal.TokenContract == "" ||
+				proposal.Title == "" ||
+				proposal.Description == "" ||
+				proposal.Metadata.Base == "" ||
+				proposal.Metadata.Name == "" ||
+				proposal.Metadata.Display == "" ||
+				proposal.Metadata.Symbol == "" {
+				return fmt.Errorf("proposal json file is not valid, please check example json in docs")
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "Your proposal.json is not valid, please correct it")
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGovRequestERC20DeploymentProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-request-erc20-deployment [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to whitelist a Cosmos originated denom, with metadata already set, for ERC20 deployment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.RequestERC20DeploymentProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if proposal.Denom == "" ||
+				proposal.Title == "" ||
+				proposal.Description == "" {
+				return fmt.Errorf("proposal json file is not valid, please check example json in docs")
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "Your proposal.json is not valid, please correct it")
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGovDeprecateERC20Proposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-deprecate-erc20 [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to deprecate the ERC20 of a Cosmos originated denom and request deployment of a replacement",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.DeprecateERC20Proposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if proposal.Denom == "" ||
+				proposal.Title == "" ||
+				proposal.Description == "" ||
+				proposal.MigrationWindow == 0 {
+				return fmt.Errorf("proposal json file is not valid, please check example json in docs")
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "Your proposal.json is not valid, please correct it")
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // AirDropProposalPlain is a struct with plaintext recipients so that the proposal.json can be readable
 // and not subject to the strange encoding of the airdrop proposal tx where the recipients are packed as 20
 // byte sets
@@ -203,6 +400,165 @@ func CmdGovAirdropProposal() *cobra.Command {
 	return cmd
 }
 
+// AirdropV2ProposalPlain is the json file read by gov-airdrop-v2 and claim-airdrop, when Merkle is true only the
+// root of the recipient list is submitted and this file must be published for recipients to claim
+type AirdropV2ProposalPlain struct {
+	Title       string
+	Description string
+	Recipients  []AirdropRecipientPlain
+	Merkle      bool
+	ClaimWindow uint64
+}
+
+// AirdropRecipientPlain is a recipient with a coins string amount, e.g. "100ugraviton,5ibc/..."
+type AirdropRecipientPlain struct {
+	Address string
+	Amount  string
+}
+
+// parseAirdropRecipients converts the plaintext recipient list to the proposal type
+func parseAirdropRecipients(plain []AirdropRecipientPlain) ([]types.AirdropRecipient, error) {
+	recipients := make([]types.AirdropRecipient, len(plain))
+	for i, r := range plain {
+		amount, err := sdk.ParseCoinsNormalized(r.Amount)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid amount for %s", r.Address)
+		}
+		recipients[i] = types.AirdropRecipient{Address: r.Address, Amount: amount}
+		if err := recipients[i].ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	return recipients, nil
+}
+
+func readAirdropV2Proposal(path string) (*AirdropV2ProposalPlain, []types.AirdropRecipient, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to read proposal json file")
+	}
+	proposal := &AirdropV2ProposalPlain{}
+	if err := json.Unmarshal(contents, proposal); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "proposal json file is not valid json")
+	}
+	recipients, err := parseAirdropRecipients(proposal.Recipients)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proposal, recipients, nil
+}
+
+func CmdGovAirdropV2Proposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "gov-airdrop-v2 [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal for a multi denom airdrop, with Merkle set to true recipients claim their share with claim-airdrop",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposal, recipients, err := readAirdropV2Proposal(args[0])
+			if err != nil {
+				return err
+			}
+
+			finalProposal := &types.AirdropV2Proposal{
+				Title:       proposal.Title,
+				Description: proposal.Description,
+			}
+			if proposal.Merkle {
+				root, _ := types.AirdropMerkleTree(recipients)
+				finalProposal.MerkleRoot = types.AirdropMerkleRootHex(root)
+				finalProposal.Total = types.AirdropV2Proposal{Recipients: recipients}.TotalAmount()
+				finalProposal.ClaimWindow = proposal.ClaimWindow
+			} else {
+				finalProposal.Recipients = recipients
+			}
+			if err := finalProposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(finalProposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdClaimAirdrop() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "claim-airdrop [airdrop id] [path-to-proposal-json]",
+		Short: "Claims your share of a Merkle airdrop, the proof is built from the published proposal json",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			airdropID, err := strconv.ParseUint(args[0], 0, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse airdrop id")
+			}
+
+			_, recipients, err := readAirdropV2Proposal(args[1])
+			if err != nil {
+				return err
+			}
+			_, proofs := types.AirdropMerkleTree(recipients)
+			for i, r := range recipients {
+				if r.Address != cosmosAddr.String() {
+					continue
+				}
+				proof := make([]string, len(proofs[i]))
+				for j, node := range proofs[i] {
+					proof[j] = hex.EncodeToString(node)
+				}
+				// Make the message
+				msg := types.NewMsgClaimAirdrop(cosmosAddr, airdropID, r.Amount, proof)
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				// Send it
+				return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+			}
+			return fmt.Errorf("%s is not a recipient of this airdrop", cosmosAddr)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdGovUnhaltBridgeProposal() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
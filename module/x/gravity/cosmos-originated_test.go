@@ -69,6 +69,7 @@ func addDenomToERC20Relation(tv *testingVars) {
 		Base:    "ugraviton",
 		Display: "graviton",
 	})
+	requestERC20Deployment(tv, tv.denom)
 
 	var (
 		myNonce = uint64(1)
@@ -110,6 +111,64 @@ func addDenomToERC20Relation(tv *testingVars) {
 
 	assert.Equal(tv.t, tv.denom, gotDenom)
 	assert.Equal(tv.t, tv.erc20, gotERC20.GetAddress().Hex())
+
+	// check the deployment request was completed
+	request, found := tv.input.GravityKeeper.GetERC20DeploymentRequest(tv.ctx, tv.denom)
+	require.True(tv.t, found)
+	assert.Equal(tv.t, tv.erc20, request.Erc20)
+	assert.Equal(tv.t, uint64(tv.ctx.BlockHeight()), request.DeployedHeight)
+}
+
+// requestERC20Deployment passes a governance proposal whitelisting denom for ERC20 deployment
+func requestERC20Deployment(tv *testingVars, denom string) {
+	proposal := types.RequestERC20DeploymentProposal{
+		Title:       "Deploy ERC20",
+		Description: "Deploy an ERC20 representation",
+		Denom:       denom,
+	}
+	require.NoError(tv.t, proposal.ValidateBasic())
+	require.NoError(tv.t, keeper.NewGravityProposalHandler(tv.input.GravityKeeper)(tv.ctx, &proposal))
+}
+
+// Deployed claims for denoms governance has not requested a deployment for must not create a mapping
+func TestERC20DeployedRequiresRequest(t *testing.T) {
+	tv := initializeTestingVars(t)
+	defer func() {
+		tv.input.Context.Logger().Info("Asserting invariants at test end")
+		tv.input.AssertInvariants()
+	}()
+	tv.input.BankKeeper.SetDenomMetaData(tv.ctx, banktypes.Metadata{
+		Description: "The native staking token of the Cosmos Gravity Bridge",
+		Name:        "Graviton",
+		Symbol:      "GRAV",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ugraviton", Exponent: uint32(0)},
+			{Denom: "graviton", Exponent: uint32(6)},
+		},
+		Base:    "ugraviton",
+		Display: "graviton",
+	})
+
+	for _, v := range keeper.OrchAddrs {
+		ethClaim := types.MsgERC20DeployedClaim{
+			EventNonce:    1,
+			BlockHeight:   0,
+			CosmosDenom:   tv.denom,
+			TokenContract: tv.erc20,
+			Name:          "Graviton",
+			Symbol:        "GRAV",
+			Decimals:      6,
+			Orchestrator:  v.String(),
+		}
+		_, err := tv.h(tv.ctx, &ethClaim)
+		require.NoError(t, err)
+	}
+	EndBlocker(tv.ctx, tv.input.GravityKeeper)
+
+	// the claim was observed but did not register the squatting contract
+	require.Equal(t, uint64(1), tv.input.GravityKeeper.GetLastObservedEventNonce(tv.ctx))
+	_, exists := tv.input.GravityKeeper.GetCosmosOriginatedERC20(tv.ctx, tv.denom)
+	require.False(t, exists)
 }
 
 func lockCoinsInModule(tv *testingVars) {
@@ -226,6 +285,7 @@ func addIbcDenomToERC20Relation(tv *testingVars) {
 		},
 	}
 	tv.input.BankKeeper.SetDenomMetaData(tv.ctx, metadata)
+	requestERC20Deployment(tv, ibcDenom)
 
 	var (
 		myNonce = uint64(2)
@@ -268,3 +328,177 @@ func addIbcDenomToERC20Relation(tv *testingVars) {
 	assert.Equal(tv.t, tv.denom, gotDenom)
 	assert.Equal(tv.t, tv.erc20, gotERC20.GetAddress().Hex())
 }
+
+// Deprecate the ERC20 of a Cosmos originated denom, check that unbatched transfers are refunded, new transfers
+// are refused, deposits are honored only during the migration window and the replacement can be deployed
+func TestDeprecateERC20(t *testing.T) {
+	tv := initializeTestingVars(t)
+	defer func() {
+		tv.input.Context.Logger().Info("Asserting invariants at test end")
+		tv.input.AssertInvariants()
+	}()
+	addDenomToERC20Relation(tv)
+	lockCoinsInModule(tv)
+
+	var (
+		senderAddr, _   = sdk.AccAddressFromBech32("gravity1990z7dqsvh8gthw9pa5sn4wuy2xrsd80lcx6lv")
+		receiverAddr, _ = sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+		newErc20        = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		window          = uint64(10)
+		gk              = tv.input.GravityKeeper
+	)
+
+	proposal := types.DeprecateERC20Proposal{
+		Title:           "Deprecate ERC20",
+		Description:     "The ERC20 was deployed with the wrong decimals",
+		Denom:           tv.denom,
+		MigrationWindow: window,
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, keeper.NewGravityProposalHandler(gk)(tv.ctx, &proposal))
+
+	// the unbatched transfer was refunded
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(tv.denom, sdk.NewInt(150))), tv.input.BankKeeper.GetAllBalances(tv.ctx, senderAddr))
+	require.Empty(t, gk.GetUnbatchedTransactions(tv.ctx))
+
+	// new transfers are refused
+	_, err := tv.h(tv.ctx, &types.MsgSendToEth{
+		Sender:    senderAddr.String(),
+		EthDest:   "0x3c9289da00b02dC623d0D8D907619890301D26d4",
+		Amount:    sdk.NewCoin(tv.denom, sdk.NewInt(50)),
+		BridgeFee: sdk.NewCoin(tv.denom, sdk.NewInt(5)),
+	})
+	require.Error(t, err)
+
+	migrations, err := gk.ERC20Migrations(sdk.WrapSDKContext(tv.ctx), &types.QueryERC20MigrationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ERC20Migration{{
+		Denom:       tv.denom,
+		OldErc20:    tv.erc20,
+		StartHeight: uint64(tv.ctx.BlockHeight()),
+		EndHeight:   uint64(tv.ctx.BlockHeight()) + window,
+	}}, migrations.Active)
+	require.Empty(t, migrations.Finished)
+
+	// tokens previously bridged to Ethereum are locked in the module
+	require.NoError(t, tv.input.BankKeeper.MintCoins(tv.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(tv.denom, sdk.NewInt(100)))))
+	deposit := func(nonce uint64) {
+		for _, v := range keeper.OrchAddrs {
+			claim := types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				BlockHeight:    nonce,
+				TokenContract:  tv.erc20,
+				Amount:         sdk.NewInt(12),
+				EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+				CosmosReceiver: receiverAddr.String(),
+				Orchestrator:   v.String(),
+			}
+			_, err := tv.h(tv.ctx, &claim)
+			require.NoError(t, err)
+		}
+		EndBlocker(tv.ctx, gk)
+		require.Equal(t, nonce, gk.GetLastObservedEventNonce(tv.ctx))
+	}
+
+	// deposits from the old contract are honored during the window
+	deposit(2)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(tv.denom, sdk.NewInt(12))), tv.input.BankKeeper.GetAllBalances(tv.ctx, receiverAddr))
+
+	// and go to the community pool after it, the tokens are locked on Ethereum either way
+	tv.ctx = tv.ctx.WithBlockHeight(tv.ctx.BlockHeight() + int64(window) + 1)
+	communityPool := tv.input.DistKeeper.GetFeePoolCommunityCoins(tv.ctx).AmountOf(tv.denom)
+	deposit(3)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(tv.denom, sdk.NewInt(12))), tv.input.BankKeeper.GetAllBalances(tv.ctx, receiverAddr))
+	assert.Equal(t, communityPool.Add(sdk.NewDec(12)), tv.input.DistKeeper.GetFeePoolCommunityCoins(tv.ctx).AmountOf(tv.denom))
+
+	// the replacement ERC20 can be deployed
+	for _, v := range keeper.OrchAddrs {
+		claim := types.MsgERC20DeployedClaim{
+			EventNonce:    4,
+			BlockHeight:   4,
+			CosmosDenom:   tv.denom,
+			TokenContract: newErc20,
+			Name:          "Graviton",
+			Symbol:        "GRAV",
+			Decimals:      6,
+			Orchestrator:  v.String(),
+		}
+		_, err := tv.h(tv.ctx, &claim)
+		require.NoError(t, err)
+	}
+	EndBlocker(tv.ctx, gk)
+
+	isCosmosOriginated, erc20, err := gk.DenomToERC20Lookup(tv.ctx, tv.denom)
+	require.NoError(t, err)
+	require.True(t, isCosmosOriginated)
+	require.Equal(t, newErc20, erc20.GetAddress().Hex())
+
+	migrations, err = gk.ERC20Migrations(sdk.WrapSDKContext(tv.ctx), &types.QueryERC20MigrationsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, migrations.Active)
+	require.Len(t, migrations.Finished, 1)
+	require.Equal(t, newErc20, migrations.Finished[0].NewErc20)
+}
+
+// Batches of a deprecated ERC20 which were created before the deprecation may still execute on Ethereum, those
+// which do not are refunded instead of returning to the pool, since the old contract can not be batched again
+func TestDeprecateERC20InFlightBatches(t *testing.T) {
+	tv := initializeTestingVars(t)
+	defer func() {
+		tv.input.Context.Logger().Info("Asserting invariants at test end")
+		tv.input.AssertInvariants()
+	}()
+	addDenomToERC20Relation(tv)
+	lockCoinsInModule(tv)
+
+	var (
+		senderAddr, _ = sdk.AccAddressFromBech32("gravity1990z7dqsvh8gthw9pa5sn4wuy2xrsd80lcx6lv")
+		gk            = tv.input.GravityKeeper
+	)
+	oldErc20, err := types.NewEthAddress(tv.erc20)
+	require.NoError(t, err)
+
+	// the transfer of lockCoinsInModule is batched, then a second one paying more is batched after it
+	first, err := gk.BuildOutgoingTXBatch(tv.ctx, *oldErc20, 10)
+	require.NoError(t, err)
+	_, err = tv.h(tv.ctx, &types.MsgSendToEth{
+		Sender:    senderAddr.String(),
+		EthDest:   "0x3c9289da00b02dC623d0D8D907619890301D26d4",
+		Amount:    sdk.NewCoin(tv.denom, sdk.NewInt(20)),
+		BridgeFee: sdk.NewCoin(tv.denom, sdk.NewInt(10)),
+	})
+	require.NoError(t, err)
+	second, err := gk.BuildOutgoingTXBatch(tv.ctx, *oldErc20, 1)
+	require.NoError(t, err)
+	require.Len(t, second.Transactions, 1)
+	// the sender holds 150 - 55 - 30
+	require.Equal(t, sdk.NewInt(65), tv.input.BankKeeper.GetBalance(tv.ctx, senderAddr, tv.denom).Amount)
+
+	require.NoError(t, keeper.NewGravityProposalHandler(gk)(tv.ctx, &types.DeprecateERC20Proposal{
+		Title:           "Deprecate ERC20",
+		Description:     "The ERC20 was deployed with the wrong decimals",
+		Denom:           tv.denom,
+		MigrationWindow: 10,
+	}))
+	require.Len(t, gk.GetOutgoingTxBatches(tv.ctx), 2)
+
+	// the second batch executes on Ethereum, which supersedes the first one
+	for _, v := range keeper.OrchAddrs {
+		_, err := tv.h(tv.ctx, &types.MsgBatchSendToEthClaim{
+			EventNonce:    2,
+			BlockHeight:   2,
+			BatchNonce:    second.BatchNonce,
+			TokenContract: tv.erc20,
+			Orchestrator:  v.String(),
+		})
+		require.NoError(t, err)
+	}
+	EndBlocker(tv.ctx, gk)
+	require.Equal(t, uint64(2), gk.GetLastObservedEventNonce(tv.ctx))
+
+	// the executed transfer stays locked for the tokens released on Ethereum, the superseded one is refunded
+	require.Empty(t, gk.GetOutgoingTxBatches(tv.ctx))
+	require.Empty(t, gk.GetUnbatchedTransactions(tv.ctx))
+	require.Equal(t, sdk.NewInt(120), tv.input.BankKeeper.GetBalance(tv.ctx, senderAddr, tv.denom).Amount)
+	require.Nil(t, gk.GetOutgoingTXBatch(tv.ctx, *oldErc20, first.BatchNonce))
+}
@@ -0,0 +1,442 @@
+// Package ethsim simulates the Ethereum side of the bridge for end to end tests written in Go.
+//
+// Gravity.sol and the ERC20s it moves are transcribed into Go and run on top of go-ethereum's
+// SimulatedBackend, which provides real accounts, nonces, gas, blocks and receipts. Transactions sent to a
+// simulated contract are mined by the SimulatedBackend like any other transaction and then executed by the
+// Go contract when the block is committed, the resulting logs and status are merged into the receipts and
+// log queries served by the Backend. This makes Backend usable anywhere a bind.ContractBackend is expected.
+// The compiled contract can not be run here, instead the scenarios of the Solidity tests in /solidity/test
+// are ported to gravity_sol_test.go, which checks the transcription reverts with the same reasons and ends
+// in the same state as the compiled contract does in those tests.
+//
+// Bridge ties a Backend to a keeper.TestInput together with an orchestrator.Orchestrator per validator,
+// talking to the keeper through CosmosClient, and a relayer, so the full deposit, withdrawal and validator
+// set update loop can be run with go test.
+package ethsim
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// simulatedGasLimit is the block gas limit of the simulated chain
+const simulatedGasLimit = 30000000
+
+// placeholderCode is reported as the code of every simulated contract, bind refuses to call addresses without
+// code. It is the INVALID opcode so that nothing ever mistakes it for a real contract
+var placeholderCode = []byte{0xfe}
+
+// txResult is the outcome of a transaction sent to a simulated contract
+type txResult struct {
+	logs []*ethtypes.Log
+	err  error
+}
+
+// Backend is a SimulatedBackend which executes calls and transactions to the simulated contracts
+type Backend struct {
+	*backends.SimulatedBackend
+
+	mu sync.Mutex
+	// world is the committed state of the simulated contracts
+	world *world
+	// pending are the transactions to simulated contracts waiting for the next Commit
+	pending []*ethtypes.Transaction
+	results map[gethcommon.Hash]txResult
+	logs    []*ethtypes.Log
+	// deployments counts contracts deployed through DeployGravity and DeployERC20
+	deployments uint64
+	logsFeed    event.Feed
+}
+
+// NewBackend creates a simulated Ethereum chain, alloc funds the accounts used to send transactions
+func NewBackend(alloc core.GenesisAlloc) *Backend {
+	return &Backend{
+		SimulatedBackend: backends.NewSimulatedBackend(alloc, simulatedGasLimit),
+		world:            newWorld(),
+		results:          make(map[gethcommon.Hash]txResult),
+	}
+}
+
+// deployerAddress stands in for the account deploying the simulated contracts
+var deployerAddress = gethcommon.HexToAddress("0x00000000000000000000000000000000deadbeef")
+
+// DeployGravity deploys Gravity.sol with the given gravity id and initial validator set in a new block,
+// the constructor emits the ValsetUpdatedEvent with event nonce 1 which the orchestrators must attest to
+func (b *Backend) DeployGravity(gravityID string, validators []gethcommon.Address, powers []*big.Int) (gethcommon.Address, error) {
+	var id [32]byte
+	copy(id[:], gravityID)
+	return b.deploy(func(ctx callContext) (contract, error) {
+		return newGravity(ctx, id, validators, powers)
+	})
+}
+
+// DeployERC20 deploys an ERC20 with no supply in a new block, use Mint to hand out tokens
+func (b *Backend) DeployERC20(name, symbol string, decimals uint8) (gethcommon.Address, error) {
+	return b.deploy(func(ctx callContext) (contract, error) {
+		return newERC20(name, symbol, decimals), nil
+	})
+}
+
+// deploy runs a simulated constructor and commits a block containing the deployment, there is no real
+// transaction so the logs of the deployment carry a transaction hash derived from the contract address
+func (b *Backend) deploy(constructor func(ctx callContext) (contract, error)) (gethcommon.Address, error) {
+	b.Commit()
+
+	b.mu.Lock()
+	address := crypto.CreateAddress(deployerAddress, b.deployments)
+	head := b.Blockchain().CurrentBlock()
+	w := b.world.clone()
+	con, err := constructor(callContext{world: w, self: address, sender: deployerAddress, blockNumber: head.NumberU64()})
+	if err != nil {
+		b.mu.Unlock()
+		return gethcommon.Address{}, err
+	}
+	w.contracts[address] = con
+	b.deployments++
+	logs := b.include(w, head, crypto.Keccak256Hash(address.Bytes()), 0, 0)
+	b.mu.Unlock()
+
+	b.logsFeed.Send(logs)
+	return address, nil
+}
+
+// Mint creates amount of a simulated ERC20 out of thin air for the given account
+func (b *Backend) Mint(token, to gethcommon.Address, amount *big.Int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	w := b.world.clone()
+	erc20, err := w.erc20(token)
+	if err != nil {
+		return err
+	}
+	erc20.mint(callContext{world: w, self: token, blockNumber: b.Blockchain().CurrentBlock().NumberU64()}, to, amount)
+	w.logs = nil
+	b.world = w
+	return nil
+}
+
+// ERC20Balance returns the balance of account in a simulated ERC20 at the latest block
+func (b *Backend) ERC20Balance(token, account gethcommon.Address) (*big.Int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	erc20, err := b.world.erc20(token)
+	if err != nil {
+		return nil, err
+	}
+	return erc20.balanceOf(account), nil
+}
+
+// isSimulated returns true if a simulated contract is deployed at the given address
+func (b *Backend) isSimulated(addr *gethcommon.Address) bool {
+	if addr == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.world.contracts[*addr]
+	return ok
+}
+
+// Commit mines the pending block and then executes the transactions to simulated contracts in it, in order
+func (b *Backend) Commit() {
+	b.SimulatedBackend.Commit()
+
+	b.mu.Lock()
+	head := b.Blockchain().CurrentBlock()
+	signer := ethtypes.MakeSigner(b.Blockchain().Config(), head.Number())
+	var logs []*ethtypes.Log
+	logIndex := uint(0)
+	for _, tx := range b.pending {
+		receipt, err := b.SimulatedBackend.TransactionReceipt(context.Background(), tx.Hash())
+		if err != nil {
+			panic("simulated transaction was not mined: " + err.Error())
+		}
+		sender, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			panic("simulated transaction has an invalid signature: " + err.Error())
+		}
+		w := b.world.clone()
+		if _, err := w.call(sender, *tx.To(), tx.Data(), head.NumberU64()); err != nil {
+			b.results[tx.Hash()] = txResult{err: err}
+			continue
+		}
+		txLogs := b.include(w, head, tx.Hash(), receipt.TransactionIndex, logIndex)
+		logIndex += uint(len(txLogs))
+		b.results[tx.Hash()] = txResult{logs: txLogs}
+		logs = append(logs, txLogs...)
+	}
+	b.pending = nil
+	b.mu.Unlock()
+
+	if len(logs) > 0 {
+		b.logsFeed.Send(logs)
+	}
+}
+
+// include makes w the committed state and stamps the logs emitted by a transaction with its position in the chain
+func (b *Backend) include(w *world, block *ethtypes.Block, txHash gethcommon.Hash, txIndex uint, logIndex uint) []*ethtypes.Log {
+	logs := w.logs
+	for i, l := range logs {
+		l.BlockNumber = block.NumberU64()
+		l.BlockHash = block.Hash()
+		l.TxHash = txHash
+		l.TxIndex = txIndex
+		l.Index = logIndex + uint(i)
+	}
+	w.logs = nil
+	b.world = w
+	b.logs = append(b.logs, logs...)
+	return logs
+}
+
+// Rollback aborts all pending transactions, including those to simulated contracts
+func (b *Backend) Rollback() {
+	b.SimulatedBackend.Rollback()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending = nil
+}
+
+// SendTransaction adds a transaction to the pending block, transactions to simulated contracts are executed
+// when the block is committed
+func (b *Backend) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	if err := b.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	if b.isSimulated(tx.To()) {
+		b.mu.Lock()
+		b.pending = append(b.pending, tx)
+		b.mu.Unlock()
+	}
+	return nil
+}
+
+// CodeAt returns placeholder code for simulated contracts. Since the simulated contracts only keep their
+// latest state, the block number is ignored for them
+func (b *Backend) CodeAt(ctx context.Context, contract gethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	if b.isSimulated(&contract) {
+		return placeholderCode, nil
+	}
+	return b.SimulatedBackend.CodeAt(ctx, contract, blockNumber)
+}
+
+// PendingCodeAt returns placeholder code for simulated contracts
+func (b *Backend) PendingCodeAt(ctx context.Context, contract gethcommon.Address) ([]byte, error) {
+	if b.isSimulated(&contract) {
+		return placeholderCode, nil
+	}
+	return b.SimulatedBackend.PendingCodeAt(ctx, contract)
+}
+
+// CallContract executes a call to a simulated contract against the latest state, the block number is ignored
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if !b.isSimulated(call.To) {
+		return b.SimulatedBackend.CallContract(ctx, call, blockNumber)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.world.clone().call(call.From, *call.To, call.Data, b.Blockchain().CurrentBlock().NumberU64())
+}
+
+// PendingCallContract executes a call to a simulated contract on top of the pending transactions
+func (b *Backend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	if !b.isSimulated(call.To) {
+		return b.SimulatedBackend.PendingCallContract(ctx, call)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	w, number := b.pendingWorld()
+	return w.call(call.From, *call.To, call.Data, number)
+}
+
+// EstimateGas reports the revert reason of a call to a simulated contract the way a node would, so that
+// bind fails before sending a transaction which can not succeed
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	if b.isSimulated(call.To) {
+		b.mu.Lock()
+		w, number := b.pendingWorld()
+		_, err := w.call(call.From, *call.To, call.Data, number)
+		b.mu.Unlock()
+		if err != nil {
+			return 0, err
+		}
+	}
+	return b.SimulatedBackend.EstimateGas(ctx, call)
+}
+
+// pendingWorld returns a copy of the simulated contracts with the pending transactions applied and the
+// number of the pending block, b.mu must be held
+func (b *Backend) pendingWorld() (*world, uint64) {
+	number := b.Blockchain().CurrentBlock().NumberU64() + 1
+	signer := ethtypes.MakeSigner(b.Blockchain().Config(), new(big.Int).SetUint64(number))
+	w := b.world.clone()
+	for _, tx := range b.pending {
+		sender, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			continue
+		}
+		attempt := w.clone()
+		if _, err := attempt.call(sender, *tx.To(), tx.Data(), number); err == nil {
+			w = attempt
+		}
+	}
+	w.logs = nil
+	return w, number
+}
+
+// TransactionReceipt returns the receipt of a mined transaction, with the status and logs of the simulated
+// contract execution for transactions to simulated contracts
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash gethcommon.Hash) (*ethtypes.Receipt, error) {
+	receipt, err := b.SimulatedBackend.TransactionReceipt(ctx, txHash)
+	if err != nil || receipt == nil {
+		return receipt, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	result, ok := b.results[txHash]
+	if !ok {
+		return receipt, nil
+	}
+	patched := *receipt
+	if result.err != nil {
+		patched.Status = ethtypes.ReceiptStatusFailed
+		patched.Logs = nil
+	} else {
+		patched.Logs = result.logs
+	}
+	patched.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{&patched})
+	return &patched, nil
+}
+
+// TransactionError returns the revert reason of a mined transaction to a simulated contract, or nil if it succeeded
+func (b *Backend) TransactionError(txHash gethcommon.Hash) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.results[txHash].err
+}
+
+// FilterLogs returns the logs matching the query, including those emitted by simulated contracts
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	logs, err := b.SimulatedBackend.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	head := b.Blockchain().CurrentBlock().NumberU64()
+	for _, l := range b.logs {
+		if inRange(query, *l, head) && matches(query, *l) {
+			logs = append(logs, *l)
+		}
+	}
+	b.mu.Unlock()
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+	return logs, nil
+}
+
+// SubscribeFilterLogs streams the logs of newly committed blocks which match the query, like a node it
+// ignores the block range of the query. Logs from simulated contracts are delivered from Commit, so the
+// subscriber must keep draining ch
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- ethtypes.Log) (ethereum.Subscription, error) {
+	inner, err := b.SimulatedBackend.SubscribeFilterLogs(ctx, query, ch)
+	if err != nil {
+		return nil, err
+	}
+	sink := make(chan []*ethtypes.Log)
+	sub := b.logsFeed.Subscribe(sink)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		defer inner.Unsubscribe()
+		for {
+			select {
+			case logs := <-sink:
+				for _, l := range logs {
+					if !matches(query, *l) {
+						continue
+					}
+					select {
+					case ch <- *l:
+					case err := <-sub.Err():
+						return err
+					case <-quit:
+						return nil
+					}
+				}
+			case err := <-inner.Err():
+				return err
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// inRange checks a log against the block hash or block range of a query, an unset end of the range is the head
+func inRange(query ethereum.FilterQuery, l ethtypes.Log, head uint64) bool {
+	if query.BlockHash != nil {
+		return l.BlockHash == *query.BlockHash
+	}
+	if query.FromBlock != nil && new(big.Int).SetUint64(l.BlockNumber).Cmp(query.FromBlock) < 0 {
+		return false
+	}
+	to := new(big.Int).SetUint64(head)
+	if query.ToBlock != nil && query.ToBlock.Sign() >= 0 {
+		to = query.ToBlock
+	}
+	return new(big.Int).SetUint64(l.BlockNumber).Cmp(to) <= 0
+}
+
+// matches checks a log against the addresses and topics of a query
+func matches(query ethereum.FilterQuery, l ethtypes.Log) bool {
+	if len(query.Addresses) > 0 {
+		found := false
+		for _, addr := range query.Addresses {
+			if addr == l.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(query.Topics) > len(l.Topics) {
+		return false
+	}
+	for i, alternatives := range query.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range alternatives {
+			if topic == l.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,185 @@
+package ethsim
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/orchestrator"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/relayer"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ethUsers is the number of funded Ethereum accounts available to a test through Bridge.EthUsers
+const ethUsers = 3
+
+// Bridge is a five validator chain from keeper.SetupFiveValChain connected to a simulated Ethereum chain
+// running Gravity.sol, with an orchestrator for every validator and a single relayer
+type Bridge struct {
+	t *testing.T
+
+	Input         keeper.TestInput
+	Eth           *Backend
+	Gravity       gethcommon.Address
+	Orchestrators []*orchestrator.Orchestrator
+	Relayer       *ecdsa.PrivateKey
+	EthUsers      []*ecdsa.PrivateKey
+
+	relayer *relayer.Relayer
+}
+
+// NewBridge sets up the chain, gives every validator a real Ethereum delegate key and deploys Gravity.sol
+// with the current validator set. The orchestrators still have to attest to the deployment, run Step to
+// make progress
+func NewBridge(t *testing.T) *Bridge {
+	t.Helper()
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	ethKeys := make([]*ecdsa.PrivateKey, len(keeper.ValAddrs))
+	for i, val := range keeper.ValAddrs {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		ethKeys[i] = key
+		ethAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(key.PublicKey).Hex())
+		require.NoError(t, err)
+		k.SetEthAddressForValidator(ctx, val, *ethAddr)
+	}
+
+	alloc := core.GenesisAlloc{}
+	fund := func() *ecdsa.PrivateKey {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = core.GenesisAccount{Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(21), nil)}
+		return key
+	}
+	relayerKey := fund()
+	users := make([]*ecdsa.PrivateKey, ethUsers)
+	for i := range users {
+		users[i] = fund()
+	}
+	eth := NewBackend(alloc)
+
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	args := types.NewValsetArgs(valset)
+	gravityAddress, err := eth.DeployGravity(k.GetGravityID(ctx), args.Validators, args.Powers)
+	require.NoError(t, err)
+
+	// one simulated Ethereum block is mined per Step, so the batch timeout projection must use the Cosmos block time,
+	// and the orchestrators vote on the full claim data from the first block
+	params := k.GetParams(ctx)
+	params.ClaimDataHeight = 1
+	params.BridgeEthereumAddress = gravityAddress.Hex()
+	params.BridgeChainId = eth.Blockchain().Config().ChainID.Uint64()
+	params.AverageEthereumBlockTime = params.AverageBlockTime
+	k.SetParams(ctx, params)
+
+	b := &Bridge{
+		t:        t,
+		Input:    input,
+		Eth:      eth,
+		Gravity:  gravityAddress,
+		Relayer:  relayerKey,
+		EthUsers: users,
+	}
+	cosmos := NewCosmosClient(&b.Input)
+	for i, key := range ethKeys {
+		o, err := orchestrator.New(orchestrator.Config{
+			GravityAddress:      gravityAddress,
+			OrchestratorAddress: keeper.OrchAddrs[i],
+			EthKey:              key,
+		}, cosmos, eth)
+		require.NoError(t, err)
+		b.Orchestrators = append(b.Orchestrators, o)
+	}
+	b.relayer, err = relayer.New(relayer.Config{
+		GravityAddress: gravityAddress,
+		EthKey:         relayerKey,
+		ChainID:        eth.Blockchain().Config().ChainID,
+	}, cosmos, eth)
+	require.NoError(t, err)
+	return b
+}
+
+// Context returns the context of the current Cosmos block
+func (b *Bridge) Context() sdk.Context {
+	return b.Input.Context
+}
+
+// Step runs one round of the bridge: the orchestrators sign what is pending, the relayer submits what
+// is ready to Gravity.sol, an Ethereum block is mined, the orchestrators attest to the new events and the
+// Cosmos block ends
+func (b *Bridge) Step() {
+	b.t.Helper()
+	ctx := b.Input.Context
+	k := b.Input.GravityKeeper
+
+	for _, o := range b.Orchestrators {
+		require.NoError(b.t, o.SignPending(context.Background()))
+	}
+	require.NoError(b.t, b.Relay())
+	b.Eth.Commit()
+	for _, o := range b.Orchestrators {
+		require.NoError(b.t, o.ReportEvents(context.Background()))
+	}
+
+	staking.EndBlocker(ctx, b.Input.StakingKeeper)
+	gravity.EndBlocker(ctx, k)
+	b.Input.Context = ctx.WithBlockHeight(ctx.BlockHeight() + 1).
+		WithBlockTime(ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).AverageBlockTime) * time.Millisecond))
+}
+
+// StepUntil runs Step until cond holds, failing the test after maxSteps
+func (b *Bridge) StepUntil(maxSteps int, cond func() bool) {
+	b.t.Helper()
+	for i := 0; i < maxSteps; i++ {
+		if cond() {
+			return
+		}
+		b.Step()
+	}
+	require.True(b.t, cond(), "condition not met after %d steps", maxSteps)
+}
+
+// Relay runs a round of the relayer, its transactions are mined by the next Commit
+func (b *Bridge) Relay() error {
+	return b.relayer.Relay(context.Background())
+}
+
+// EthereumValset returns the validator set Gravity.sol currently holds
+func (b *Bridge) EthereumValset() (types.ValsetArgs, error) {
+	valset, err := b.relayer.EthereumValset(context.Background())
+	if err != nil {
+		return types.ValsetArgs{}, err
+	}
+	return types.NewValsetArgs(valset), nil
+}
+
+// Deposit sends amount of an ERC20 held by from to the Cosmos receiver through Gravity.sol, approving the
+// contract first. The transactions are mined by the next Commit
+func (b *Bridge) Deposit(from *ecdsa.PrivateKey, token gethcommon.Address, receiver sdk.AccAddress, amount *big.Int) error {
+	opts, err := bind.NewKeyedTransactorWithChainID(from, b.Eth.Blockchain().Config().ChainID)
+	if err != nil {
+		return err
+	}
+	erc20 := bind.NewBoundContract(token, erc20ABI, b.Eth, b.Eth, b.Eth)
+	if _, err := erc20.Transact(opts, "approve", b.Gravity, amount); err != nil {
+		return err
+	}
+	contract := bind.NewBoundContract(b.Gravity, gravityABI, b.Eth, b.Eth, b.Eth)
+	_, err = contract.Transact(opts, "sendToCosmos", token, receiver.String(), amount)
+	return err
+}
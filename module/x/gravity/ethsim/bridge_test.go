@@ -0,0 +1,105 @@
+package ethsim_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/ethsim"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// lastValsetNonce reads state_lastValsetNonce from the simulated Gravity.sol
+func lastValsetNonce(t *testing.T, b *ethsim.Bridge) uint64 {
+	contract := bind.NewBoundContract(b.Gravity, mustABI(t, types.GravityABIJSON), b.Eth, b.Eth, b.Eth)
+	var out []interface{}
+	require.NoError(t, contract.Call(nil, &out, "state_lastValsetNonce"))
+	return out[0].(*big.Int).Uint64()
+}
+
+//nolint: exhaustivestruct
+func TestBridgeRoundTrip(t *testing.T) {
+	b := ethsim.NewBridge(t)
+	defer func() { b.Input.AssertInvariants() }()
+	k := b.Input.GravityKeeper
+
+	// the orchestrators attest to the deployment and the relayer submits the first valset
+	b.StepUntil(5, func() bool {
+		return lastValsetNonce(t, b) == 1 && k.GetLastObservedEventNonce(b.Context()) == 2
+	})
+	require.Equal(t, uint64(1), k.GetLastObservedValset(b.Context()).Nonce)
+
+	// deposit an Ethereum originated token
+	var (
+		user     = b.EthUsers[0]
+		userAddr = crypto.PubkeyToAddress(user.PublicKey)
+		receiver = sdk.AccAddress(gethcommon.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+		minted   = big.NewInt(1000)
+		deposit  = big.NewInt(600)
+	)
+	token, err := b.Eth.DeployERC20("Simulated Token", "SIM", 6)
+	require.NoError(t, err)
+	require.NoError(t, b.Eth.Mint(token, userAddr, minted))
+	require.NoError(t, b.Deposit(user, token, receiver, deposit))
+
+	denom := types.GravityDenom(*mustEthAddress(t, token))
+	b.StepUntil(5, func() bool {
+		return b.Input.BankKeeper.GetBalance(b.Context(), receiver, denom).Amount.Equal(sdk.NewIntFromBigInt(deposit))
+	})
+	metadata, found := b.Input.BankKeeper.GetDenomMetaData(b.Context(), denom)
+	require.True(t, found)
+	require.Equal(t, "SIM", metadata.Symbol)
+
+	// send part of it back to Ethereum in a batch
+	h := gravity.NewHandler(k)
+	_, err = h(b.Context(), &types.MsgSendToEth{
+		Sender:    receiver.String(),
+		EthDest:   userAddr.Hex(),
+		Amount:    sdk.NewCoin(denom, sdk.NewInt(400)),
+		BridgeFee: sdk.NewCoin(denom, sdk.NewInt(50)),
+	})
+	require.NoError(t, err)
+	_, err = h(b.Context(), &types.MsgRequestBatch{Sender: keeper.OrchAddrs[0].String(), Denom: denom})
+	require.NoError(t, err)
+
+	b.StepUntil(5, func() bool {
+		balance, err := b.Eth.ERC20Balance(token, userAddr)
+		require.NoError(t, err)
+		return balance.Cmp(big.NewInt(800)) == 0
+	})
+	relayerFees, err := b.Eth.ERC20Balance(token, crypto.PubkeyToAddress(b.Relayer.PublicKey))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(50), relayerFees)
+	// the batch is removed once the orchestrators observe its execution
+	b.StepUntil(3, func() bool {
+		return len(k.GetOutgoingTxBatches(b.Context())) == 0
+	})
+	require.Equal(t, sdk.NewInt(150), b.Input.BankKeeper.GetBalance(b.Context(), receiver, denom).Amount)
+
+	// a large delegation changes the validator set, which must make its way to Ethereum
+	before := lastValsetNonce(t, b)
+	_, err = staking.NewHandler(b.Input.StakingKeeper)(b.Context(),
+		stakingtypes.NewMsgDelegate(keeper.AccAddrs[0], keeper.ValAddrs[0], sdk.NewCoin(keeper.TestingStakeParams.BondDenom, keeper.StakingAmount)))
+	require.NoError(t, err)
+	b.StepUntil(5, func() bool {
+		return lastValsetNonce(t, b) > before
+	})
+	b.StepUntil(3, func() bool {
+		return k.GetLastObservedValset(b.Context()).Nonce == lastValsetNonce(t, b)
+	})
+	ethValset, err := b.EthereumValset()
+	require.NoError(t, err)
+	current, err := k.GetCurrentValset(b.Context())
+	require.NoError(t, err)
+	require.Equal(t, types.NewValsetArgs(current).Powers, ethValset.Powers)
+}
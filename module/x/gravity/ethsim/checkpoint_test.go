@@ -0,0 +1,72 @@
+package ethsim
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// the simulated Gravity.sol must compute the same checkpoints as the real contract, these are the gold hashes
+// from /solidity/test/updateValsetAndSubmitBatch.ts also checked against the Cosmos side in the types package
+
+// goldGravityID is the gravity id used by the solidity tests, padded to a bytes32 like DeployGravity does
+var goldGravityID = methodName("foo")
+
+func TestValsetCheckpointGold1(t *testing.T) {
+	valset := types.Valset{
+		Nonce:        0,
+		Members:      types.BridgeValidators{{Power: 6667, EthereumAddress: "0xc783df8a850f42e7F7e57013759C285caa701eB6"}},
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	}
+
+	ourHash := makeCheckpoint(types.NewValsetArgs(valset), goldGravityID)
+
+	goldHash := "0x89731c26bab12cf0cb5363ef9abab6f9bd5496cf758a2309311c7946d54bca85"[2:]
+	assert.Equal(t, goldHash, hex.EncodeToString(ourHash.Bytes()))
+}
+
+func TestOutgoingTxBatchCheckpointGold1(t *testing.T) {
+	ourHash := makeBatchCheckpoint(
+		goldGravityID,
+		[]*big.Int{big.NewInt(1)},
+		[]gethcommon.Address{gethcommon.HexToAddress("0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39")},
+		[]*big.Int{big.NewInt(1)},
+		big.NewInt(1),
+		gethcommon.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"),
+		big.NewInt(2111),
+	)
+
+	goldHash := "0xa3a7ee0a363b8ad2514e7ee8f110d7449c0d88f3b0913c28c1751e6e0079a9b2"[2:]
+	assert.Equal(t, goldHash, hex.EncodeToString(ourHash.Bytes()))
+}
+
+func TestOutgoingLogicCallCheckpointGold1(t *testing.T) {
+	payload, err := hex.DecodeString("0x74657374696e675061796c6f6164000000000000000000000000000000000000"[2:])
+	require.NoError(t, err)
+	var invalidationID [32]byte
+	copy(invalidationID[:], "invalidationId")
+	token := gethcommon.HexToAddress("0xC26eFfa98B8A2632141562Ae7E34953Cfe5B4888")
+
+	ourHash := makeLogicCallCheckpoint(types.LogicCallArgs{
+		TransferAmounts:        []*big.Int{big.NewInt(1)},
+		TransferTokenContracts: []gethcommon.Address{token},
+		FeeAmounts:             []*big.Int{big.NewInt(1)},
+		FeeTokenContracts:      []gethcommon.Address{token},
+		LogicContractAddress:   gethcommon.HexToAddress("0x17c1736CcF692F653c433d7aa2aB45148C016F68"),
+		Payload:                payload,
+		TimeOut:                big.NewInt(4766922941000),
+		InvalidationId:         invalidationID,
+		InvalidationNonce:      big.NewInt(1),
+	}, goldGravityID)
+
+	goldHash := "0x1de95c9ace999f8ec70c6dc8d045942da2612950567c4861aca959c0650194da"[2:]
+	assert.Equal(t, goldHash, hex.EncodeToString(ourHash.Bytes()))
+}
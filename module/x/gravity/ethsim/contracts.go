@@ -0,0 +1,690 @@
+package ethsim
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// The errors below mirror the custom errors Gravity.sol reverts with, so that tests can assert on the exact
+// reason a submission was rejected with errors.Is. Errors which take arguments in Gravity.sol are returned
+// wrapped by revertWith, so that the revert reason reads the same as that of the compiled contract
+var (
+	ErrExecutionReverted         = errors.New("execution reverted")
+	ErrInvalidSignature          = fmt.Errorf("%w: InvalidSignature()", ErrExecutionReverted)
+	ErrInvalidValsetNonce        = fmt.Errorf("%w: InvalidValsetNonce", ErrExecutionReverted)
+	ErrInvalidBatchNonce         = fmt.Errorf("%w: InvalidBatchNonce", ErrExecutionReverted)
+	ErrInvalidLogicCallNonce     = fmt.Errorf("%w: InvalidLogicCallNonce", ErrExecutionReverted)
+	ErrInvalidLogicCallTransfers = fmt.Errorf("%w: InvalidLogicCallTransfers()", ErrExecutionReverted)
+	ErrInvalidLogicCallFees      = fmt.Errorf("%w: InvalidLogicCallFees()", ErrExecutionReverted)
+	ErrInvalidSendToCosmos       = fmt.Errorf("%w: InvalidSendToCosmos()", ErrExecutionReverted)
+	ErrIncorrectCheckpoint       = fmt.Errorf("%w: IncorrectCheckpoint()", ErrExecutionReverted)
+	ErrMalformedNewValidatorSet  = fmt.Errorf("%w: MalformedNewValidatorSet()", ErrExecutionReverted)
+	ErrMalformedCurrentValset    = fmt.Errorf("%w: MalformedCurrentValidatorSet()", ErrExecutionReverted)
+	ErrMalformedBatch            = fmt.Errorf("%w: MalformedBatch()", ErrExecutionReverted)
+	ErrInsufficientPower         = fmt.Errorf("%w: InsufficientPower", ErrExecutionReverted)
+	ErrBatchTimedOut             = fmt.Errorf("%w: BatchTimedOut()", ErrExecutionReverted)
+	ErrLogicCallTimedOut         = fmt.Errorf("%w: LogicCallTimedOut()", ErrExecutionReverted)
+	ErrInsufficientBalance       = fmt.Errorf("%w: ERC20: transfer amount exceeds balance", ErrExecutionReverted)
+	ErrInsufficientAllowance     = fmt.Errorf("%w: ERC20: transfer amount exceeds allowance", ErrExecutionReverted)
+	ErrUnknownMethod             = fmt.Errorf("%w: unknown method", ErrExecutionReverted)
+	ErrNoContractCode            = fmt.Errorf("%w: Address: call to non-contract", ErrExecutionReverted)
+	ErrTransferToZeroAddress     = fmt.Errorf("%w: ERC20: transfer to the zero address", ErrExecutionReverted)
+)
+
+// revertWith adds the arguments of a custom error to its revert reason, e.g. InsufficientPower(625, 2863311530)
+func revertWith(err error, args ...*big.Int) error {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = arg.String()
+	}
+	return fmt.Errorf("%w(%s)", err, strings.Join(strs, ", "))
+}
+
+// signaturePrefix is prepended to every hash signed by the validators, see NewEthereumSignature
+const signaturePrefix = "\x19Ethereum Signed Message:\n32"
+
+var (
+	gravityABI = types.MustParseABI(types.GravityABIJSON)
+	erc20ABI   = types.MustParseABI(types.ERC20ABIJSON)
+
+	// maxUint256 is the total supply of an ERC20 deployed by Gravity.sol, all of it held by the contract
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+// callContext is one message call into a simulated contract
+type callContext struct {
+	world       *world
+	self        gethcommon.Address
+	sender      gethcommon.Address
+	blockNumber uint64
+}
+
+// emit records an event emitted by the called contract, the block and transaction fields are filled in
+// by the backend once the transaction is included
+func (c callContext) emit(contractAbi abi.ABI, name string, indexed []gethcommon.Hash, args ...interface{}) {
+	event := contractAbi.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		panic(fmt.Sprintf("packing %s: %v", name, err))
+	}
+	c.world.logs = append(c.world.logs, &ethtypes.Log{
+		Address: c.self,
+		Topics:  append([]gethcommon.Hash{event.ID}, indexed...),
+		Data:    data,
+	})
+}
+
+// contract is the Go stand-in for the bytecode of a deployed contract
+type contract interface {
+	abi() abi.ABI
+	call(ctx callContext, method *abi.Method, args []interface{}) ([]interface{}, error)
+	clone() contract
+}
+
+// world is the state of every simulated contract, it is copied before each transaction so that a revert
+// discards every state change made by the transaction, including those in other contracts
+type world struct {
+	contracts map[gethcommon.Address]contract
+	logs      []*ethtypes.Log
+}
+
+func newWorld() *world {
+	return &world{contracts: make(map[gethcommon.Address]contract)}
+}
+
+func (w *world) clone() *world {
+	c := &world{contracts: make(map[gethcommon.Address]contract, len(w.contracts))}
+	for addr, con := range w.contracts {
+		c.contracts[addr] = con.clone()
+	}
+	return c
+}
+
+// call executes a call to a simulated contract with abi encoded input and returns the abi encoded output
+func (w *world) call(sender, to gethcommon.Address, input []byte, blockNumber uint64) ([]byte, error) {
+	con, ok := w.contracts[to]
+	if !ok {
+		return nil, ErrNoContractCode
+	}
+	if len(input) < 4 {
+		return nil, ErrUnknownMethod
+	}
+	contractAbi := con.abi()
+	method, err := contractAbi.MethodById(input[:4])
+	if err != nil {
+		return nil, ErrUnknownMethod
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExecutionReverted, err)
+	}
+	ctx := callContext{world: w, self: to, sender: sender, blockNumber: blockNumber}
+	out, err := con.call(ctx, method, args)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out...)
+}
+
+// erc20 returns the simulated ERC20 at the given address
+func (w *world) erc20(token gethcommon.Address) (*erc20Contract, error) {
+	con, ok := w.contracts[token].(*erc20Contract)
+	if !ok {
+		return nil, ErrNoContractCode
+	}
+	return con, nil
+}
+
+// erc20Contract is a plain OpenZeppelin style ERC20, Gravity.sol deploys these for Cosmos-originated tokens
+// and tests deploy them as Ethereum-originated tokens
+type erc20Contract struct {
+	name        string
+	symbol      string
+	decimals    uint8
+	totalSupply *big.Int
+	balances    map[gethcommon.Address]*big.Int
+	allowances  map[[2]gethcommon.Address]*big.Int
+}
+
+func newERC20(name, symbol string, decimals uint8) *erc20Contract {
+	return &erc20Contract{
+		name:        name,
+		symbol:      symbol,
+		decimals:    decimals,
+		totalSupply: new(big.Int),
+		balances:    make(map[gethcommon.Address]*big.Int),
+		allowances:  make(map[[2]gethcommon.Address]*big.Int),
+	}
+}
+
+func (e *erc20Contract) abi() abi.ABI {
+	return erc20ABI
+}
+
+// clone copies the maps, the values are never mutated in place so they can be shared
+func (e *erc20Contract) clone() contract {
+	c := *e
+	c.balances = make(map[gethcommon.Address]*big.Int, len(e.balances))
+	for k, v := range e.balances {
+		c.balances[k] = v
+	}
+	c.allowances = make(map[[2]gethcommon.Address]*big.Int, len(e.allowances))
+	for k, v := range e.allowances {
+		c.allowances[k] = v
+	}
+	return &c
+}
+
+func (e *erc20Contract) balanceOf(account gethcommon.Address) *big.Int {
+	if b, ok := e.balances[account]; ok {
+		return b
+	}
+	return new(big.Int)
+}
+
+func (e *erc20Contract) allowance(owner, spender gethcommon.Address) *big.Int {
+	if a, ok := e.allowances[[2]gethcommon.Address{owner, spender}]; ok {
+		return a
+	}
+	return new(big.Int)
+}
+
+func (e *erc20Contract) mint(ctx callContext, to gethcommon.Address, amount *big.Int) {
+	e.totalSupply = new(big.Int).Add(e.totalSupply, amount)
+	e.balances[to] = new(big.Int).Add(e.balanceOf(to), amount)
+	ctx.emit(erc20ABI, "Transfer", []gethcommon.Hash{{}, addressTopic(to)}, amount)
+}
+
+func (e *erc20Contract) transfer(ctx callContext, from, to gethcommon.Address, amount *big.Int) error {
+	if to == (gethcommon.Address{}) {
+		return ErrTransferToZeroAddress
+	}
+	balance := e.balanceOf(from)
+	if balance.Cmp(amount) < 0 {
+		return ErrInsufficientBalance
+	}
+	e.balances[from] = new(big.Int).Sub(balance, amount)
+	e.balances[to] = new(big.Int).Add(e.balanceOf(to), amount)
+	ctx.emit(erc20ABI, "Transfer", []gethcommon.Hash{addressTopic(from), addressTopic(to)}, amount)
+	return nil
+}
+
+func (e *erc20Contract) call(ctx callContext, method *abi.Method, args []interface{}) ([]interface{}, error) {
+	switch method.Name {
+	case "name":
+		return []interface{}{e.name}, nil
+	case "symbol":
+		return []interface{}{e.symbol}, nil
+	case "decimals":
+		return []interface{}{e.decimals}, nil
+	case "totalSupply":
+		return []interface{}{e.totalSupply}, nil
+	case "balanceOf":
+		return []interface{}{e.balanceOf(args[0].(gethcommon.Address))}, nil
+	case "allowance":
+		return []interface{}{e.allowance(args[0].(gethcommon.Address), args[1].(gethcommon.Address))}, nil
+	case "transfer":
+		if err := e.transfer(ctx, ctx.sender, args[0].(gethcommon.Address), args[1].(*big.Int)); err != nil {
+			return nil, err
+		}
+		return []interface{}{true}, nil
+	case "approve":
+		spender, amount := args[0].(gethcommon.Address), args[1].(*big.Int)
+		e.allowances[[2]gethcommon.Address{ctx.sender, spender}] = amount
+		ctx.emit(erc20ABI, "Approval", []gethcommon.Hash{addressTopic(ctx.sender), addressTopic(spender)}, amount)
+		return []interface{}{true}, nil
+	case "transferFrom":
+		from, to, amount := args[0].(gethcommon.Address), args[1].(gethcommon.Address), args[2].(*big.Int)
+		allowance := e.allowance(from, ctx.sender)
+		if allowance.Cmp(amount) < 0 {
+			return nil, ErrInsufficientAllowance
+		}
+		if err := e.transfer(ctx, from, to, amount); err != nil {
+			return nil, err
+		}
+		e.allowances[[2]gethcommon.Address{from, ctx.sender}] = new(big.Int).Sub(allowance, amount)
+		return []interface{}{true}, nil
+	default:
+		return nil, ErrUnknownMethod
+	}
+}
+
+// gravityContract is a Go transcription of solidity/contracts/Gravity.sol
+type gravityContract struct {
+	gravityID            [32]byte
+	lastValsetCheckpoint [32]byte
+	lastValsetNonce      *big.Int
+	lastEventNonce       *big.Int
+	lastBatchNonces      map[gethcommon.Address]*big.Int
+	invalidationMapping  map[[32]byte]*big.Int
+	// deployedERC20s counts the ERC20s created by deployERC20, it stands in for the contract's account nonce
+	deployedERC20s uint64
+}
+
+// newGravity runs the Gravity.sol constructor
+func newGravity(ctx callContext, gravityID [32]byte, validators []gethcommon.Address, powers []*big.Int) (*gravityContract, error) {
+	if len(validators) != len(powers) || len(validators) == 0 {
+		return nil, ErrMalformedCurrentValset
+	}
+	if err := checkCumulativePower(powers); err != nil {
+		return nil, err
+	}
+	valset := types.ValsetArgs{
+		Validators:   validators,
+		Powers:       powers,
+		ValsetNonce:  new(big.Int),
+		RewardAmount: new(big.Int),
+		RewardToken:  gethcommon.Address{},
+	}
+	g := &gravityContract{
+		gravityID:            gravityID,
+		lastValsetCheckpoint: makeCheckpoint(valset, gravityID),
+		lastValsetNonce:      new(big.Int),
+		lastEventNonce:       big.NewInt(1),
+		lastBatchNonces:      make(map[gethcommon.Address]*big.Int),
+		invalidationMapping:  make(map[[32]byte]*big.Int),
+	}
+	ctx.emit(gravityABI, "ValsetUpdatedEvent", []gethcommon.Hash{uintTopic(valset.ValsetNonce)},
+		g.lastEventNonce, valset.RewardAmount, valset.RewardToken, validators, powers)
+	return g, nil
+}
+
+func (g *gravityContract) abi() abi.ABI {
+	return gravityABI
+}
+
+func (g *gravityContract) clone() contract {
+	c := *g
+	c.lastBatchNonces = make(map[gethcommon.Address]*big.Int, len(g.lastBatchNonces))
+	for k, v := range g.lastBatchNonces {
+		c.lastBatchNonces[k] = v
+	}
+	c.invalidationMapping = make(map[[32]byte]*big.Int, len(g.invalidationMapping))
+	for k, v := range g.invalidationMapping {
+		c.invalidationMapping[k] = v
+	}
+	return &c
+}
+
+func (g *gravityContract) lastBatchNonce(token gethcommon.Address) *big.Int {
+	if n, ok := g.lastBatchNonces[token]; ok {
+		return n
+	}
+	return new(big.Int)
+}
+
+func (g *gravityContract) lastLogicCallNonce(invalidationID [32]byte) *big.Int {
+	if n, ok := g.invalidationMapping[invalidationID]; ok {
+		return n
+	}
+	return new(big.Int)
+}
+
+func (g *gravityContract) nextEventNonce() *big.Int {
+	g.lastEventNonce = new(big.Int).Add(g.lastEventNonce, big.NewInt(1))
+	return g.lastEventNonce
+}
+
+func (g *gravityContract) call(ctx callContext, method *abi.Method, args []interface{}) ([]interface{}, error) {
+	switch method.Name {
+	case "updateValset":
+		return nil, g.updateValset(ctx,
+			*abi.ConvertType(args[0], new(types.ValsetArgs)).(*types.ValsetArgs),
+			*abi.ConvertType(args[1], new(types.ValsetArgs)).(*types.ValsetArgs),
+			*abi.ConvertType(args[2], new([]types.Signature)).(*[]types.Signature),
+		)
+	case "submitBatch":
+		return nil, g.submitBatch(ctx,
+			*abi.ConvertType(args[0], new(types.ValsetArgs)).(*types.ValsetArgs),
+			*abi.ConvertType(args[1], new([]types.Signature)).(*[]types.Signature),
+			args[2].([]*big.Int), args[3].([]gethcommon.Address), args[4].([]*big.Int),
+			args[5].(*big.Int), args[6].(gethcommon.Address), args[7].(*big.Int),
+		)
+	case "submitLogicCall":
+		return nil, g.submitLogicCall(ctx,
+			*abi.ConvertType(args[0], new(types.ValsetArgs)).(*types.ValsetArgs),
+			*abi.ConvertType(args[1], new([]types.Signature)).(*[]types.Signature),
+			*abi.ConvertType(args[2], new(types.LogicCallArgs)).(*types.LogicCallArgs),
+		)
+	case "sendToCosmos":
+		return nil, g.sendToCosmos(ctx, args[0].(gethcommon.Address), args[1].(string), args[2].(*big.Int))
+	case "deployERC20":
+		return nil, g.deployERC20(ctx, args[0].(string), args[1].(string), args[2].(string), args[3].(uint8))
+	case "lastBatchNonce":
+		return []interface{}{g.lastBatchNonce(args[0].(gethcommon.Address))}, nil
+	case "lastLogicCallNonce":
+		return []interface{}{g.lastLogicCallNonce(args[0].([32]byte))}, nil
+	case "state_gravityId":
+		return []interface{}{g.gravityID}, nil
+	case "state_lastEventNonce":
+		return []interface{}{g.lastEventNonce}, nil
+	case "state_lastValsetCheckpoint":
+		return []interface{}{g.lastValsetCheckpoint}, nil
+	case "state_lastValsetNonce":
+		return []interface{}{g.lastValsetNonce}, nil
+	default:
+		return nil, ErrUnknownMethod
+	}
+}
+
+func (g *gravityContract) updateValset(ctx callContext, newValset, currentValset types.ValsetArgs, sigs []types.Signature) error {
+	// Check that the valset nonce is greater than the old one, but not more than one million nonces ahead
+	if newValset.ValsetNonce.Cmp(currentValset.ValsetNonce) <= 0 {
+		return revertWith(ErrInvalidValsetNonce, newValset.ValsetNonce, currentValset.ValsetNonce)
+	}
+	if newValset.ValsetNonce.Cmp(new(big.Int).Add(currentValset.ValsetNonce, big.NewInt(1000000))) > 0 {
+		return revertWith(ErrInvalidValsetNonce, newValset.ValsetNonce, currentValset.ValsetNonce)
+	}
+	if len(newValset.Validators) != len(newValset.Powers) || len(newValset.Validators) == 0 {
+		return ErrMalformedNewValidatorSet
+	}
+	if err := validateValset(currentValset, sigs); err != nil {
+		return err
+	}
+	// Check that the new validator set has enough power to pass a vote
+	if err := checkCumulativePower(newValset.Powers); err != nil {
+		return err
+	}
+	if makeCheckpoint(currentValset, g.gravityID) != g.lastValsetCheckpoint {
+		return ErrIncorrectCheckpoint
+	}
+	newCheckpoint := makeCheckpoint(newValset, g.gravityID)
+	if err := checkValidatorSignatures(currentValset, sigs, newCheckpoint); err != nil {
+		return err
+	}
+
+	g.lastValsetCheckpoint = newCheckpoint
+	g.lastValsetNonce = newValset.ValsetNonce
+
+	// Send the reward to the relayer
+	if newValset.RewardToken != (gethcommon.Address{}) && newValset.RewardAmount.Sign() != 0 {
+		token, err := ctx.world.erc20(newValset.RewardToken)
+		if err != nil {
+			return err
+		}
+		if err := token.transfer(ctx.forToken(newValset.RewardToken), ctx.self, ctx.sender, newValset.RewardAmount); err != nil {
+			return err
+		}
+	}
+
+	eventNonce := g.nextEventNonce()
+	ctx.emit(gravityABI, "ValsetUpdatedEvent", []gethcommon.Hash{uintTopic(newValset.ValsetNonce)},
+		eventNonce, newValset.RewardAmount, newValset.RewardToken, newValset.Validators, newValset.Powers)
+	return nil
+}
+
+func (g *gravityContract) submitBatch(
+	ctx callContext,
+	currentValset types.ValsetArgs,
+	sigs []types.Signature,
+	amounts []*big.Int,
+	destinations []gethcommon.Address,
+	fees []*big.Int,
+	batchNonce *big.Int,
+	tokenContract gethcommon.Address,
+	batchTimeout *big.Int,
+) error {
+	lastNonce := g.lastBatchNonce(tokenContract)
+	if batchNonce.Cmp(lastNonce) <= 0 {
+		return revertWith(ErrInvalidBatchNonce, batchNonce, lastNonce)
+	}
+	if batchNonce.Cmp(new(big.Int).Add(lastNonce, big.NewInt(1000000))) > 0 {
+		return revertWith(ErrInvalidBatchNonce, batchNonce, lastNonce)
+	}
+	if new(big.Int).SetUint64(ctx.blockNumber).Cmp(batchTimeout) >= 0 {
+		return ErrBatchTimedOut
+	}
+	if err := validateValset(currentValset, sigs); err != nil {
+		return err
+	}
+	if makeCheckpoint(currentValset, g.gravityID) != g.lastValsetCheckpoint {
+		return ErrIncorrectCheckpoint
+	}
+	if len(amounts) != len(destinations) || len(amounts) != len(fees) {
+		return ErrMalformedBatch
+	}
+	batchHash := makeBatchCheckpoint(g.gravityID, amounts, destinations, fees, batchNonce, tokenContract, batchTimeout)
+	if err := checkValidatorSignatures(currentValset, sigs, batchHash); err != nil {
+		return err
+	}
+
+	g.lastBatchNonces[tokenContract] = batchNonce
+
+	token, err := ctx.world.erc20(tokenContract)
+	if err != nil {
+		return err
+	}
+	tokenCtx := ctx.forToken(tokenContract)
+	totalFee := new(big.Int)
+	for i := range amounts {
+		if err := token.transfer(tokenCtx, ctx.self, destinations[i], amounts[i]); err != nil {
+			return err
+		}
+		totalFee.Add(totalFee, fees[i])
+	}
+	if err := token.transfer(tokenCtx, ctx.self, ctx.sender, totalFee); err != nil {
+		return err
+	}
+
+	eventNonce := g.nextEventNonce()
+	ctx.emit(gravityABI, "TransactionBatchExecutedEvent", []gethcommon.Hash{uintTopic(batchNonce), addressTopic(tokenContract)}, eventNonce)
+	return nil
+}
+
+func (g *gravityContract) submitLogicCall(ctx callContext, currentValset types.ValsetArgs, sigs []types.Signature, args types.LogicCallArgs) error {
+	if new(big.Int).SetUint64(ctx.blockNumber).Cmp(args.TimeOut) >= 0 {
+		return ErrLogicCallTimedOut
+	}
+	if lastNonce := g.lastLogicCallNonce(args.InvalidationId); lastNonce.Cmp(args.InvalidationNonce) >= 0 {
+		return revertWith(ErrInvalidLogicCallNonce, args.InvalidationNonce, lastNonce)
+	}
+	if err := validateValset(currentValset, sigs); err != nil {
+		return err
+	}
+	if makeCheckpoint(currentValset, g.gravityID) != g.lastValsetCheckpoint {
+		return ErrIncorrectCheckpoint
+	}
+	if len(args.TransferAmounts) != len(args.TransferTokenContracts) {
+		return ErrInvalidLogicCallTransfers
+	}
+	if len(args.FeeAmounts) != len(args.FeeTokenContracts) {
+		return ErrInvalidLogicCallFees
+	}
+	argsHash := makeLogicCallCheckpoint(args, g.gravityID)
+	if err := checkValidatorSignatures(currentValset, sigs, argsHash); err != nil {
+		return err
+	}
+
+	g.invalidationMapping[args.InvalidationId] = args.InvalidationNonce
+
+	for i, amount := range args.TransferAmounts {
+		token, err := ctx.world.erc20(args.TransferTokenContracts[i])
+		if err != nil {
+			return err
+		}
+		if err := token.transfer(ctx.forToken(args.TransferTokenContracts[i]), ctx.self, args.LogicContractAddress, amount); err != nil {
+			return err
+		}
+	}
+	// only simulated contracts can be the target of a logic call, there is no EVM to run anything else
+	returnData, err := ctx.world.call(ctx.self, args.LogicContractAddress, args.Payload, ctx.blockNumber)
+	if err != nil {
+		return err
+	}
+	for i, amount := range args.FeeAmounts {
+		token, err := ctx.world.erc20(args.FeeTokenContracts[i])
+		if err != nil {
+			return err
+		}
+		if err := token.transfer(ctx.forToken(args.FeeTokenContracts[i]), ctx.self, ctx.sender, amount); err != nil {
+			return err
+		}
+	}
+
+	eventNonce := g.nextEventNonce()
+	ctx.emit(gravityABI, "LogicCallEvent", nil, args.InvalidationId, args.InvalidationNonce, returnData, eventNonce)
+	return nil
+}
+
+func (g *gravityContract) sendToCosmos(ctx callContext, tokenContract gethcommon.Address, destination string, amount *big.Int) error {
+	token, err := ctx.world.erc20(tokenContract)
+	if err != nil {
+		return err
+	}
+	startingBalance := token.balanceOf(ctx.self)
+	// transferFrom is called by Gravity.sol, so the allowance checked is the one granted to the contract
+	tokenCtx := callContext{world: ctx.world, self: tokenContract, sender: ctx.self, blockNumber: ctx.blockNumber}
+	method := erc20ABI.Methods["transferFrom"]
+	if _, err := token.call(tokenCtx, &method, []interface{}{ctx.sender, ctx.self, amount}); err != nil {
+		return err
+	}
+	endingBalance := token.balanceOf(ctx.self)
+	if endingBalance.Cmp(startingBalance) <= 0 {
+		return ErrInvalidSendToCosmos
+	}
+
+	eventNonce := g.nextEventNonce()
+	ctx.emit(gravityABI, "SendToCosmosEvent", []gethcommon.Hash{addressTopic(tokenContract), addressTopic(ctx.sender)},
+		destination, new(big.Int).Sub(endingBalance, startingBalance), eventNonce)
+	return nil
+}
+
+func (g *gravityContract) deployERC20(ctx callContext, cosmosDenom, name, symbol string, decimals uint8) error {
+	// CosmosERC20 mints the entire supply to Gravity.sol in its constructor
+	address := crypto.CreateAddress(ctx.self, g.deployedERC20s)
+	g.deployedERC20s++
+	token := newERC20(name, symbol, decimals)
+	ctx.world.contracts[address] = token
+	token.mint(ctx.forToken(address), ctx.self, maxUint256)
+
+	eventNonce := g.nextEventNonce()
+	ctx.emit(gravityABI, "ERC20DeployedEvent", []gethcommon.Hash{addressTopic(address)},
+		cosmosDenom, name, symbol, decimals, eventNonce)
+	return nil
+}
+
+// forToken returns the context used by this contract to call a token contract, events emitted in it are
+// attributed to the token
+func (c callContext) forToken(token gethcommon.Address) callContext {
+	return callContext{world: c.world, self: token, sender: c.self, blockNumber: c.blockNumber}
+}
+
+// validateValset checks that the current valset and the signatures are well formed
+func validateValset(valset types.ValsetArgs, sigs []types.Signature) error {
+	if len(valset.Validators) != len(valset.Powers) || len(valset.Validators) != len(sigs) {
+		return ErrMalformedCurrentValset
+	}
+	return nil
+}
+
+// checkCumulativePower checks that the powers add up to more than the power threshold
+func checkCumulativePower(powers []*big.Int) error {
+	cumulativePower := new(big.Int)
+	threshold := big.NewInt(types.GravityPowerThreshold)
+	for _, p := range powers {
+		cumulativePower.Add(cumulativePower, p)
+		if cumulativePower.Cmp(threshold) > 0 {
+			return nil
+		}
+	}
+	return revertWith(ErrInsufficientPower, cumulativePower, threshold)
+}
+
+// checkValidatorSignatures checks that validators holding more than the power threshold signed theHash,
+// signatures with v == 0 mark validators who did not sign and are skipped
+func checkValidatorSignatures(valset types.ValsetArgs, sigs []types.Signature, theHash gethcommon.Hash) error {
+	cumulativePower := new(big.Int)
+	threshold := big.NewInt(types.GravityPowerThreshold)
+	for i, sig := range sigs {
+		if sig.V == 0 {
+			continue
+		}
+		if !verifySig(valset.Validators[i], theHash, sig) {
+			return ErrInvalidSignature
+		}
+		cumulativePower.Add(cumulativePower, valset.Powers[i])
+		// Break early to avoid wasting gas
+		if cumulativePower.Cmp(threshold) > 0 {
+			return nil
+		}
+	}
+	return revertWith(ErrInsufficientPower, cumulativePower, threshold)
+}
+
+// verifySig is the equivalent of ecrecover on the Ethereum signed message digest of theHash
+func verifySig(signer gethcommon.Address, theHash gethcommon.Hash, sig types.Signature) bool {
+	if sig.V != 27 && sig.V != 28 {
+		return false
+	}
+	digest := crypto.Keccak256(append([]byte(signaturePrefix), theHash.Bytes()...))
+	rsv := append(append(sig.R[:], sig.S[:]...), sig.V-27)
+	pubKey, err := crypto.SigToPub(digest, rsv)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(crypto.PubkeyToAddress(*pubKey).Bytes(), signer.Bytes())
+}
+
+// makeCheckpoint is the checkpoint Gravity.sol stores for a valset, it is the same value computed by
+// Valset.GetCheckpoint on the Cosmos side
+func makeCheckpoint(valset types.ValsetArgs, gravityID [32]byte) gethcommon.Hash {
+	return crypto.Keccak256Hash(mustEncode(types.ValsetCheckpointABIJSON, "checkpoint",
+		gravityID, methodName("checkpoint"), valset.ValsetNonce, valset.Validators, valset.Powers,
+		valset.RewardAmount, valset.RewardToken))
+}
+
+// makeBatchCheckpoint is the hash Gravity.sol checks the signatures of a batch against, it is the same value
+// computed by OutgoingTxBatch.GetCheckpoint on the Cosmos side
+func makeBatchCheckpoint(
+	gravityID [32]byte,
+	amounts []*big.Int,
+	destinations []gethcommon.Address,
+	fees []*big.Int,
+	batchNonce *big.Int,
+	tokenContract gethcommon.Address,
+	batchTimeout *big.Int,
+) gethcommon.Hash {
+	return crypto.Keccak256Hash(mustEncode(types.OutgoingBatchTxCheckpointABIJSON, "submitBatch",
+		gravityID, methodName("transactionBatch"), amounts, destinations, fees, batchNonce, tokenContract, batchTimeout))
+}
+
+// makeLogicCallCheckpoint is the hash Gravity.sol checks the signatures of a logic call against, it is the
+// same value computed by OutgoingLogicCall.GetCheckpoint on the Cosmos side
+func makeLogicCallCheckpoint(args types.LogicCallArgs, gravityID [32]byte) gethcommon.Hash {
+	return crypto.Keccak256Hash(mustEncode(types.OutgoingLogicCallABIJSON, "checkpoint",
+		gravityID, methodName("logicCall"), args.TransferAmounts, args.TransferTokenContracts, args.FeeAmounts,
+		args.FeeTokenContracts, args.LogicContractAddress, args.Payload, args.TimeOut, args.InvalidationId, args.InvalidationNonce))
+}
+
+// mustEncode emulates abi.encode by packing a call to the given method and discarding the selector
+func mustEncode(abiJSON, method string, args ...interface{}) []byte {
+	bz, err := types.MustParseABI(abiJSON).Pack(method, args...)
+	if err != nil {
+		panic(fmt.Sprintf("encoding %s: %v", method, err))
+	}
+	return bz[4:]
+}
+
+func methodName(name string) [32]byte {
+	var b [32]byte
+	copy(b[:], name)
+	return b
+}
+
+func addressTopic(addr gethcommon.Address) gethcommon.Hash {
+	return gethcommon.BytesToHash(addr.Bytes())
+}
+
+func uintTopic(n *big.Int) gethcommon.Hash {
+	return gethcommon.BigToHash(n)
+}
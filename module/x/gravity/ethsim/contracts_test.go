@@ -0,0 +1,128 @@
+package ethsim_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/ethsim"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+func mustABI(t *testing.T, abiJSON string) abi.ABI {
+	contractAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	require.NoError(t, err)
+	return contractAbi
+}
+
+func mustEthAddress(t *testing.T, addr gethcommon.Address) *types.EthAddress {
+	ethAddr, err := types.NewEthAddress(addr.Hex())
+	require.NoError(t, err)
+	return ethAddr
+}
+
+// signValset signs the checkpoint of valset with the keys of the signers, in the order of the members
+func signValset(t *testing.T, gravityID string, valset types.Valset, signers []*ecdsa.PrivateKey) []types.Signature {
+	sigs := make([]types.Signature, len(signers))
+	for i, key := range signers {
+		if key == nil {
+			continue
+		}
+		sig, err := types.NewEthereumSignature(valset.GetCheckpoint(gravityID), key)
+		require.NoError(t, err)
+		copy(sigs[i].R[:], sig[:32])
+		copy(sigs[i].S[:], sig[32:64])
+		sigs[i].V = sig[64] + 27
+	}
+	return sigs
+}
+
+//nolint: exhaustivestruct
+func TestGravityUpdateValset(t *testing.T) {
+	const gravityID = "testgravityid"
+	keys := make([]*ecdsa.PrivateKey, 3)
+	members := make(types.BridgeValidators, len(keys))
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		members[i] = types.BridgeValidator{Power: 1431655766, EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex()}
+	}
+	relayer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	relayerAddr := crypto.PubkeyToAddress(relayer.PublicKey)
+	eth := ethsim.NewBackend(core.GenesisAlloc{relayerAddr: {Balance: big.NewInt(1e18)}})
+
+	current := types.Valset{Nonce: 0, Members: members, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddressString}
+	currentArgs := types.NewValsetArgs(current)
+	gravityAddr, err := eth.DeployGravity(gravityID, currentArgs.Validators, currentArgs.Powers)
+	require.NoError(t, err)
+
+	next := current
+	next.Nonce = 1
+	nextArgs := types.NewValsetArgs(next)
+
+	contract := bind.NewBoundContract(gravityAddr, mustABI(t, types.GravityABIJSON), eth, eth, eth)
+	opts, err := bind.NewKeyedTransactorWithChainID(relayer, eth.Blockchain().Config().ChainID)
+	require.NoError(t, err)
+	// skip gas estimation, which would refuse to send a reverting transaction
+	opts.GasLimit = 1000000
+
+	submit := func(sigs []types.Signature) (*ethtypes.Receipt, error) {
+		tx, err := contract.Transact(opts, "updateValset", nextArgs, currentArgs, sigs)
+		require.NoError(t, err)
+		eth.Commit()
+		receipt, err := eth.TransactionReceipt(context.Background(), tx.Hash())
+		require.NoError(t, err)
+		return receipt, eth.TransactionError(tx.Hash())
+	}
+
+	// one of three validators does not hold 2/3 of the power
+	receipt, err := submit(signValset(t, gravityID, next, []*ecdsa.PrivateKey{keys[0], nil, nil}))
+	require.True(t, errors.Is(err, ethsim.ErrInsufficientPower), err)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	// a signature from a key which is not the member's
+	receipt, err = submit(signValset(t, gravityID, next, []*ecdsa.PrivateKey{keys[0], keys[2], keys[2]}))
+	require.True(t, errors.Is(err, ethsim.ErrInvalidSignature), err)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	// a signature over another gravity id
+	receipt, err = submit(signValset(t, "othergravityid", next, keys))
+	require.True(t, errors.Is(err, ethsim.ErrInvalidSignature), err)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	// two of three validators are enough
+	receipt, err = submit(signValset(t, gravityID, next, []*ecdsa.PrivateKey{keys[0], nil, keys[2]}))
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	require.Len(t, receipt.Logs, 1)
+
+	call := func(method string) interface{} {
+		var out []interface{}
+		require.NoError(t, contract.Call(nil, &out, method))
+		return out[0]
+	}
+	require.Equal(t, big.NewInt(1), call("state_lastValsetNonce"))
+	require.Equal(t, big.NewInt(2), call("state_lastEventNonce"))
+	checkpoint := call("state_lastValsetCheckpoint").([32]byte)
+	require.Equal(t, next.GetCheckpoint(gravityID), checkpoint[:])
+
+	// the same update can not be replayed since the contract no longer holds the signing valset, gas estimation reports why
+	opts.GasLimit = 0
+	_, err = contract.Transact(opts, "updateValset", nextArgs, currentArgs, signValset(t, gravityID, next, keys))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "IncorrectCheckpoint")
+}
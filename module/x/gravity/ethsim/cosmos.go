@@ -0,0 +1,77 @@
+package ethsim
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/orchestrator"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// CosmosClient is an orchestrator.CosmosClient answering from the keeper of a TestInput: queries and
+// messages are run against the context of the current block, messages through the gravity handler
+// instead of being signed and broadcast
+type CosmosClient struct {
+	types.QueryClient
+
+	input *keeper.TestInput
+}
+
+var _ orchestrator.CosmosClient = CosmosClient{}
+
+// NewCosmosClient returns a CosmosClient for input, it follows input.Context as the test advances blocks
+func NewCosmosClient(input *keeper.TestInput) CosmosClient {
+	helper := baseapp.NewQueryServerTestHelper(input.Context, keeper.MakeTestEncodingConfig().InterfaceRegistry)
+	types.RegisterQueryServer(helper, input.GravityKeeper)
+	return CosmosClient{
+		QueryClient: types.NewQueryClient(localConn{helper: helper, input: input}),
+		input:       input,
+	}
+}
+
+// Broadcast delivers msgs to the gravity handler, they are applied atomically like the messages of a transaction
+func (c CosmosClient) Broadcast(ctx context.Context, msgs ...sdk.Msg) error {
+	_, err := c.BroadcastTx(ctx, msgs...)
+	return err
+}
+
+// BroadcastTx is Broadcast returning a response carrying the events emitted by msgs, like a node does in
+// block mode
+func (c CosmosClient) BroadcastTx(_ context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	ctx, commit := c.input.Context.CacheContext()
+	handler := gravity.NewHandler(c.input.GravityKeeper)
+	res := &sdk.TxResponse{Height: ctx.BlockHeight()}
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		result, err := handler(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		res.Events = append(res.Events, result.Events...)
+	}
+	commit()
+	return res, nil
+}
+
+// localConn routes gRPC queries to the query server registered with helper at the current block
+type localConn struct {
+	helper *baseapp.QueryServiceTestHelper
+	input  *keeper.TestInput
+}
+
+func (c localConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	c.helper.Ctx = c.input.Context
+	return c.helper.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (c localConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streaming is not supported")
+}
@@ -0,0 +1,570 @@
+package ethsim_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/ethsim"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// The tests in this file are ports of the Solidity tests in /solidity/test, which run against the compiled
+// Gravity.sol. Each scenario is set up the same way and must revert with the same reason, including the
+// arguments of custom errors, or end in the same state as it does there, so that the simulated contract
+// is checked against the behaviour of the real one and not only against its checkpoints.
+
+// solidityGravityID is the gravity id used by the Solidity tests
+const solidityGravityID = "foo"
+
+// examplePowers is examplePowers from /solidity/test-utils/pure.ts, the power distribution on the Cosmos hub
+// as of 7/14/2020
+func examplePowers() []*big.Int {
+	powers := []int64{
+		303654379, 266717637, 261134176, 188549183, 176952764, 174805279, 137009543, 134003064, 133573567,
+		130137591, 105656262, 103508777, 96207328, 91482861, 83322418, 75161975, 74302981, 73014490, 66142538,
+		63995053, 59700083, 52828131, 51110143, 48533161, 47244670, 45956179, 45097185, 44667688, 39513724,
+		38654730, 37795736, 37795736, 37795736, 36507245, 36507245, 36077748, 35218754, 30064790, 28776299,
+		27487808, 25340323, 24910826, 24051832, 23622335, 22333844, 22333844, 22333844, 21474850, 21045353,
+		18897868, 18038874, 17179880, 16750383, 16320886, 15891389, 15891389, 15461892, 15032395, 14602898,
+		14173401, 14173401, 14173401, 13743904, 13314407, 12884910, 12884910, 12455413, 12025916, 11596419,
+		11166922, 10737425, 10307928, 9878431, 9878431, 9448934, 9448934, 9448934, 9019437, 9019437, 8589940,
+		8160443, 7730946, 7301449, 6871952, 6012958, 6012958, 5583461, 5583461, 4724467, 4294970, 4294970,
+		4294970, 4294970, 4294970, 3865473, 3435976, 3435976, 3006479, 3006479, 3006479, 2576982, 2576982,
+		2147485, 2147485, 2147485, 2147485, 2147485, 2147485, 1717988, 1717988, 1288491, 858994, 429497,
+		429497, 429497, 429497, 429497, 429497, 429497, 429497, 429497, 429497, 429497, 429497, 429497,
+	}
+	out := make([]*big.Int, len(powers))
+	for i, p := range powers {
+		out[i] = big.NewInt(p)
+	}
+	return out
+}
+
+// solidityTest is deployContracts from /solidity/test-utils/index.ts: Gravity.sol deployed with the
+// validators of examplePowers and a test ERC20 of which the sender holds 10000
+type solidityTest struct {
+	t          *testing.T
+	eth        *ethsim.Backend
+	gravity    *bind.BoundContract
+	gravityABI abi.ABI
+	gravityAdr gethcommon.Address
+	testERC20  gethcommon.Address
+	keys       []*ecdsa.PrivateKey
+	validators []gethcommon.Address
+	powers     []*big.Int
+	sender     *bind.TransactOpts
+}
+
+func newSolidityTest(t *testing.T) *solidityTest {
+	s := &solidityTest{t: t, powers: examplePowers(), gravityABI: mustABI(t, types.GravityABIJSON)}
+	s.keys, s.validators = generateKeys(t, len(s.powers))
+
+	senderKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	s.eth = ethsim.NewBackend(core.GenesisAlloc{crypto.PubkeyToAddress(senderKey.PublicKey): {Balance: big.NewInt(1e18)}})
+	s.sender, err = bind.NewKeyedTransactorWithChainID(senderKey, s.eth.Blockchain().Config().ChainID)
+	require.NoError(t, err)
+	// skip gas estimation, which would refuse to send a reverting transaction
+	s.sender.GasLimit = 1000000
+
+	s.gravityAdr, err = s.eth.DeployGravity(solidityGravityID, s.validators, s.powers)
+	require.NoError(t, err)
+	s.gravity = bind.NewBoundContract(s.gravityAdr, s.gravityABI, s.eth, s.eth, s.eth)
+	s.testERC20, err = s.eth.DeployERC20("Bitcoin MAX", "MAX", 18)
+	require.NoError(t, err)
+	require.NoError(t, s.eth.Mint(s.testERC20, s.sender.From, big.NewInt(10000)))
+	return s
+}
+
+func generateKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []gethcommon.Address) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]gethcommon.Address, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return keys, addrs
+}
+
+// transact sends a transaction to contract and mines it, returning its receipt and revert reason
+func (s *solidityTest) transact(contract *bind.BoundContract, method string, args ...interface{}) (*ethtypes.Receipt, error) {
+	tx, err := contract.Transact(s.sender, method, args...)
+	require.NoError(s.t, err)
+	s.eth.Commit()
+	receipt, err := s.eth.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(s.t, err)
+	return receipt, s.eth.TransactionError(tx.Hash())
+}
+
+func (s *solidityTest) call(method string, args ...interface{}) interface{} {
+	var out []interface{}
+	require.NoError(s.t, s.gravity.Call(nil, &out, method, args...))
+	return out[0]
+}
+
+func (s *solidityTest) balance(token, account gethcommon.Address) *big.Int {
+	balance, err := s.eth.ERC20Balance(token, account)
+	require.NoError(s.t, err)
+	return balance
+}
+
+// sendToCosmos approves and locks amount of the test ERC20 in Gravity.sol
+func (s *solidityTest) sendToCosmos(amount int64) *ethtypes.Receipt {
+	erc20 := bind.NewBoundContract(s.testERC20, mustABI(s.t, types.ERC20ABIJSON), s.eth, s.eth, s.eth)
+	_, err := s.transact(erc20, "approve", s.gravityAdr, big.NewInt(amount))
+	require.NoError(s.t, err)
+	receipt, err := s.transact(s.gravity, "sendToCosmos", s.testERC20, "myCosmosAddress", big.NewInt(amount))
+	require.NoError(s.t, err)
+	return receipt
+}
+
+// deployERC20 deploys a Cosmos originated token through Gravity.sol and returns its ERC20DeployedEvent
+func (s *solidityTest) deployERC20() map[string]interface{} {
+	receipt, err := s.transact(s.gravity, "deployERC20", "uatom", "Atom", "ATOM", uint8(6))
+	require.NoError(s.t, err)
+	return s.event(receipt, "ERC20DeployedEvent")
+}
+
+// event returns the arguments of the only event with the given name in the receipt
+func (s *solidityTest) event(receipt *ethtypes.Receipt, name string) map[string]interface{} {
+	for _, log := range receipt.Logs {
+		if log.Address != s.gravityAdr || log.Topics[0] != s.gravityABI.Events[name].ID {
+			continue
+		}
+		args := make(map[string]interface{})
+		require.NoError(s.t, s.gravity.UnpackLogIntoMap(args, name, *log))
+		return args
+	}
+	s.t.Fatalf("no %s in receipt", name)
+	return nil
+}
+
+// currentValset is the valset the contract was deployed with
+func (s *solidityTest) currentValset() types.ValsetArgs {
+	return types.ValsetArgs{
+		Validators:   s.validators,
+		Powers:       s.powers,
+		ValsetNonce:  big.NewInt(0),
+		RewardAmount: big.NewInt(0),
+		RewardToken:  gethcommon.Address{},
+	}
+}
+
+// signHash is signHash from /solidity/test-utils/pure.ts, every validator signs the hash
+func (s *solidityTest) signHash(hash []byte) []types.Signature {
+	sigs := make([]types.Signature, len(s.keys))
+	for i, key := range s.keys {
+		sig, err := types.NewEthereumSignature(hash, key)
+		require.NoError(s.t, err)
+		copy(sigs[i].R[:], sig[:32])
+		copy(sigs[i].S[:], sig[32:64])
+		sigs[i].V = sig[64] + 27
+	}
+	return sigs
+}
+
+// keccakEncoded hashes the abi encoding of args, as the tests do with defaultAbiCoder.encode
+func keccakEncoded(t *testing.T, abiJSON, method string, args ...interface{}) []byte {
+	bz, err := mustABI(t, abiJSON).Pack(method, args...)
+	require.NoError(t, err)
+	return crypto.Keccak256(bz[4:])
+}
+
+func bytes32(s string) [32]byte {
+	var b [32]byte
+	copy(b[:], s)
+	return b
+}
+
+// signatureOpts are the ways the Solidity tests tamper with the current valset and its signatures
+type signatureOpts struct {
+	malformedCurrentValset bool
+	badValidatorSig        bool
+	zeroedValidatorSig     bool
+	notEnoughPower         bool
+	barelyEnoughPower      bool
+}
+
+// apply tampers with the valset and signatures like the Solidity tests do
+func (o signatureOpts) apply(valset *types.ValsetArgs, sigs []types.Signature) {
+	if o.malformedCurrentValset {
+		// Remove one of the powers to make the length not match
+		valset.Powers = valset.Powers[:len(valset.Powers)-1]
+	}
+	if o.badValidatorSig || o.zeroedValidatorSig {
+		// Switch the first sig for the second sig to screw things up
+		sigs[1] = sigs[0]
+	}
+	if o.zeroedValidatorSig {
+		// Then zero it out to skip evaluation
+		sigs[1].V = 0
+	}
+	if o.notEnoughPower {
+		// zero out enough signatures that we dip below the threshold
+		for _, i := range []int{1, 2, 3, 5, 6, 7, 9, 11, 13} {
+			sigs[i].V = 0
+		}
+	}
+	if o.barelyEnoughPower {
+		// Stay just above the threshold
+		for _, i := range []int{1, 2, 3, 5, 6, 7, 9, 11} {
+			sigs[i].V = 0
+		}
+	}
+}
+
+// constructor.ts
+func TestSolidityConstructor(t *testing.T) {
+	keys, validators := generateKeys(t, len(examplePowers()))
+	require.Len(t, keys, len(validators))
+	eth := ethsim.NewBackend(core.GenesisAlloc{})
+
+	_, err := eth.DeployGravity(solidityGravityID, validators[:len(validators)-1], examplePowers())
+	require.True(t, errors.Is(err, ethsim.ErrMalformedCurrentValset), err)
+	require.EqualError(t, err, "execution reverted: MalformedCurrentValidatorSet()")
+
+	_, err = eth.DeployGravity(solidityGravityID, validators[:2], examplePowers()[:2])
+	require.True(t, errors.Is(err, ethsim.ErrInsufficientPower), err)
+	require.EqualError(t, err, "execution reverted: InsufficientPower(570372016, 2863311530)")
+
+	_, err = eth.DeployGravity(solidityGravityID, nil, nil)
+	require.EqualError(t, err, "execution reverted: MalformedCurrentValidatorSet()")
+}
+
+// sendToCosmos.ts
+func TestSoliditySendToCosmos(t *testing.T) {
+	s := newSolidityTest(t)
+
+	for i, nonce := range []int64{2, 3} {
+		event := s.event(s.sendToCosmos(1000), "SendToCosmosEvent")
+		require.Equal(t, "myCosmosAddress", event["_destination"])
+		require.Equal(t, big.NewInt(1000), event["_amount"])
+		require.Equal(t, big.NewInt(nonce), event["_eventNonce"])
+		require.Equal(t, big.NewInt(int64(1000*(i+1))), s.balance(s.testERC20, s.gravityAdr))
+		require.Equal(t, big.NewInt(nonce), s.call("state_lastEventNonce"))
+	}
+}
+
+// deployERC20.ts
+func TestSolidityDeployERC20(t *testing.T) {
+	s := newSolidityTest(t)
+
+	event := s.deployERC20()
+	require.Equal(t, "uatom", event["_cosmosDenom"])
+	require.Equal(t, "Atom", event["_name"])
+	require.Equal(t, "ATOM", event["_symbol"])
+	require.Equal(t, uint8(6), event["_decimals"])
+	require.Equal(t, big.NewInt(2), event["_eventNonce"])
+	token := event["_tokenContract"].(gethcommon.Address)
+
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	require.Equal(t, maxUint256, s.balance(token, s.gravityAdr))
+
+	amounts, destinations, fees := batchTxs(t, 100)
+	batchNonce, batchTimeout := big.NewInt(1), big.NewInt(10000)
+	digest := keccakEncoded(t, types.OutgoingBatchTxCheckpointABIJSON, "submitBatch", bytes32(solidityGravityID),
+		bytes32("transactionBatch"), amounts, destinations, fees, batchNonce, token, batchTimeout)
+	_, err := s.transact(s.gravity, "submitBatch", s.currentValset(), s.signHash(digest),
+		amounts, destinations, fees, batchNonce, token, batchTimeout)
+	require.NoError(t, err)
+
+	require.Equal(t, new(big.Int).Sub(maxUint256, big.NewInt(200)), s.balance(token, s.gravityAdr))
+	require.Equal(t, big.NewInt(1), s.balance(token, destinations[1]))
+}
+
+// batchTxs returns a batch of n transactions of 1 token with a fee of 1 token to different destinations
+func batchTxs(t *testing.T, n int) (amounts []*big.Int, destinations []gethcommon.Address, fees []*big.Int) {
+	_, destinations = generateKeys(t, n)
+	for i := 0; i < n; i++ {
+		amounts = append(amounts, big.NewInt(1))
+		fees = append(fees, big.NewInt(1))
+	}
+	return amounts, destinations, fees
+}
+
+// updateValset.ts
+//nolint: exhaustivestruct
+func TestSolidityUpdateValset(t *testing.T) {
+	type updateValsetOpts struct {
+		signatureOpts
+		nonMatchingCurrentValset bool
+		malformedNewValset       bool
+		nonceNotIncremented      bool
+		badReward                bool
+		notEnoughReward          bool
+		withReward               bool
+		notEnoughPowerNewSet     bool
+		zeroLengthValset         bool
+	}
+	run := func(opts updateValsetOpts) (*solidityTest, []byte, error) {
+		s := newSolidityTest(t)
+
+		newPowers := examplePowers()
+		newPowers[0].Sub(newPowers[0], big.NewInt(3))
+		newPowers[1].Add(newPowers[1], big.NewInt(3))
+		newValidators := s.validators
+		if opts.malformedNewValset {
+			// Validators and powers array don't match
+			newValidators = s.validators[:len(newPowers)-1]
+		} else if opts.zeroLengthValset {
+			newValidators = []gethcommon.Address{}
+			newPowers = []*big.Int{}
+		} else if opts.notEnoughPowerNewSet {
+			for i := range newPowers {
+				newPowers[i] = big.NewInt(5)
+			}
+		}
+
+		currentValset := s.currentValset()
+		if opts.nonMatchingCurrentValset {
+			currentValset.Powers = examplePowers()
+			currentValset.Powers[0] = big.NewInt(78)
+		}
+		newValset := types.ValsetArgs{
+			Validators:   newValidators,
+			Powers:       newPowers,
+			ValsetNonce:  big.NewInt(1),
+			RewardAmount: big.NewInt(0),
+			RewardToken:  gethcommon.Address{},
+		}
+		if opts.nonceNotIncremented {
+			newValset.ValsetNonce = big.NewInt(0)
+		}
+
+		var rewardToken gethcommon.Address
+		switch {
+		case opts.badReward:
+			// some amount of a reward, in a random token that's not in the bridge
+			newValset.RewardAmount = big.NewInt(5000000)
+			newValset.RewardToken = gethcommon.HexToAddress("0x8bcd7D3532CB626A7138962Bdb859737e5B6d4a7")
+		case opts.withReward:
+			// five atom of a Cosmos originated token, issued as an inflationary reward
+			rewardToken = s.deployERC20()["_tokenContract"].(gethcommon.Address)
+			newValset.RewardToken = rewardToken
+			newValset.RewardAmount = big.NewInt(5000000)
+		case opts.notEnoughReward:
+			// send in 1000 tokens, then have a reward of five million
+			s.sendToCosmos(1000)
+			newValset.RewardToken = s.testERC20
+			newValset.RewardAmount = big.NewInt(5000000)
+		}
+
+		checkpoint := keccakEncoded(t, types.ValsetCheckpointABIJSON, "checkpoint", bytes32(solidityGravityID),
+			bytes32("checkpoint"), newValset.ValsetNonce, newValset.Validators, newValset.Powers,
+			newValset.RewardAmount, newValset.RewardToken)
+		sigs := s.signHash(checkpoint)
+		opts.apply(&currentValset, sigs)
+
+		_, err := s.transact(s.gravity, "updateValset", newValset, currentValset, sigs)
+		if err == nil && opts.withReward {
+			// check that the relayer was paid
+			require.Equal(t, big.NewInt(5000000), s.balance(rewardToken, s.sender.From))
+		}
+		return s, checkpoint, err
+	}
+
+	for _, tc := range []struct {
+		name   string
+		opts   updateValsetOpts
+		reason string
+	}{
+		{"throws on malformed new valset", updateValsetOpts{malformedNewValset: true}, "MalformedNewValidatorSet()"},
+		{"throws on empty new valset", updateValsetOpts{zeroLengthValset: true}, "MalformedNewValidatorSet()"},
+		{"throws on malformed current valset", updateValsetOpts{signatureOpts: signatureOpts{malformedCurrentValset: true}}, "MalformedCurrentValidatorSet()"},
+		{"throws on non matching checkpoint for current valset", updateValsetOpts{nonMatchingCurrentValset: true}, "IncorrectCheckpoint()"},
+		{"throws on new valset nonce not incremented", updateValsetOpts{nonceNotIncremented: true}, "InvalidValsetNonce(0, 0)"},
+		{"throws on bad validator sig", updateValsetOpts{signatureOpts: signatureOpts{badValidatorSig: true}}, "InvalidSignature()"},
+		{"allows zeroed sig", updateValsetOpts{signatureOpts: signatureOpts{zeroedValidatorSig: true}}, ""},
+		{"throws on not enough signatures", updateValsetOpts{signatureOpts: signatureOpts{notEnoughPower: true}}, "InsufficientPower(2807621889, 2863311530)"},
+		{"throws on not enough power in new set", updateValsetOpts{notEnoughPowerNewSet: true}, "InsufficientPower(625, 2863311530)"},
+		{"throws on bad reward", updateValsetOpts{badReward: true}, "Address: call to non-contract"},
+		{"throws on not enough reward", updateValsetOpts{notEnoughReward: true}, "ERC20: transfer amount exceeds balance"},
+		{"pays reward correctly", updateValsetOpts{withReward: true}, ""},
+		{"happy path", updateValsetOpts{}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, checkpoint, err := run(tc.opts)
+			if tc.reason != "" {
+				require.EqualError(t, err, "execution reverted: "+tc.reason)
+				return
+			}
+			require.NoError(t, err)
+			lastCheckpoint := s.call("state_lastValsetCheckpoint").([32]byte)
+			require.Equal(t, checkpoint, lastCheckpoint[:])
+		})
+	}
+}
+
+// submitBatch.ts
+//nolint: exhaustivestruct
+func TestSoliditySubmitBatch(t *testing.T) {
+	type submitBatchOpts struct {
+		signatureOpts
+		nonMatchingCurrentValset bool
+		batchNonceNotHigher      bool
+		malformedTxBatch         bool
+		batchTimeout             bool
+	}
+	run := func(opts submitBatchOpts) (*solidityTest, []gethcommon.Address, error) {
+		s := newSolidityTest(t)
+		s.sendToCosmos(1000)
+
+		amounts, destinations, fees := batchTxs(t, 100)
+		if opts.malformedTxBatch {
+			// Make the fees array the wrong size
+			fees = fees[:len(fees)-1]
+		}
+		blockNumber := s.eth.Blockchain().CurrentBlock().Number()
+		batchTimeout := new(big.Int).Add(blockNumber, big.NewInt(1000))
+		if opts.batchTimeout {
+			batchTimeout = new(big.Int).Sub(blockNumber, big.NewInt(1))
+		}
+		batchNonce := big.NewInt(1)
+		if opts.batchNonceNotHigher {
+			batchNonce = big.NewInt(0)
+		}
+
+		digest := keccakEncoded(t, types.OutgoingBatchTxCheckpointABIJSON, "submitBatch", bytes32(solidityGravityID),
+			bytes32("transactionBatch"), amounts, destinations, fees, batchNonce, s.testERC20, batchTimeout)
+		sigs := s.signHash(digest)
+		valset := s.currentValset()
+		if opts.nonMatchingCurrentValset {
+			// Wrong nonce
+			valset.ValsetNonce = big.NewInt(420)
+		}
+		opts.apply(&valset, sigs)
+
+		_, err := s.transact(s.gravity, "submitBatch", valset, sigs, amounts, destinations, fees, batchNonce, s.testERC20, batchTimeout)
+		return s, destinations, err
+	}
+
+	for _, tc := range []struct {
+		name   string
+		opts   submitBatchOpts
+		reason string
+	}{
+		{"throws on malformed current valset", submitBatchOpts{signatureOpts: signatureOpts{malformedCurrentValset: true}}, "MalformedCurrentValidatorSet()"},
+		{"throws on malformed txbatch", submitBatchOpts{malformedTxBatch: true}, "MalformedBatch()"},
+		{"throws on batch nonce not incremented", submitBatchOpts{batchNonceNotHigher: true}, "InvalidBatchNonce(0, 0)"},
+		{"throws on timeout batch", submitBatchOpts{batchTimeout: true}, "BatchTimedOut()"},
+		{"throws on non matching checkpoint for current valset", submitBatchOpts{nonMatchingCurrentValset: true}, "IncorrectCheckpoint()"},
+		{"throws on bad validator sig", submitBatchOpts{signatureOpts: signatureOpts{badValidatorSig: true}}, "InvalidSignature()"},
+		{"allows zeroed sig", submitBatchOpts{signatureOpts: signatureOpts{zeroedValidatorSig: true}}, ""},
+		{"throws on not enough signatures", submitBatchOpts{signatureOpts: signatureOpts{notEnoughPower: true}}, "InsufficientPower(2807621889, 2863311530)"},
+		{"does not throw on barely enough signatures", submitBatchOpts{signatureOpts: signatureOpts{barelyEnoughPower: true}}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, destinations, err := run(tc.opts)
+			if tc.reason != "" {
+				require.EqualError(t, err, "execution reverted: "+tc.reason)
+				require.Zero(t, s.call("lastBatchNonce", s.testERC20).(*big.Int).Sign())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(1), s.call("lastBatchNonce", s.testERC20))
+			// the amounts went to the destinations and the fees to the relayer
+			require.Equal(t, big.NewInt(800), s.balance(s.testERC20, s.gravityAdr))
+			require.Equal(t, big.NewInt(1), s.balance(s.testERC20, destinations[0]))
+			require.Equal(t, big.NewInt(10000-1000+100), s.balance(s.testERC20, s.sender.From))
+		})
+	}
+}
+
+// invalidationID is the token address padded to 32 bytes, as the logic call tests use
+func invalidationID(token gethcommon.Address) [32]byte {
+	return gethcommon.BytesToHash(token.Bytes())
+}
+
+// arbitrary-logic.ts, the logic contracts of the Solidity test are not simulated so the call transfers the
+// test ERC20 to a receiver instead, every check made before the logic contract is called is the same
+//nolint: exhaustivestruct
+func TestSoliditySubmitLogicCall(t *testing.T) {
+	type logicCallOpts struct {
+		signatureOpts
+		nonMatchingCurrentValset   bool
+		invalidationNonceNotHigher bool
+		timedOut                   bool
+	}
+	receiver := gethcommon.HexToAddress("0x1111111111111111111111111111111111111111")
+	run := func(opts logicCallOpts) (*solidityTest, error) {
+		s := newSolidityTest(t)
+		s.sendToCosmos(1000)
+
+		payload, err := mustABI(t, types.ERC20ABIJSON).Pack("transfer", receiver, big.NewInt(40))
+		require.NoError(t, err)
+		args := types.LogicCallArgs{
+			TransferAmounts:        []*big.Int{big.NewInt(50)},
+			TransferTokenContracts: []gethcommon.Address{s.testERC20},
+			FeeAmounts:             []*big.Int{big.NewInt(10)},
+			FeeTokenContracts:      []gethcommon.Address{s.testERC20},
+			LogicContractAddress:   s.testERC20,
+			Payload:                payload,
+			TimeOut:                big.NewInt(4766922941000),
+			InvalidationId:         invalidationID(s.testERC20),
+			InvalidationNonce:      big.NewInt(1),
+		}
+		if opts.invalidationNonceNotHigher {
+			args.InvalidationNonce = big.NewInt(0)
+		}
+		if opts.timedOut {
+			args.TimeOut = big.NewInt(0)
+		}
+
+		digest := keccakEncoded(t, types.OutgoingLogicCallABIJSON, "checkpoint", bytes32(solidityGravityID),
+			bytes32("logicCall"), args.TransferAmounts, args.TransferTokenContracts, args.FeeAmounts,
+			args.FeeTokenContracts, args.LogicContractAddress, args.Payload, args.TimeOut, args.InvalidationId,
+			args.InvalidationNonce)
+		sigs := s.signHash(digest)
+		valset := s.currentValset()
+		if opts.nonMatchingCurrentValset {
+			// Wrong nonce
+			valset.ValsetNonce = big.NewInt(420)
+		}
+		opts.apply(&valset, sigs)
+
+		_, err = s.transact(s.gravity, "submitLogicCall", valset, sigs, args)
+		return s, err
+	}
+
+	for _, tc := range []struct {
+		name   string
+		opts   logicCallOpts
+		reason string
+	}{
+		{"throws on malformed current valset", logicCallOpts{signatureOpts: signatureOpts{malformedCurrentValset: true}}, "MalformedCurrentValidatorSet()"},
+		{"throws on invalidation nonce not incremented", logicCallOpts{invalidationNonceNotHigher: true}, "InvalidLogicCallNonce(0, 0)"},
+		{"throws on non matching checkpoint for current valset", logicCallOpts{nonMatchingCurrentValset: true}, "IncorrectCheckpoint()"},
+		{"throws on bad validator sig", logicCallOpts{signatureOpts: signatureOpts{badValidatorSig: true}}, "InvalidSignature()"},
+		{"allows zeroed sig", logicCallOpts{signatureOpts: signatureOpts{zeroedValidatorSig: true}}, ""},
+		{"throws on not enough signatures", logicCallOpts{signatureOpts: signatureOpts{notEnoughPower: true}}, "InsufficientPower(2807621889, 2863311530)"},
+		{"does not throw on barely enough signatures", logicCallOpts{signatureOpts: signatureOpts{barelyEnoughPower: true}}, ""},
+		{"throws on timeout", logicCallOpts{timedOut: true}, "LogicCallTimedOut()"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := run(tc.opts)
+			if tc.reason != "" {
+				require.EqualError(t, err, "execution reverted: "+tc.reason)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(1), s.call("lastLogicCallNonce", invalidationID(s.testERC20)))
+			// the transfer went to the logic contract, which sent part of it on, and the fee to the relayer
+			require.Equal(t, big.NewInt(1000-50-40-10), s.balance(s.testERC20, s.gravityAdr))
+			require.Equal(t, big.NewInt(50), s.balance(s.testERC20, s.testERC20))
+			require.Equal(t, big.NewInt(40), s.balance(s.testERC20, receiver))
+		})
+	}
+}
@@ -200,6 +200,107 @@ func TestMsgSendToCosmosClaim(t *testing.T) {
 	assert.Equal(t, sdk.Coins{sdk.NewCoin("gravity0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e", amountB)}, balance)
 }
 
+// Ensure that the ERC20 metadata reported with the first deposit of a token is used as the voucher's bank metadata
+func TestMsgSendToCosmosClaimMetadata(t *testing.T) {
+	var (
+		myCosmosAddr, _ = sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+		anyETHAddr      = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr    = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		denom           = "gravity0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+		amount, _       = sdk.NewIntFromString("50000000000000000000")
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	h := NewHandler(input.GravityKeeper)
+
+	// reported is the metadata an orchestrator reports, report picks it by the index of the orchestrator
+	type reported struct {
+		name, symbol string
+		decimals     uint32
+	}
+	claim := func(nonce uint64, orch sdk.AccAddress, r reported) types.MsgSendToCosmosClaim {
+		return types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  tokenETHAddr,
+			Amount:         amount,
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   orch.String(),
+			EthTxHash:      fmt.Sprintf("0x%064x", nonce),
+			TokenName:      r.name,
+			TokenSymbol:    r.symbol,
+			TokenDecimals:  r.decimals,
+		}
+	}
+	deposit := func(nonce uint64, report func(i int) reported) {
+		for i, v := range keeper.OrchAddrs {
+			ethClaim := claim(nonce, v, report(i))
+			_, err := h(ctx, &ethClaim)
+			require.NoError(t, err)
+			EndBlocker(ctx, input.GravityKeeper)
+		}
+	}
+	yearn := reported{"Yearn", "YFI", 18}
+
+	// the metadata is part of the claim hash
+	withMetadata := types.MsgSendToCosmosClaim{EventNonce: 1, Amount: amount, TokenName: "Yearn", TokenSymbol: "YFI", TokenDecimals: 18}
+	withoutMetadata := types.MsgSendToCosmosClaim{EventNonce: 1, Amount: amount}
+	hashWith, err := withMetadata.ClaimHash()
+	require.NoError(t, err)
+	hashWithout, err := withoutMetadata.ClaimHash()
+	require.NoError(t, err)
+	require.NotEqual(t, hashWith, hashWithout)
+
+	// before the claim data height the metadata is dropped, so orchestrators which do not report it agree
+	params := input.GravityKeeper.GetParams(ctx)
+	params.ClaimDataHeight = 2
+	input.GravityKeeper.SetParams(ctx, params)
+	deposit(1, func(i int) reported {
+		if i%2 == 0 {
+			return yearn
+		}
+		return reported{}
+	})
+	require.Equal(t, uint64(1), input.GravityKeeper.GetLastObservedEventNonce(ctx))
+	_, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.False(t, found)
+
+	// from the height on claims must carry the tx hash
+	bare := claim(2, keeper.OrchAddrs[0], yearn)
+	bare.EthTxHash = ""
+	_, err = h(ctx, &bare)
+	require.True(t, types.ErrClaimDataRequired.Is(err), err)
+
+	// and the metadata is voted on, a single orchestrator can not decide it
+	deposit(2, func(i int) reported {
+		if i == 0 {
+			return reported{"Fake", "FAKE", 6}
+		}
+		return yearn
+	})
+	require.Equal(t, uint64(2), input.GravityKeeper.GetLastObservedEventNonce(ctx))
+	metadata, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	assert.Equal(t, "Yearn", metadata.Name)
+	assert.Equal(t, "YFI", metadata.Symbol)
+	assert.Equal(t, denom, metadata.Base)
+	// wallets display the amount with the token's decimals
+	assert.Equal(t, "YFI", metadata.Display)
+	require.Len(t, metadata.DenomUnits, 2)
+	assert.Equal(t, uint32(18), metadata.DenomUnits[1].Exponent)
+
+	// later deposits can not change the metadata
+	deposit(3, func(int) reported { return reported{"Fake", "FAKE", 6} })
+	metadata, found = input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	assert.Equal(t, "Yearn", metadata.Name)
+
+	balance := input.BankKeeper.GetBalance(ctx, myCosmosAddr, denom)
+	assert.Equal(t, amount.MulRaw(3), balance.Amount)
+}
+
 //nolint: exhaustivestruct
 func TestEthereumBlacklist(t *testing.T) {
 	var (
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// this file contains code related to airdrops whose recipients are committed to by a Merkle root and claim
+// their share with MsgClaimAirdrop, the funds of these airdrops are held by the gravity module until claimed
+
+// withdrawFromCommunityPool removes coins from the community pool accounting, the caller is responsible
+// for moving the coins out of the distribution module account
+func (k Keeper) withdrawFromCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
+	feePool := k.DistKeeper.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(coins...))
+	if negative {
+		return sdkerrors.Wrapf(types.ErrInvalid, "insufficient tokens in community pool for %s", coins)
+	}
+	feePool.CommunityPool = newPool
+	k.DistKeeper.SetFeePool(ctx, feePool)
+	return nil
+}
+
+// createMerkleAirdrop moves the total of a Merkle airdrop from the community pool to the gravity module
+// and records it so that recipients can claim until the claim window closes
+func (k Keeper) createMerkleAirdrop(ctx sdk.Context, merkleRoot string, total sdk.Coins, claimWindow uint64) (types.MerkleAirdrop, error) {
+	if err := k.withdrawFromCommunityPool(ctx, total); err != nil {
+		return types.MerkleAirdrop{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, disttypes.ModuleName, types.ModuleName, total); err != nil {
+		return types.MerkleAirdrop{}, sdkerrors.Wrap(err, "unable to escrow airdrop")
+	}
+	airdrop := types.MerkleAirdrop{
+		Id:         k.nextMerkleAirdropID(ctx),
+		MerkleRoot: merkleRoot,
+		Total:      total,
+		Claimed:    sdk.NewCoins(),
+		EndHeight:  uint64(ctx.BlockHeight()) + claimWindow,
+	}
+	k.setMerkleAirdrop(ctx, airdrop)
+	return airdrop, nil
+}
+
+// nextMerkleAirdropID returns the id of a new Merkle airdrop, ids start at 1
+func (k Keeper) nextMerkleAirdropID(ctx sdk.Context) uint64 {
+	if !ctx.KVStore(k.storeKey).Has(types.LastMerkleAirdropIDKey) {
+		k.setID(ctx, 0, types.LastMerkleAirdropIDKey)
+	}
+	return k.autoIncrementID(ctx, types.LastMerkleAirdropIDKey)
+}
+
+func (k Keeper) setMerkleAirdrop(ctx sdk.Context, airdrop types.MerkleAirdrop) {
+	ctx.KVStore(k.storeKey).Set(types.GetMerkleAirdropKey(airdrop.Id), k.cdc.MustMarshal(&airdrop))
+}
+
+// GetMerkleAirdrop returns the Merkle airdrop with the given id, if it is still open for claiming
+func (k Keeper) GetMerkleAirdrop(ctx sdk.Context, id uint64) (types.MerkleAirdrop, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMerkleAirdropKey(id))
+	if bz == nil {
+		return types.MerkleAirdrop{}, false
+	}
+	var airdrop types.MerkleAirdrop
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return airdrop, true
+}
+
+// IterateMerkleAirdrops iterates over every Merkle airdrop still open for claiming, in order of id
+func (k Keeper) IterateMerkleAirdrops(ctx sdk.Context, cb func(types.MerkleAirdrop) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MerkleAirdropKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var airdrop types.MerkleAirdrop
+		k.cdc.MustUnmarshal(iter.Value(), &airdrop)
+		// cb returns true to stop early
+		if cb(airdrop) {
+			break
+		}
+	}
+}
+
+// HasClaimedAirdrop returns true if recipient has already claimed from the given Merkle airdrop
+func (k Keeper) HasClaimedAirdrop(ctx sdk.Context, id uint64, recipient sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAirdropClaimKey(id, recipient))
+}
+
+// setAirdropClaim records that recipient has claimed from the given Merkle airdrop
+func (k Keeper) setAirdropClaim(ctx sdk.Context, id uint64, recipient sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.GetAirdropClaimKey(id, recipient), []byte{0x1})
+}
+
+// IterateAirdropClaims iterates over every recorded claim of the Merkle airdrops still open for claiming
+func (k Keeper) IterateAirdropClaims(ctx sdk.Context, cb func(id uint64, claimer sdk.AccAddress) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AirdropClaimKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// the key is the airdrop id followed by the length prefixed claimer
+		claimer, _ := types.SplitLengthPrefixed(iter.Key()[8:])
+		if cb(types.UInt64FromBytes(iter.Key()[:8]), claimer) {
+			break
+		}
+	}
+}
+
+// ClaimAirdrop pays claimer their share of a Merkle airdrop once the proof of their leaf has been verified
+func (k Keeper) ClaimAirdrop(ctx sdk.Context, claimer sdk.AccAddress, id uint64, amount sdk.Coins, proof [][]byte) error {
+	airdrop, found := k.GetMerkleAirdrop(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalid, "airdrop %d does not exist or has expired", id)
+	}
+	if k.HasClaimedAirdrop(ctx, id, claimer) {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "%s has already claimed airdrop %d", claimer, id)
+	}
+	root, err := decodeMerkleRoot(airdrop.MerkleRoot)
+	if err != nil {
+		return err
+	}
+	if !types.VerifyAirdropProof(root, types.AirdropLeafHash(claimer.String(), amount), proof) {
+		return sdkerrors.Wrap(types.ErrInvalid, "invalid airdrop proof")
+	}
+	claimed := airdrop.Claimed.Add(amount...)
+	if !airdrop.Total.IsAllGTE(claimed) {
+		// the proposal's total did not cover every leaf of the tree
+		return sdkerrors.Wrapf(types.ErrInvalid, "airdrop %d does not have %s left to claim", id, amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, amount); err != nil {
+		return sdkerrors.Wrap(err, "unable to pay airdrop claim")
+	}
+	airdrop.Claimed = claimed
+	k.setMerkleAirdrop(ctx, airdrop)
+	k.setAirdropClaim(ctx, id, claimer)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAirdropClaimed{
+		AirdropId: fmt.Sprint(id),
+		Claimer:   claimer.String(),
+		Amount:    amount.String(),
+	})
+}
+
+// ExpireMerkleAirdrops returns the unclaimed funds of every Merkle airdrop whose claim window has closed
+// to the community pool and deletes the airdrop along with its claim records. An airdrop whose funds cannot
+// be returned is kept and retried in the next block, this runs in the EndBlocker and must not panic
+func (k Keeper) ExpireMerkleAirdrops(ctx sdk.Context) {
+	var expired []types.MerkleAirdrop
+	k.IterateMerkleAirdrops(ctx, func(airdrop types.MerkleAirdrop) bool {
+		if airdrop.EndHeight <= uint64(ctx.BlockHeight()) {
+			expired = append(expired, airdrop)
+		}
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, airdrop := range expired {
+		remaining := airdrop.Remaining()
+		if !remaining.IsZero() {
+			if err := k.SendToCommunityPool(ctx, remaining); err != nil {
+				ctx.Logger().Error("Unable to return unclaimed funds of expired airdrop, retrying next block",
+					"id", airdrop.Id, "remaining", remaining.String(), "cause", err.Error())
+				continue
+			}
+		}
+		claims := prefix.NewStore(store, types.GetAirdropClaimPrefix(airdrop.Id))
+		iter := claims.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			claims.Delete(key)
+		}
+		store.Delete(types.GetMerkleAirdropKey(airdrop.Id))
+
+		ctx.Logger().Info("Merkle airdrop expired", "id", airdrop.Id, "returned", remaining.String())
+		ctx.EventManager().EmitTypedEvent(&types.EventAirdropExpired{
+			AirdropId: fmt.Sprint(airdrop.Id),
+			Returned:  remaining.String(),
+		})
+	}
+}
+
+func decodeMerkleRoot(merkleRoot string) ([]byte, error) {
+	root, err := types.DecodeAirdropProof([]string{merkleRoot})
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "invalid merkle root")
+	}
+	return root[0], nil
+}
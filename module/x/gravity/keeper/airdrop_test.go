@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+var airdropTestAddrs = []string{
+	"gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+	"gravity1n38caqg63jf9hefycw3yp95fpkpk669nvekqy2",
+	"gravity1qz4zm5s0vwfuu46lg3q0vmnwsukd8e9yfmcgjj",
+}
+
+// fundCommunityPool mints coins into the community pool for airdrop tests
+func fundCommunityPool(t *testing.T, input TestInput, ctx sdk.Context, coins sdk.Coins) {
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.GravityKeeper.SendToCommunityPool(ctx, coins))
+}
+
+// Tests that listed recipients receive every denom and that an underfunded airdrop changes nothing
+func TestAirdropV2ProposalRecipients(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	// amounts which do not fit in a uint64
+	large := sdk.NewInt(1000000000000).Mul(sdk.NewInt(1000000000000))
+	fundCommunityPool(t, input, ctx, sdk.NewCoins(sdk.NewCoin("grav", large), sdk.NewInt64Coin("stake", 1000)))
+
+	proposal := types.AirdropV2Proposal{
+		Title:       "test title",
+		Description: "test description",
+		Recipients: []types.AirdropRecipient{
+			{Address: airdropTestAddrs[0], Amount: sdk.NewCoins(sdk.NewCoin("grav", large.QuoRaw(2)), sdk.NewInt64Coin("stake", 100))},
+			{Address: airdropTestAddrs[1], Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+		},
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	poolBefore := gk.DistKeeper.GetFeePool(ctx).CommunityPool
+	require.NoError(t, gk.HandleAirdropV2Proposal(ctx, &proposal))
+
+	for _, r := range proposal.Recipients {
+		addr, err := sdk.AccAddressFromBech32(r.Address)
+		require.NoError(t, err)
+		for _, coin := range r.Amount {
+			require.Equal(t, coin, input.BankKeeper.GetBalance(ctx, addr, coin.Denom))
+		}
+	}
+	poolAfter := gk.DistKeeper.GetFeePool(ctx).CommunityPool
+	require.Equal(t, large.QuoRaw(2), poolBefore.AmountOf("grav").Sub(poolAfter.AmountOf("grav")).TruncateInt())
+	require.Equal(t, sdk.NewInt(300), poolBefore.AmountOf("stake").Sub(poolAfter.AmountOf("stake")).TruncateInt())
+
+	tooBig := proposal
+	tooBig.Recipients = []types.AirdropRecipient{{Address: airdropTestAddrs[2], Amount: sdk.NewCoins(sdk.NewCoin("grav", large))}}
+	require.Error(t, gk.HandleAirdropV2Proposal(ctx, &tooBig))
+}
+
+// Tests claiming from a Merkle airdrop and that unclaimed funds return to the community pool once it expires
+func TestMerkleAirdrop(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	fundCommunityPool(t, input, ctx, sdk.NewCoins(sdk.NewInt64Coin("grav", 10000), sdk.NewInt64Coin("stake", 1000)))
+
+	recipients := []types.AirdropRecipient{
+		{Address: airdropTestAddrs[0], Amount: sdk.NewCoins(sdk.NewInt64Coin("grav", 1000), sdk.NewInt64Coin("stake", 100))},
+		{Address: airdropTestAddrs[1], Amount: sdk.NewCoins(sdk.NewInt64Coin("grav", 2000))},
+		{Address: airdropTestAddrs[2], Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 300))},
+	}
+	root, proofs := types.AirdropMerkleTree(recipients)
+	proposal := types.AirdropV2Proposal{
+		Title:       "test title",
+		Description: "test description",
+		MerkleRoot:  types.AirdropMerkleRootHex(root),
+		Total:       sdk.NewCoins(sdk.NewInt64Coin("grav", 3000), sdk.NewInt64Coin("stake", 400)),
+		ClaimWindow: 100,
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, gk.HandleAirdropV2Proposal(ctx, &proposal))
+
+	airdrop, found := gk.GetMerkleAirdrop(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(ctx.BlockHeight())+100, airdrop.EndHeight)
+	input.AssertInvariants()
+
+	claimer, err := sdk.AccAddressFromBech32(airdropTestAddrs[0])
+	require.NoError(t, err)
+	// claiming a different amount than the leaf fails
+	require.Error(t, gk.ClaimAirdrop(ctx, claimer, 1, recipients[1].Amount, proofs[0]))
+	// another recipient's proof fails
+	require.Error(t, gk.ClaimAirdrop(ctx, claimer, 1, recipients[0].Amount, proofs[1]))
+	require.NoError(t, gk.ClaimAirdrop(ctx, claimer, 1, recipients[0].Amount, proofs[0]))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, claimer, "grav").Amount)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, claimer, "stake").Amount)
+	require.True(t, gk.HasClaimedAirdrop(ctx, 1, claimer))
+	require.ErrorIs(t, gk.ClaimAirdrop(ctx, claimer, 1, recipients[0].Amount, proofs[0]), types.ErrDuplicate)
+	input.AssertInvariants()
+
+	// once the window closes the remaining funds go back to the community pool
+	poolBefore := gk.DistKeeper.GetFeePool(ctx).CommunityPool
+	ctx = ctx.WithBlockHeight(int64(airdrop.EndHeight))
+	gk.ExpireMerkleAirdrops(ctx)
+	_, found = gk.GetMerkleAirdrop(ctx, 1)
+	require.False(t, found)
+	require.False(t, gk.HasClaimedAirdrop(ctx, 1, claimer))
+	poolAfter := gk.DistKeeper.GetFeePool(ctx).CommunityPool
+	require.Equal(t, sdk.NewInt(2000), poolAfter.AmountOf("grav").Sub(poolBefore.AmountOf("grav")).TruncateInt())
+	require.Equal(t, sdk.NewInt(300), poolAfter.AmountOf("stake").Sub(poolBefore.AmountOf("stake")).TruncateInt())
+
+	second, err := sdk.AccAddressFromBech32(airdropTestAddrs[1])
+	require.NoError(t, err)
+	require.Error(t, gk.ClaimAirdrop(ctx, second, 1, recipients[1].Amount, proofs[1]))
+}
+
+// Tests that an expired Merkle airdrop whose funds cannot be returned is kept and retried instead of halting the chain
+func TestMerkleAirdropExpiryRetry(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	fundCommunityPool(t, input, ctx, sdk.NewCoins(sdk.NewInt64Coin("grav", 10000)))
+	recipients := []types.AirdropRecipient{
+		{Address: airdropTestAddrs[0], Amount: sdk.NewCoins(sdk.NewInt64Coin("grav", 1000))},
+	}
+	root, _ := types.AirdropMerkleTree(recipients)
+	proposal := types.AirdropV2Proposal{
+		Title:       "test title",
+		Description: "test description",
+		MerkleRoot:  types.AirdropMerkleRootHex(root),
+		Total:       sdk.NewCoins(sdk.NewInt64Coin("grav", 1000)),
+		ClaimWindow: 100,
+	}
+	require.NoError(t, gk.HandleAirdropV2Proposal(ctx, &proposal))
+	airdrop, found := gk.GetMerkleAirdrop(ctx, 1)
+	require.True(t, found)
+
+	// take some of the airdrop's funds out of the module so that they cannot all be returned
+	holder, err := sdk.AccAddressFromBech32(airdropTestAddrs[1])
+	require.NoError(t, err)
+	missing := sdk.NewCoins(sdk.NewInt64Coin("grav", 1))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, missing))
+
+	ctx = ctx.WithBlockHeight(int64(airdrop.EndHeight))
+	require.NotPanics(t, func() { gk.ExpireMerkleAirdrops(ctx) })
+	_, found = gk.GetMerkleAirdrop(ctx, 1)
+	require.True(t, found)
+
+	// once the funds are back the airdrop expires in a later block
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, missing))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gk.ExpireMerkleAirdrops(ctx)
+	_, found = gk.GetMerkleAirdrop(ctx, 1)
+	require.False(t, found)
+}
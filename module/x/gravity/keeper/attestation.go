@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -315,11 +316,15 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
-	height := types.LastObservedEthereumBlockHeight{
+	k.setLastObservedEthereumBlockHeight(ctx, types.LastObservedEthereumBlockHeight{
 		EthereumBlockHeight: ethereumHeight,
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
-	}
+	})
+}
+
+// setLastObservedEthereumBlockHeight sets both the Ethereum and Cosmos block heights in the store
+func (k Keeper) setLastObservedEthereumBlockHeight(ctx sdk.Context, height types.LastObservedEthereumBlockHeight) {
+	store := ctx.KVStore(k.storeKey)
 	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshal(&height))
 }
 
@@ -388,3 +393,16 @@ func (k Keeper) SetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetLastEventNonceByValidatorKey(validator), types.UInt64Bytes(nonce))
 }
+
+// IterateLastEventNonceByValidator iterates over the validators which have a stored last event nonce
+func (k Keeper) IterateLastEventNonceByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LastEventNonceByValidatorKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		validator, _ := types.SplitLengthPrefixed(iter.Key())
+		if cb(validator, types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
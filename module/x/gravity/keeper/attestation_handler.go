@@ -57,6 +57,83 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 // In the event of a native receiver, bank module handles the transfer, otherwise an IBC transfer is initiated
 // Note: Previously SendToCosmos was referred to as a bridge "Deposit", as tokens are deposited into the gravity contract
 func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgSendToCosmosClaim) error {
+	tokenAddress, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		// creditSendToCosmos logs and rejects the invalid token contract
+		return a.creditSendToCosmos(ctx, claim)
+	}
+
+	_, denom := a.keeper.ERC20ToDenomLookup(ctx, *tokenAddress)
+	coin := sdk.NewCoin(denom, claim.Amount)
+	if err := ctx.EventManager().EmitTypedEvent(
+		&types.EventDepositObserved{
+			Version:        types.TransferEventVersion,
+			EventNonce:     claim.EventNonce,
+			EthBlockHeight: claim.BlockHeight,
+			EthereumSender: claim.EthereumSender,
+			CosmosReceiver: claim.CosmosReceiver,
+			TokenContract:  tokenAddress.GetAddress().Hex(),
+			Amount:         coin,
+		},
+	); err != nil {
+		return err
+	}
+
+	// Deposits of a paused token are held until governance lifts the pause
+	if a.keeper.GetParams(ctx).DepositsPaused(*tokenAddress) {
+		a.keeper.setPausedDeposit(ctx, claim)
+		a.keeper.setDepositReceipt(ctx, claim, coin, types.DepositReceiptQueued)
+		if err := a.emitDepositQueued(ctx, claim, *tokenAddress, coin, types.DepositQueuedReasonPaused); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(
+			&types.EventSendToCosmosPaused{
+				Amount: claim.Amount.String(),
+				Nonce:  strconv.Itoa(int(claim.GetEventNonce())),
+				Token:  tokenAddress.GetAddress().Hex(),
+			},
+		)
+	}
+
+	// Deposits over the token's inflow limit are queued until capacity frees up, deposits are never rejected
+	// for being over the limit since the tokens are already locked on Ethereum
+	if !a.keeper.inflowAllowed(ctx, *tokenAddress, claim.Amount) {
+		a.keeper.setRateLimitedDeposit(ctx, claim)
+		a.keeper.setDepositReceipt(ctx, claim, coin, types.DepositReceiptQueued)
+		if err := a.emitDepositQueued(ctx, claim, *tokenAddress, coin, types.DepositQueuedReasonRateLimited); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(
+			&types.EventSendToCosmosRateLimited{
+				Amount: claim.Amount.String(),
+				Nonce:  strconv.Itoa(int(claim.GetEventNonce())),
+				Token:  tokenAddress.GetAddress().Hex(),
+			},
+		)
+	}
+
+	return a.creditSendToCosmos(ctx, claim)
+}
+
+// emitDepositQueued emits an EventDepositQueued for a deposit held back from its receiver for the given reason
+func (a AttestationHandler) emitDepositQueued(
+	ctx sdk.Context, claim types.MsgSendToCosmosClaim, tokenAddress types.EthAddress, coin sdk.Coin, reason string,
+) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventDepositQueued{
+			Version:       types.TransferEventVersion,
+			EventNonce:    claim.EventNonce,
+			TokenContract: tokenAddress.GetAddress().Hex(),
+			Amount:        coin,
+			Reason:        reason,
+		},
+	)
+}
+
+// creditSendToCosmos mints or unlocks the deposited tokens and sends them to the receiver, or the community
+// pool if the receiver is invalid, this is run either when the deposit is observed or when a rate limited
+// deposit is released from the queue
+func (a AttestationHandler) creditSendToCosmos(ctx sdk.Context, claim types.MsgSendToCosmosClaim) error {
 	invalidAddress := false
 	// Validate the receiver as a valid bech32 address
 	receiverAddress, addressErr := types.IBCAddressFromBech32(claim.CosmosReceiver)
@@ -96,7 +173,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
-		return sdkerrors.Wrap(errTokenAddress, "invalid ethereum sender on claim")
+		return sdkerrors.Wrap(errEthereumSender, "invalid ethereum sender on claim")
 	}
 
 	// Block blacklisted asset transfers
@@ -112,10 +189,25 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 		invalidAddress = true
 	}
 
+	// Deposits from a deprecated ERC20 are only credited to the receiver during its migration window. The tokens
+	// are locked in Gravity.sol either way, so later deposits are unlocked to the community pool instead
+	if migration, deprecated := a.keeper.GetERC20Migration(ctx, *tokenAddress); deprecated && uint64(ctx.BlockHeight()) > migration.EndHeight {
+		hash, _ := claim.ClaimHash()
+		a.keeper.logger(ctx).Error("Invalid SendToCosmos: token contract deprecated",
+			"token", tokenAddress.GetAddress().Hex(),
+			"end height", fmt.Sprint(migration.EndHeight),
+			"claim type", claim.GetType(),
+			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"nonce", fmt.Sprint(claim.GetEventNonce()),
+		)
+		invalidAddress = true
+	}
+
 	// Check if coin is Cosmos-originated asset and get denom
 	isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *tokenAddress)
 	coin := sdk.NewCoin(denom, claim.Amount)
 	coins := sdk.Coins{coin}
+	a.keeper.recordRateLimitFlow(ctx, types.RateLimitInflowKey, *tokenAddress, claim.Amount)
 
 	moduleAddr := a.keeper.accountKeeper.GetModuleAddress(types.ModuleName)
 	if !isCosmosOriginated { // We need to mint eth-originated coins (aka vouchers)
@@ -123,8 +215,10 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			// TODO: Evaluate closely, if we can't mint an ethereum voucher, what should we do?
 			return err
 		}
+		a.setEthereumOriginatedMetadata(ctx, claim, *tokenAddress)
 	}
 
+	receiptStatus := types.DepositReceiptCredited
 	if !invalidAddress { // address appears valid, attempt to send minted/locked coins to receiver
 		preSendBalance := a.keeper.bankKeeper.GetBalance(ctx, moduleAddr, denom)
 		// Failure to send will result in funds transfer to community pool
@@ -139,6 +233,8 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 
 		if err != nil { // trigger send to community pool
 			invalidAddress = true
+		} else if ibcForwardQueued {
+			receiptStatus = types.DepositReceiptIbcAutoForwardQueued
 		}
 	}
 
@@ -157,6 +253,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			)
 			return sdkerrors.Wrap(err, "failed to send to Community pool")
 		}
+		receiptStatus = types.DepositReceiptCommunityPool
 
 		if err := ctx.EventManager().EmitTypedEvent(
 			&types.EventInvalidSendToCosmosReceiver{
@@ -168,6 +265,16 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 		); err != nil {
 			return err
 		}
+		if err := ctx.EventManager().EmitTypedEvent(
+			&types.EventDepositToCommunityPool{
+				Version:        types.TransferEventVersion,
+				EventNonce:     claim.EventNonce,
+				CosmosReceiver: claim.CosmosReceiver,
+				Amount:         coin,
+			},
+		); err != nil {
+			return err
+		}
 
 	} else {
 		if err := ctx.EventManager().EmitTypedEvent(
@@ -180,6 +287,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			return err
 		}
 	}
+	a.keeper.setDepositReceipt(ctx, claim, coin, receiptStatus)
 
 	return nil
 }
@@ -192,15 +300,29 @@ func (a AttestationHandler) handleBatchSendToEth(ctx sdk.Context, claim types.Ms
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid token contract on batch")
 	}
+	// read the batch before execution deletes it, OutgoingTxBatchExecuted panics if it does not exist
+	batch := a.keeper.GetOutgoingTXBatch(ctx, *contract, claim.BatchNonce)
 	a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim.BatchNonce)
+	a.keeper.setBatchExecution(ctx, claim, *contract)
 
 	err = ctx.EventManager().EmitTypedEvent(
 		&types.EventBatchSendToEthClaim{
 			Nonce: strconv.Itoa(int(claim.BatchNonce)),
 		},
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventTransfersExecuted{
+			Version:       types.TransferEventVersion,
+			BatchNonce:    claim.BatchNonce,
+			TokenContract: contract.GetAddress().Hex(),
+			TxIds:         batch.TxIds(),
+			EventNonce:    claim.EventNonce,
+		},
+	)
 }
 
 // Upon acceptance of sufficient ERC20 Deployed claims, register claim.TokenContract as the canonical ethereum
@@ -217,6 +339,21 @@ func (a AttestationHandler) handleErc20Deployed(ctx sdk.Context, claim types.Msg
 			types.ErrInvalid,
 			fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20.GetAddress().Hex(), claim.CosmosDenom))
 	}
+	// Disallow registering a contract which already represents a denom, including deprecated contracts
+	if existingDenom, exists := a.keeper.GetCosmosOriginatedDenom(ctx, *tokenAddress); exists {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 %s is already registered for denom %s", tokenAddress.GetAddress().Hex(), existingDenom))
+	}
+
+	// Only accept deployments which governance has requested, otherwise anyone could deploy a junk contract
+	// with matching details and claim the canonical mapping before the community deploys the real one
+	request, requested := a.keeper.GetERC20DeploymentRequest(ctx, claim.CosmosDenom)
+	if !requested {
+		return sdkerrors.Wrap(
+			types.ErrInvalid,
+			fmt.Sprintf("ERC20 deployment has not been requested by governance for denom %s", claim.CosmosDenom))
+	}
 
 	// Check if denom metadata has been accepted by governance
 	metadata, ok := a.keeper.bankKeeper.GetDenomMetaData(ctx, claim.CosmosDenom)
@@ -267,6 +404,33 @@ func (a AttestationHandler) handleErc20Deployed(ctx sdk.Context, claim types.Msg
 	// Add to denom-erc20 mapping
 	a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, *tokenAddress)
 
+	// Mark the deployment request as completed
+	request.Erc20 = tokenAddress.GetAddress().Hex()
+	request.DeployedHeight = uint64(ctx.BlockHeight())
+	a.keeper.SetERC20DeploymentRequest(ctx, request)
+
+	// Record the replacement for any migration away from a deprecated ERC20 of this denom
+	var completedMigrations []types.ERC20Migration
+	a.keeper.IterateERC20Migrations(ctx, func(migration types.ERC20Migration) bool {
+		if migration.Denom == claim.CosmosDenom && migration.NewErc20 == "" {
+			migration.NewErc20 = request.Erc20
+			completedMigrations = append(completedMigrations, migration)
+		}
+		return false
+	})
+	for _, migration := range completedMigrations {
+		a.keeper.SetERC20Migration(ctx, migration)
+		if err := ctx.EventManager().EmitTypedEvent(
+			&types.EventERC20MigrationCompleted{
+				Denom:    migration.Denom,
+				OldToken: migration.OldErc20,
+				NewToken: migration.NewErc20,
+			},
+		); err != nil {
+			return err
+		}
+	}
+
 	err = ctx.EventManager().EmitTypedEvent(
 		&types.EventERC20DeployedClaim{
 			Token: tokenAddress.GetAddress().Hex(),
@@ -431,6 +595,33 @@ func (a AttestationHandler) mintEthereumOriginatedVouchers(
 	return nil
 }
 
+// setEthereumOriginatedMetadata sets the bank denom metadata of an Ethereum originated voucher using the ERC20
+// details reported in the claim. Metadata is only set if none exists yet, so the first deposit carrying valid
+// details decides it, after that only an ERC20MetadataProposal can change it. Invalid details are logged and
+// ignored, the deposit itself must never fail because of them
+func (a AttestationHandler) setEthereumOriginatedMetadata(ctx sdk.Context, claim types.MsgSendToCosmosClaim, tokenAddress types.EthAddress) {
+	if !claim.HasTokenMetadata() {
+		return
+	}
+	denom := types.GravityDenom(tokenAddress)
+	if _, exists := a.keeper.bankKeeper.GetDenomMetaData(ctx, denom); exists {
+		return
+	}
+
+	metadata := types.GravityDenomMetadata(tokenAddress, claim.TokenName, claim.TokenSymbol, claim.TokenDecimals)
+	if err := metadata.Validate(); err != nil {
+		hash, _ := claim.ClaimHash()
+		a.keeper.logger(ctx).Error("Invalid ERC20 metadata on SendToCosmos",
+			"cause", err.Error(),
+			"claim type", claim.GetType(),
+			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"nonce", fmt.Sprint(claim.GetEventNonce()),
+		)
+		return
+	}
+	a.keeper.bankKeeper.SetDenomMetaData(ctx, metadata)
+}
+
 // Transfer tokens to gravity native accounts via bank module or foreign accounts via ibc-transfer
 // Returns ibcForwardQueued: true -> new Pending IBC Auto-Forward has been added to the queue
 // Returns err: not-nil -> the funds could not be sent to the receiver locally or with IBC, must be sent to
@@ -529,6 +720,14 @@ func (a AttestationHandler) sendCoinToLocalAddress(
 		}); err != nil {
 			return err
 		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositCredited{
+			Version:    types.TransferEventVersion,
+			EventNonce: claim.EventNonce,
+			Receiver:   receiver.String(),
+			Amount:     coin,
+		}); err != nil {
+			return err
+		}
 	}
 
 	return err // returns nil if no error
@@ -15,6 +15,7 @@ const OutgoingTxBatchSize = 100
 
 // BuildOutgoingTXBatch starts the following process chain:
 // - find bridged denominator for given voucher type
+// - check governance has not paused batches of this token
 // - determine if an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //   have a higher total fees. If not exit without creating a batch
 // - select available transactions from the outgoing transaction pool sorted by fee desc
@@ -31,6 +32,9 @@ func (k Keeper) BuildOutgoingTXBatch(
 	if !params.BridgeActive {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "bridge paused")
 	}
+	if params.BatchesPaused(contract) {
+		return nil, sdkerrors.Wrapf(types.ErrBridgePaused, "batches of %s are paused by governance", contract.GetAddress().Hex())
+	}
 
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contract)
 
@@ -78,6 +82,15 @@ func (k Keeper) BuildOutgoingTXBatch(
 			Nonce:          fmt.Sprint(nextID),
 		},
 	)
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventTransfersBatched{
+			Version:       types.TransferEventVersion,
+			BatchNonce:    nextID,
+			TokenContract: contract.GetAddress().Hex(),
+			TxIds:         batch.TxIds(),
+			BatchTimeout:  batch.BatchTimeout,
+		},
+	)
 	return batch, nil
 }
 
@@ -127,11 +140,21 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		}
 	}
 
+	// Pay the bridge fee shares held since the txs entered the pool, now that they were delivered
+	_, denom := k.ERC20ToDenomLookup(ctx, contract)
+	feeShares := sdk.NewCoin(denom, sdk.ZeroInt())
+	for _, tx := range b.Transactions {
+		feeShares = feeShares.AddAmount(tx.FeeShare)
+	}
+	if err := k.payBridgeFeeShare(ctx, feeShares); err != nil {
+		panic(sdkerrors.Wrapf(err, "unable to pay bridge fee shares of batch %s %d", contract.GetAddress().Hex(), nonce))
+	}
+
 	// Iterate through remaining batches
 	k.IterateOutgoingTXBatches(ctx, func(key []byte, iter_batch types.InternalOutgoingTxBatch) bool {
 		// If the iterated batches nonce is lower than the one that was just executed, cancel it
 		if iter_batch.BatchNonce < b.BatchNonce && iter_batch.TokenContract.GetAddress() == tokenContract.GetAddress() {
-			err := k.CancelOutgoingTXBatch(ctx, tokenContract, iter_batch.BatchNonce)
+			err := k.CancelOutgoingTXBatch(ctx, tokenContract, iter_batch.BatchNonce, types.UnbatchReasonSuperseded)
 			if err != nil {
 				panic(fmt.Sprintf("Failed cancel out batch %s %d while trying to execute %s %d with %s", tokenContract.GetAddress().Hex(), iter_batch.BatchNonce, tokenContract.GetAddress().Hex(), nonce, err))
 			}
@@ -139,6 +162,8 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		return false
 	})
 
+	// Keep a record of the delivered transactions before the batch is deleted
+	k.archiveExecutedBatch(ctx, *b)
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *b)
 	// Delete it's confirmations as well
@@ -232,8 +257,9 @@ func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddre
 	return ret
 }
 
-// CancelOutgoingTXBatch releases all TX in the batch and deletes the batch
-func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) error {
+// CancelOutgoingTXBatch releases all TX in the batch and deletes the batch, reason is reported in the emitted
+// EventTransfersUnbatched and is one of types.UnbatchReasonTimeout or types.UnbatchReasonSuperseded
+func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64, reason string) error {
 	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
 	if batch == nil {
 		return types.ErrUnknown
@@ -258,6 +284,35 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAd
 			Nonce:          fmt.Sprint(nonce),
 		},
 	)
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventTransfersUnbatched{
+			Version:       types.TransferEventVersion,
+			BatchNonce:    nonce,
+			TokenContract: tokenContract.GetAddress().Hex(),
+			TxIds:         batch.TxIds(),
+			Reason:        reason,
+		},
+	)
+
+	// A deprecated ERC20 can not be batched again, so its transactions are refunded rather than left in the pool.
+	// Batches which execute on Ethereum before they time out are handled like any other. This runs in the
+	// EndBlocker, so a refund which fails is logged and its transaction left in the pool, where the sender can
+	// still cancel it
+	if _, deprecated := k.GetERC20Migration(ctx, tokenContract); deprecated {
+		for _, tx := range batch.Transactions {
+			xCtx, commit := ctx.CacheContext()
+			if err := k.RemoveFromOutgoingPoolAndRefund(xCtx, tx.Id, tx.Sender); err != nil {
+				k.logger(ctx).Error("unable to refund transaction of deprecated ERC20 batch, leaving it in the pool",
+					"cause", err.Error(),
+					"id", fmt.Sprint(tx.Id),
+					"token", tokenContract.GetAddress().Hex(),
+				)
+				continue
+			}
+			commit()
+			ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// this file contains the executed batch archive, executed batches are deleted along with their confirms
+// so the archive keeps a copy of the most recent ones, bounded by the ExecutedBatchArchiveSize param, which
+// records which transfers were delivered in which batch
+
+// ExecutedBatchArchivePruneLimit is the most archived batches the EndBlocker drops in a single block, shrinking
+// the archive by more than this is spread over several blocks
+const ExecutedBatchArchivePruneLimit = 100
+
+// archiveExecutedBatch adds a batch observed executed on Ethereum to the archive, the oldest entries which no
+// longer fit in the archive are dropped by PruneExecutedBatchArchive
+func (k Keeper) archiveExecutedBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
+	if k.GetParams(ctx).ExecutedBatchArchiveSize == 0 {
+		// the archive is disabled
+		return
+	}
+	if !ctx.KVStore(k.storeKey).Has(types.LastExecutedBatchIDKey) {
+		k.setID(ctx, 0, types.LastExecutedBatchIDKey)
+	}
+
+	external := batch.ToExternal()
+	executed := types.ExecutedBatch{
+		BatchNonce:     batch.BatchNonce,
+		TokenContract:  batch.TokenContract.GetAddress().Hex(),
+		Transactions:   external.Transactions,
+		TotalFees:      external.GetFees(),
+		ExecutedHeight: uint64(ctx.BlockHeight()),
+	}
+	archiveID := k.autoIncrementID(ctx, types.LastExecutedBatchIDKey)
+	k.setExecutedBatch(ctx, archiveID, executed)
+}
+
+// PruneExecutedBatchArchive drops the oldest archived batches which no longer fit in the archive, or every
+// batch if the archive is disabled, at most ExecutedBatchArchivePruneLimit of them per call
+func (k Keeper) PruneExecutedBatchArchive(ctx sdk.Context) {
+	if !ctx.KVStore(k.storeKey).Has(types.LastExecutedBatchIDKey) {
+		// nothing was ever archived
+		return
+	}
+	size := k.GetParams(ctx).ExecutedBatchArchiveSize
+	k.pruneExecutedBatches(ctx, k.getID(ctx, types.LastExecutedBatchIDKey), size, ExecutedBatchArchivePruneLimit)
+}
+
+// setExecutedBatch stores an archived batch under its archive id and indexes it by batch nonce and tx ids
+func (k Keeper) setExecutedBatch(ctx sdk.Context, archiveID uint64, executed types.ExecutedBatch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetExecutedBatchKey(archiveID), k.cdc.MustMarshal(&executed))
+	store.Set(types.GetExecutedBatchByNonceKey(executed.BatchNonce), types.UInt64Bytes(archiveID))
+	for _, tx := range executed.Transactions {
+		store.Set(types.GetExecutedBatchByTxIdKey(tx.Id), types.UInt64Bytes(archiveID))
+	}
+}
+
+// pruneExecutedBatches deletes up to limit of the archived batches, and their indexes, which are not among
+// the `size` most recent ones up to lastArchiveID, oldest first
+func (k Keeper) pruneExecutedBatches(ctx sdk.Context, lastArchiveID uint64, size uint64, limit int) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.ExecutedBatchKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var expired [][]byte
+	for pruned := 0; iter.Valid() && pruned < limit; iter.Next() {
+		if binary.BigEndian.Uint64(iter.Key())+size > lastArchiveID {
+			break
+		}
+		pruned++
+		var executed types.ExecutedBatch
+		k.cdc.MustUnmarshal(iter.Value(), &executed)
+		expired = append(expired, types.GetExecutedBatchKey(binary.BigEndian.Uint64(iter.Key())),
+			types.GetExecutedBatchByNonceKey(executed.BatchNonce))
+		for _, tx := range executed.Transactions {
+			expired = append(expired, types.GetExecutedBatchByTxIdKey(tx.Id))
+		}
+	}
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// getExecutedBatchByIndex looks up the archived batch an index key points to, nil if it is not archived
+func (k Keeper) getExecutedBatchByIndex(ctx sdk.Context, indexKey []byte) *types.ExecutedBatch {
+	store := ctx.KVStore(k.storeKey)
+	archiveID := store.Get(indexKey)
+	if len(archiveID) == 0 {
+		return nil
+	}
+	bz := store.Get(types.AppendBytes(types.ExecutedBatchKey, archiveID))
+	if len(bz) == 0 {
+		return nil
+	}
+	var executed types.ExecutedBatch
+	k.cdc.MustUnmarshal(bz, &executed)
+	return &executed
+}
+
+// GetExecutedBatchByNonce returns the archived batch with the given nonce, nil if it was not executed
+// or has been dropped from the archive
+func (k Keeper) GetExecutedBatchByNonce(ctx sdk.Context, nonce uint64) *types.ExecutedBatch {
+	return k.getExecutedBatchByIndex(ctx, types.GetExecutedBatchByNonceKey(nonce))
+}
+
+// GetExecutedBatchByTxId returns the archived batch which delivered the given pool transaction, nil if
+// the transaction was not executed or its batch has been dropped from the archive
+func (k Keeper) GetExecutedBatchByTxId(ctx sdk.Context, txID uint64) *types.ExecutedBatch {
+	return k.getExecutedBatchByIndex(ctx, types.GetExecutedBatchByTxIdKey(txID))
+}
+
+// IterateExecutedBatches iterates through the archived batches, oldest first
+func (k Keeper) IterateExecutedBatches(ctx sdk.Context, cb func(archiveID uint64, executed types.ExecutedBatch) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutedBatchKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var executed types.ExecutedBatch
+		k.cdc.MustUnmarshal(iter.Value(), &executed)
+		if cb(binary.BigEndian.Uint64(iter.Key()), executed) {
+			break
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that executed batches are archived, can be found by nonce and by tx id, and that the archive is bounded
+func TestExecutedBatchArchive(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom                  = types.GravityDenom(*myTokenContractAddr)
+		allVouchers            = sdk.NewCoins(sdk.NewInt64Coin(denom, 10000))
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.ExecutedBatchArchiveSize = 2
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// sends two transfers, then batches and executes them
+	executeBatch := func(fees ...int64) (*types.InternalOutgoingTxBatch, []uint64) {
+		var ids []uint64
+		for _, fee := range fees {
+			id, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, fee))
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+		require.NoError(t, err)
+		input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce)
+		input.GravityKeeper.PruneExecutedBatchArchive(ctx)
+		return batch, ids
+	}
+
+	first, firstIds := executeBatch(1, 2)
+	executed := input.GravityKeeper.GetExecutedBatchByNonce(ctx, first.BatchNonce)
+	require.NotNil(t, executed)
+	require.Equal(t, first.BatchNonce, executed.BatchNonce)
+	require.Equal(t, myTokenContractAddr.GetAddress().Hex(), executed.TokenContract)
+	require.Equal(t, first.ToExternal().Transactions, executed.Transactions)
+	require.Equal(t, sdk.NewInt(3), executed.TotalFees)
+	require.Equal(t, uint64(ctx.BlockHeight()), executed.ExecutedHeight)
+	for _, id := range firstIds {
+		res, err := input.GravityKeeper.ExecutedBatchByTxId(sdk.WrapSDKContext(ctx), &types.QueryExecutedBatchByTxIdRequest{TxId: id})
+		require.NoError(t, err)
+		require.Equal(t, *executed, res.Batch)
+	}
+	// the executed batch is no longer pending
+	require.Nil(t, input.GravityKeeper.GetOutgoingTXBatch(ctx, *myTokenContractAddr, first.BatchNonce))
+
+	second, _ := executeBatch(3)
+	third, thirdIds := executeBatch(4)
+
+	// only the two most recent batches are kept
+	require.Nil(t, input.GravityKeeper.GetExecutedBatchByNonce(ctx, first.BatchNonce))
+	for _, id := range firstIds {
+		_, err := input.GravityKeeper.ExecutedBatchByTxId(sdk.WrapSDKContext(ctx), &types.QueryExecutedBatchByTxIdRequest{TxId: id})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	}
+	require.NotNil(t, input.GravityKeeper.GetExecutedBatchByNonce(ctx, second.BatchNonce))
+	res, err := input.GravityKeeper.ExecutedBatchByNonce(sdk.WrapSDKContext(ctx), &types.QueryExecutedBatchByNonceRequest{BatchNonce: third.BatchNonce})
+	require.NoError(t, err)
+	require.Equal(t, thirdIds[0], res.Batch.Transactions[0].Id)
+
+	// disabling the archive drops every entry
+	params.ExecutedBatchArchiveSize = 0
+	input.GravityKeeper.SetParams(ctx, params)
+	fourth, _ := executeBatch(5)
+	input.GravityKeeper.IterateExecutedBatches(ctx, func(_ uint64, executed types.ExecutedBatch) bool {
+		t.Fatalf("batch %d left in a disabled archive", executed.BatchNonce)
+		return true
+	})
+	require.Nil(t, input.GravityKeeper.GetExecutedBatchByNonce(ctx, fourth.BatchNonce))
+	require.Nil(t, input.GravityKeeper.GetExecutedBatchByTxId(ctx, thirdIds[0]))
+}
+
+// Tests that pruning a shrunk archive deletes at most ExecutedBatchArchivePruneLimit batches per block
+func TestExecutedBatchArchivePruneLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	total := ExecutedBatchArchivePruneLimit + 50
+	for id := 1; id <= total; id++ {
+		k.setExecutedBatch(ctx, uint64(id), types.ExecutedBatch{
+			BatchNonce:   uint64(id),
+			Transactions: []types.OutgoingTransferTx{{Id: uint64(id)}},
+		})
+	}
+	k.setID(ctx, uint64(total), types.LastExecutedBatchIDKey)
+	countArchived := func() int {
+		count := 0
+		k.IterateExecutedBatches(ctx, func(_ uint64, _ types.ExecutedBatch) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	params := k.GetParams(ctx)
+	params.ExecutedBatchArchiveSize = 0
+	k.SetParams(ctx, params)
+
+	k.PruneExecutedBatchArchive(ctx)
+	require.Equal(t, total-ExecutedBatchArchivePruneLimit, countArchived())
+	// the oldest batches go first
+	require.Nil(t, k.GetExecutedBatchByNonce(ctx, uint64(ExecutedBatchArchivePruneLimit)))
+	require.Nil(t, k.GetExecutedBatchByTxId(ctx, uint64(ExecutedBatchArchivePruneLimit)))
+	require.NotNil(t, k.GetExecutedBatchByNonce(ctx, uint64(ExecutedBatchArchivePruneLimit+1)))
+
+	k.PruneExecutedBatchArchive(ctx)
+	require.Zero(t, countArchived())
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -86,6 +87,7 @@ func TestBatches(t *testing.T) {
 			{
 				Id:          2,
 				Erc20Fee:    types.NewERC20Token(3, myTokenContractAddr.GetAddress().Hex()),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver.GetAddress().Hex(),
 				Erc20Token:  types.NewERC20Token(101, myTokenContractAddr.GetAddress().Hex()),
@@ -93,6 +95,7 @@ func TestBatches(t *testing.T) {
 			{
 				Id:          3,
 				Erc20Fee:    types.NewERC20Token(2, myTokenContractAddr.GetAddress().Hex()),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver.GetAddress().Hex(),
 				Erc20Token:  types.NewERC20Token(102, myTokenContractAddr.GetAddress().Hex()),
@@ -141,6 +144,7 @@ func TestBatches(t *testing.T) {
 		{
 			Id:          1,
 			Erc20Fee:    twoFee,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: myReceiver,
 			Erc20Token:  oneHundredTok,
@@ -148,6 +152,7 @@ func TestBatches(t *testing.T) {
 		{
 			Id:          4,
 			Erc20Fee:    oneFee,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: myReceiver,
 			Erc20Token:  oneHundredThreeTok,
@@ -193,6 +198,7 @@ func TestBatches(t *testing.T) {
 			{
 				Id:          6,
 				Erc20Fee:    types.NewERC20Token(5, myTokenContractAddr.GetAddress().Hex()),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver.GetAddress().Hex(),
 				Erc20Token:  types.NewERC20Token(101, myTokenContractAddr.GetAddress().Hex()),
@@ -200,6 +206,7 @@ func TestBatches(t *testing.T) {
 			{
 				Id:          5,
 				Erc20Fee:    types.NewERC20Token(4, myTokenContractAddr.GetAddress().Hex()),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver.GetAddress().Hex(),
 				Erc20Token:  types.NewERC20Token(100, myTokenContractAddr.GetAddress().Hex()),
@@ -265,6 +272,7 @@ func TestBatches(t *testing.T) {
 		{
 			Id:          2,
 			Erc20Fee:    threeFee,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: myReceiver,
 			Erc20Token:  oneHundredOneTok,
@@ -272,6 +280,7 @@ func TestBatches(t *testing.T) {
 		{
 			Id:          3,
 			Erc20Fee:    twoFee,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: myReceiver,
 			Erc20Token:  oneHundredTwoTok,
@@ -279,6 +288,7 @@ func TestBatches(t *testing.T) {
 		{
 			Id:          1,
 			Erc20Fee:    twoFee,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: myReceiver,
 			Erc20Token:  oneHundredTok,
@@ -286,6 +296,7 @@ func TestBatches(t *testing.T) {
 		{
 			Id:          4,
 			Erc20Fee:    oneFee,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: myReceiver,
 			Erc20Token:  oneHundredThreeTok,
@@ -364,6 +375,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			{
 				Id:          2,
 				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
@@ -371,6 +383,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			{
 				Id:          3,
 				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
@@ -396,6 +409,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		{
 			Id:          1,
 			Erc20Fee:    twentyTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  twentyTok,
@@ -403,6 +417,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		{
 			Id:          4,
 			Erc20Fee:    tenTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  tenTok,
@@ -440,6 +455,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			{
 				Id:          5,
 				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(200)), myTokenContractAddr),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(200)), myTokenContractAddr),
@@ -447,6 +463,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			{
 				Id:          6,
 				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(150)), myTokenContractAddr),
+				FeeShare:    sdk.ZeroInt(),
 				Sender:      mySender.String(),
 				DestAddress: myReceiver,
 				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(150)), myTokenContractAddr),
@@ -483,6 +500,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		{
 			Id:          2,
 			Erc20Fee:    threeHundredTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  threeHundredTok,
@@ -490,6 +508,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		{
 			Id:          3,
 			Erc20Fee:    twentyFiveTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  twentyFiveTok,
@@ -497,6 +516,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		{
 			Id:          1,
 			Erc20Fee:    twentyTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  twentyTok,
@@ -504,6 +524,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		{
 			Id:          4,
 			Erc20Fee:    tenTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  tenTok,
@@ -689,6 +710,53 @@ func TestPoolTxRefund(t *testing.T) {
 	require.Equal(t, sdk.NewInt(104), balances.AmountOf(myDenom))
 }
 
+// Tests that cancelling a batch of a deprecated ERC20 refunds its transactions, and that a transaction whose
+// refund fails is left in the pool instead of halting the chain
+func TestCancelDeprecatedBatchRefundFailure(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		blockedSender       = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	contract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	denom := types.GravityDenom(*contract)
+	vouchers := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200)))
+
+	// bank refuses to send to module accounts, so the refund of the fee collector's transfer fails
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers.Add(vouchers...)))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, vouchers))
+
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewCoin(denom, sdk.NewInt(100)), sdk.NewCoin(denom, sdk.NewInt(10)))
+	require.NoError(t, err)
+	blockedTx, err := input.GravityKeeper.AddToOutgoingPool(ctx, blockedSender, *myReceiver, sdk.NewCoin(denom, sdk.NewInt(100)), sdk.NewCoin(denom, sdk.NewInt(20)))
+	require.NoError(t, err)
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *contract, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+
+	input.GravityKeeper.SetERC20Migration(ctx, types.ERC20Migration{
+		Denom:       denom,
+		OldErc20:    contract.GetAddress().Hex(),
+		StartHeight: uint64(ctx.BlockHeight()),
+		EndHeight:   uint64(ctx.BlockHeight()) + 10,
+	})
+	require.NoError(t, input.GravityKeeper.CancelOutgoingTXBatch(ctx, *contract, batch.BatchNonce, types.UnbatchReasonTimeout))
+
+	require.Nil(t, input.GravityKeeper.GetOutgoingTXBatch(ctx, *contract, batch.BatchNonce))
+	require.Equal(t, sdk.NewInt(200), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(ctx)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, blockedTx, unbatched[0].Id)
+}
+
 //nolint: exhaustivestruct
 func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 	input := CreateTestEnv(t)
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//      BRIDGE PAUSES      //
+/////////////////////////////
+
+// setPausedDeposit holds an observed deposit of a token whose deposits are paused until governance lifts the
+// pause. Paused deposits are kept apart from rate limited deposits so that they never count as inflow
+func (k Keeper) setPausedDeposit(ctx sdk.Context, claim types.MsgSendToCosmosClaim) {
+	tokenContract, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid token contract on paused deposit"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPausedDepositKey(*tokenContract, claim.EventNonce), k.cdc.MustMarshal(&claim))
+}
+
+// GetPausedDeposits returns the deposits of tokenContract held by a pause, in event nonce order
+func (k Keeper) GetPausedDeposits(ctx sdk.Context, tokenContract types.EthAddress) (out []types.MsgSendToCosmosClaim) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPausedDepositPrefix(tokenContract))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.MsgSendToCosmosClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		out = append(out, claim)
+	}
+	return out
+}
+
+// IteratePausedDeposits iterates over every paused deposit, grouped by token and in event nonce order
+func (k Keeper) IteratePausedDeposits(ctx sdk.Context, cb func(types.MsgSendToCosmosClaim) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedDepositKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.MsgSendToCosmosClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		// cb returns true to stop early
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+// releasePausedDeposits moves the paused deposits of every token which is no longer paused to the rate limited
+// deposit queue, which is ordered by event nonce, so they are credited in order as inflow capacity allows
+func (k Keeper) releasePausedDeposits(ctx sdk.Context, params types.Params) {
+	var released []types.MsgSendToCosmosClaim
+	k.IteratePausedDeposits(ctx, func(claim types.MsgSendToCosmosClaim) bool {
+		// the token contract was validated when the deposit was paused
+		tokenContract, _ := types.NewEthAddress(claim.TokenContract)
+		if !params.DepositsPaused(*tokenContract) {
+			released = append(released, claim)
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, claim := range released {
+		tokenContract, _ := types.NewEthAddress(claim.TokenContract)
+		store.Delete(types.GetPausedDepositKey(*tokenContract, claim.EventNonce))
+		k.setRateLimitedDeposit(ctx, claim)
+	}
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that paused withdrawals and batches are rejected for the paused token only
+func TestBridgePauseWithdrawalsAndBatches(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _     = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver      = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		pausedContract  = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		allowedContract = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+	)
+	sv := msgServer{input.GravityKeeper}
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+
+	voucher := func(amount int64, contract string) sdk.Coin {
+		token, err := types.NewInternalERC20Token(sdk.NewInt(amount), contract)
+		require.NoError(t, err)
+		return token.GravityCoin()
+	}
+	for _, contract := range []string{pausedContract, allowedContract} {
+		coins := sdk.Coins{voucher(1000, contract)}
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, coins))
+	}
+	sendToEth := func(contract string) error {
+		_, err := sv.SendToEth(sdk.WrapSDKContext(ctx), &types.MsgSendToEth{
+			Sender:    mySender.String(),
+			EthDest:   myReceiver,
+			Amount:    voucher(100, contract),
+			BridgeFee: voucher(1, contract),
+		})
+		return err
+	}
+	requestBatch := func(contract string) error {
+		_, err := sv.RequestBatch(sdk.WrapSDKContext(ctx), &types.MsgRequestBatch{
+			Sender: mySender.String(),
+			Denom:  voucher(1, contract).Denom,
+		})
+		return err
+	}
+
+	// queue a transfer before pausing so that a batch could be built
+	require.NoError(t, sendToEth(pausedContract))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgePauses = []types.BridgePause{{TokenContract: pausedContract, Withdrawals: true, Batches: true}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	require.ErrorIs(t, sendToEth(pausedContract), types.ErrBridgePaused)
+	require.ErrorIs(t, requestBatch(pausedContract), types.ErrBridgePaused)
+	require.NoError(t, sendToEth(allowedContract))
+	require.NoError(t, requestBatch(allowedContract))
+
+	// pausing only withdrawals still allows batching the existing pool
+	params.BridgePauses = []types.BridgePause{{TokenContract: pausedContract, Withdrawals: true}}
+	input.GravityKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, sendToEth(pausedContract), types.ErrBridgePaused)
+	require.NoError(t, requestBatch(pausedContract))
+
+	// a pause without a token contract applies to every token
+	params.BridgePauses = []types.BridgePause{{Withdrawals: true}}
+	input.GravityKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, sendToEth(allowedContract), types.ErrBridgePaused)
+}
+
+// Tests that deposits of a paused token are queued and credited in order once the pause is lifted
+func TestBridgePauseDeposits(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		myReceiver, _       = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	denom := types.GravityDenom(*tokenContract)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgePauses = []types.BridgePause{{TokenContract: myTokenContractAddr, Deposits: true}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  myTokenContractAddr,
+			Amount:         sdk.NewInt(50),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: myReceiver.String(),
+		}
+		require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	require.True(t, input.BankKeeper.GetBalance(ctx, myReceiver, denom).Amount.IsZero())
+	require.Len(t, input.GravityKeeper.GetPausedDeposits(ctx, *tokenContract), 2)
+	require.Empty(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract))
+
+	// still paused, nothing is released
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.True(t, input.BankKeeper.GetBalance(ctx, myReceiver, denom).Amount.IsZero())
+	require.Len(t, input.GravityKeeper.GetPausedDeposits(ctx, *tokenContract), 2)
+
+	params.BridgePauses = []types.BridgePause{}
+	input.GravityKeeper.SetParams(ctx, params)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, myReceiver, denom).Amount)
+	require.Empty(t, input.GravityKeeper.GetPausedDeposits(ctx, *tokenContract))
+	require.Empty(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract))
+}
+
+// Tests that paused deposits released into a rate limited token still respect its inflow limit
+func TestBridgePauseDepositsRateLimited(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		myReceiver, _       = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	denom := types.GravityDenom(*tokenContract)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgePauses = []types.BridgePause{{TokenContract: myTokenContractAddr, Deposits: true}}
+	params.RateLimits = []types.TokenRateLimit{{
+		TokenContract:           myTokenContractAddr,
+		Window:                  10,
+		InflowLimit:             sdk.NewInt(50),
+		OutflowLimit:            sdk.ZeroInt(),
+		CircuitBreakerThreshold: sdk.ZeroInt(),
+	}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  myTokenContractAddr,
+			Amount:         sdk.NewInt(50),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: myReceiver.String(),
+		}
+		require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	require.Len(t, input.GravityKeeper.GetPausedDeposits(ctx, *tokenContract), 2)
+
+	// paused deposits do not use any inflow capacity
+	capacities := input.GravityKeeper.GetRateLimitCapacities(ctx, tokenContract)
+	require.Len(t, capacities, 1)
+	require.True(t, capacities[0].InflowUsed.IsZero())
+	require.Empty(t, capacities[0].QueuedDeposits)
+
+	params.BridgePauses = []types.BridgePause{}
+	input.GravityKeeper.SetParams(ctx, params)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Equal(t, sdk.NewInt(50), input.BankKeeper.GetBalance(ctx, myReceiver, denom).Amount)
+	require.Empty(t, input.GravityKeeper.GetPausedDeposits(ctx, *tokenContract))
+	require.Len(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract), 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, myReceiver, denom).Amount)
+	require.Empty(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract))
+}
+
+// Tests that the pauses are enforced by the keeper, not only by the msg server
+func TestBridgePauseKeeperCallers(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _    = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver     = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		pausedContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), pausedContract)
+	require.NoError(t, err)
+	coins := sdk.Coins{token.GravityCoin()}
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, coins))
+
+	amount := sdk.NewCoin(token.GravityCoin().Denom, sdk.NewInt(100))
+	fee := sdk.NewCoin(token.GravityCoin().Denom, sdk.NewInt(1))
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+	require.NoError(t, err)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgePauses = []types.BridgePause{{TokenContract: pausedContract, Withdrawals: true, Batches: true}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, token.Contract, OutgoingTxBatchSize)
+	require.ErrorIs(t, err, types.ErrBridgePaused)
+}
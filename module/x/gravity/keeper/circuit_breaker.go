@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//     CIRCUIT BREAKER     //
+/////////////////////////////
+
+// GetCircuitBreakerTrip returns the reason the circuit breaker halted the bridge, if it is currently tripped
+func (k Keeper) GetCircuitBreakerTrip(ctx sdk.Context) (types.CircuitBreakerTrip, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CircuitBreakerTripKey)
+	if bz == nil {
+		return types.CircuitBreakerTrip{}, false
+	}
+	var trip types.CircuitBreakerTrip
+	k.cdc.MustUnmarshal(bz, &trip)
+	return trip, true
+}
+
+// TripCircuitBreaker halts the bridge by setting BridgeActive to false and records why, the bridge can then only
+// be resumed by an UnhaltBridgeProposal
+func (k Keeper) TripCircuitBreaker(ctx sdk.Context, reason string, tokenContract string) {
+	k.logger(ctx).Error("Circuit breaker halting the bridge", "reason", reason, "token", tokenContract)
+
+	params := k.GetParams(ctx)
+	params.BridgeActive = false
+	k.SetParams(ctx, params)
+
+	k.setCircuitBreakerTrip(ctx, types.CircuitBreakerTrip{
+		Reason:        reason,
+		TokenContract: tokenContract,
+		BlockHeight:   uint64(ctx.BlockHeight()),
+	})
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventBridgeCircuitBreakerTripped{
+			Reason: reason,
+			Token:  tokenContract,
+		},
+	)
+}
+
+// setCircuitBreakerTrip records why the circuit breaker halted the bridge, without halting it
+func (k Keeper) setCircuitBreakerTrip(ctx sdk.Context, trip types.CircuitBreakerTrip) {
+	ctx.KVStore(k.storeKey).Set(types.CircuitBreakerTripKey, k.cdc.MustMarshal(&trip))
+}
+
+// resetCircuitBreaker clears a circuit breaker trip and reactivates the bridge, returns false if the circuit
+// breaker had not been tripped. The inflow records of every token over its circuit breaker threshold are cleared,
+// governance has reviewed that inflow and it must not halt the bridge again while it is still within the window
+func (k Keeper) resetCircuitBreaker(ctx sdk.Context) bool {
+	if _, tripped := k.GetCircuitBreakerTrip(ctx); !tripped {
+		return false
+	}
+	ctx.KVStore(k.storeKey).Delete(types.CircuitBreakerTripKey)
+	for {
+		_, tokenContract, trip := k.checkInflowThresholds(ctx)
+		if !trip {
+			break
+		}
+		// the token contract comes from validated params
+		contract, _ := types.NewEthAddress(tokenContract)
+		k.clearRateLimitFlows(ctx, types.RateLimitInflowKey, *contract)
+	}
+
+	params := k.GetParams(ctx)
+	params.BridgeActive = true
+	k.SetParams(ctx, params)
+	return true
+}
+
+// CircuitBreakerBalanceCheckInterval is how often, in blocks, the circuit breaker compares the module escrow
+// against the transfers in flight. These checks iterate over every pending transfer so they are too expensive
+// to run in every EndBlocker, the inflow thresholds are cheap and are checked every block
+const CircuitBreakerBalanceCheckInterval = 10
+
+// CheckCircuitBreaker looks for anomalies which should halt the bridge, returning the reason and the token
+// responsible (if known). The bridge should be halted if:
+//   - the module balance of an Ethereum originated voucher does not match the transfers waiting to leave Cosmos,
+//     this is the same check as ModuleBalanceInvariant
+//   - the module escrow of a Cosmos originated token is less than the transfers waiting to leave Cosmos
+//   - the amount of a token credited within its rate limit window exceeds the token's circuit breaker threshold,
+//     queued deposits have not been credited yet and are only counted once they are released
+//
+// The balance checks only run every CircuitBreakerBalanceCheckInterval blocks
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context) (reason string, tokenContract string, trip bool) {
+	if uint64(ctx.BlockHeight())%CircuitBreakerBalanceCheckInterval == 0 {
+		if reason, trip := k.checkModuleBalances(ctx); trip {
+			return reason, "", true
+		}
+	}
+	return k.checkInflowThresholds(ctx)
+}
+
+// checkModuleBalances compares the module escrow of every token against the transfers waiting to leave Cosmos
+func (k Keeper) checkModuleBalances(ctx sdk.Context) (reason string, trip bool) {
+	if msg, broken := ModuleBalanceInvariant(k)(ctx); broken {
+		return msg, true
+	}
+
+	modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	expectedBals := make(map[string]*sdk.Int)
+	expectedBals = sumUnconfirmedBatchModuleBalances(ctx, k, expectedBals)
+	expectedBals = sumUnbatchedTxModuleBalances(ctx, k, expectedBals)
+	expectedBals = sumPendingIbcAutoForwards(ctx, k, expectedBals)
+	for denom, expected := range expectedBals {
+		if _, err := types.GravityDenomToERC20(denom); err == nil {
+			// Ethereum originated, checked by ModuleBalanceInvariant
+			continue
+		}
+		if actual := k.bankKeeper.GetBalance(ctx, modAcc, denom); actual.Amount.LT(*expected) {
+			return fmt.Sprintf("escrow of cosmos-originated %s is %s, less than the %s waiting to be sent", denom, actual.Amount, expected), true
+		}
+	}
+	return "", false
+}
+
+// checkInflowThresholds compares the amount of each token credited within its rate limit window against the
+// token's circuit breaker threshold
+func (k Keeper) checkInflowThresholds(ctx sdk.Context) (reason string, tokenContract string, trip bool) {
+	for _, limit := range k.GetParams(ctx).RateLimits {
+		if limit.CircuitBreakerThreshold.IsZero() {
+			continue
+		}
+		contract, err := types.NewEthAddress(limit.TokenContract)
+		if err != nil {
+			continue
+		}
+		inflow := k.getRateLimitFlow(ctx, types.RateLimitInflowKey, *contract, limit.Window)
+		if inflow.GT(limit.CircuitBreakerThreshold) {
+			return fmt.Sprintf("inflow of %s within %d blocks exceeds the circuit breaker threshold of %s", inflow, limit.Window, limit.CircuitBreakerThreshold),
+				contract.GetAddress().Hex(), true
+		}
+	}
+	return "", "", false
+}
@@ -40,6 +40,13 @@ func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, t
 	store.Set(types.GetERC20ToDenomKey(tokenContract), []byte(denom))
 }
 
+// setCosmosOriginatedERC20ToDenom sets only the ERC20 to denom half of the mapping, as is left behind for a
+// deprecated Cosmos originated ERC20
+func (k Keeper) setCosmosOriginatedERC20ToDenom(ctx sdk.Context, denom string, tokenContract types.EthAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetERC20ToDenomKey(tokenContract), []byte(denom))
+}
+
 // DenomToERC20 returns (bool isCosmosOriginated, EthAddress ERC20, err)
 // Using this information, you can see if an asset is native to Cosmos or Ethereum,
 // and get its corresponding ERC20 address.
@@ -121,3 +128,84 @@ func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 		}
 	}
 }
+
+// SetERC20DeploymentRequest stores a governance approved ERC20 deployment request, overwriting any existing
+// request for the same denom
+func (k Keeper) SetERC20DeploymentRequest(ctx sdk.Context, request types.ERC20DeploymentRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetERC20DeploymentRequestKey(request.Denom), k.cdc.MustMarshal(&request))
+}
+
+// GetERC20DeploymentRequest returns the ERC20 deployment request for the given denom, if one was approved
+func (k Keeper) GetERC20DeploymentRequest(ctx sdk.Context, denom string) (types.ERC20DeploymentRequest, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetERC20DeploymentRequestKey(denom))
+	if bz == nil {
+		return types.ERC20DeploymentRequest{}, false
+	}
+	var request types.ERC20DeploymentRequest
+	k.cdc.MustUnmarshal(bz, &request)
+	return request, true
+}
+
+// IterateERC20DeploymentRequests iterates over every ERC20 deployment request, pending or completed, in denom order
+func (k Keeper) IterateERC20DeploymentRequests(ctx sdk.Context, cb func(types.ERC20DeploymentRequest) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ERC20DeploymentRequestKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var request types.ERC20DeploymentRequest
+		k.cdc.MustUnmarshal(iter.Value(), &request)
+		// cb returns true to stop early
+		if cb(request) {
+			break
+		}
+	}
+}
+
+// deprecateCosmosOriginatedERC20 removes the denom to ERC20 half of the mapping so that no new transfers to the
+// deprecated contract can be created, the ERC20 to denom half is kept so deposits, executed batches and refunds
+// for the deprecated contract continue to resolve to the Cosmos originated denom
+func (k Keeper) deprecateCosmosOriginatedERC20(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomToERC20Key(denom))
+}
+
+// SetERC20Migration stores the migration record for a deprecated Cosmos originated ERC20
+func (k Keeper) SetERC20Migration(ctx sdk.Context, migration types.ERC20Migration) {
+	oldErc20, err := types.NewEthAddress(migration.OldErc20)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid old erc20 in migration"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetERC20MigrationKey(*oldErc20), k.cdc.MustMarshal(&migration))
+}
+
+// GetERC20Migration returns the migration record for oldErc20, if it has been deprecated
+func (k Keeper) GetERC20Migration(ctx sdk.Context, oldErc20 types.EthAddress) (types.ERC20Migration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetERC20MigrationKey(oldErc20))
+	if bz == nil {
+		return types.ERC20Migration{}, false
+	}
+	var migration types.ERC20Migration
+	k.cdc.MustUnmarshal(bz, &migration)
+	return migration, true
+}
+
+// IterateERC20Migrations iterates over every deprecated Cosmos originated ERC20
+func (k Keeper) IterateERC20Migrations(ctx sdk.Context, cb func(types.ERC20Migration) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ERC20MigrationKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var migration types.ERC20Migration
+		k.cdc.MustUnmarshal(iter.Value(), &migration)
+		// cb returns true to stop early
+		if cb(migration) {
+			break
+		}
+	}
+}
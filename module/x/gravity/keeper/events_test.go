@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// emittedEvents parses every event of the same type as `example` emitted on ctx so far
+func emittedEvents(t *testing.T, ctx sdk.Context, example proto.Message) []proto.Message {
+	var out []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(example) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		out = append(out, msg)
+	}
+	return out
+}
+
+// Tests that a withdrawal can be followed from queued to executed using only the transfer lifecycle events
+func TestTransferLifecycleEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context.WithEventManager(sdk.NewEventManager())
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		tokenContract          = myTokenContractAddr.GetAddress().Hex()
+		denom                  = types.GravityDenom(*myTokenContractAddr)
+		allVouchers            = sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	for i := int64(1); i <= 3; i++ {
+		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, i))
+		require.NoError(t, err)
+	}
+	queued := emittedEvents(t, ctx, &types.EventTransferQueued{})
+	require.Len(t, queued, 3)
+	require.Equal(t, &types.EventTransferQueued{
+		Version:       types.TransferEventVersion,
+		TxId:          3,
+		Sender:        mySender.String(),
+		Receiver:      myReceiver.GetAddress().Hex(),
+		TokenContract: tokenContract,
+		Amount:        sdk.NewInt64Coin(denom, 100),
+		Fee:           sdk.NewInt64Coin(denom, 3),
+		FeeShare:      sdk.NewInt64Coin(denom, 0),
+		ChainFee:      sdk.Coins{},
+	}, queued[2])
+
+	// the cancelled transfer is refunded amount plus fee
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 1, mySender))
+	require.Equal(t, []proto.Message{&types.EventTransferCancelled{
+		Version:       types.TransferEventVersion,
+		TxId:          1,
+		Sender:        mySender.String(),
+		TokenContract: tokenContract,
+		Refund:        sdk.NewInt64Coin(denom, 101),
+	}}, emittedEvents(t, ctx, &types.EventTransferCancelled{}))
+
+	// a timed out batch returns its transfers to the pool
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	require.NoError(t, input.GravityKeeper.CancelOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce, types.UnbatchReasonTimeout))
+	require.Equal(t, []proto.Message{&types.EventTransfersUnbatched{
+		Version:       types.TransferEventVersion,
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: tokenContract,
+		TxIds:         []uint64{3, 2},
+		Reason:        types.UnbatchReasonTimeout,
+	}}, emittedEvents(t, ctx, &types.EventTransfersUnbatched{}))
+
+	batch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	batched := emittedEvents(t, ctx, &types.EventTransfersBatched{})
+	require.Len(t, batched, 2)
+	require.Equal(t, &types.EventTransfersBatched{
+		Version:       types.TransferEventVersion,
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: tokenContract,
+		TxIds:         []uint64{3, 2},
+		BatchTimeout:  batch.BatchTimeout,
+	}, batched[1])
+
+	claim := types.MsgBatchSendToEthClaim{
+		EventNonce:    1,
+		BlockHeight:   1,
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: tokenContract,
+	}
+	require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	require.Equal(t, []proto.Message{&types.EventTransfersExecuted{
+		Version:       types.TransferEventVersion,
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: tokenContract,
+		TxIds:         []uint64{3, 2},
+		EventNonce:    1,
+	}}, emittedEvents(t, ctx, &types.EventTransfersExecuted{}))
+}
+
+// Tests that a deposit emits observed then credited, or queued while its token is paused
+func TestDepositLifecycleEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context.WithEventManager(sdk.NewEventManager())
+	var (
+		myReceiver, _       = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		ethSender           = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	amount := sdk.NewInt64Coin(types.GravityDenom(*tokenContract), 50)
+
+	deposit := func(nonce uint64) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce + 100,
+			TokenContract:  myTokenContractAddr,
+			Amount:         amount.Amount,
+			EthereumSender: ethSender,
+			CosmosReceiver: myReceiver.String(),
+		}
+		require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+
+	deposit(1)
+	require.Equal(t, []proto.Message{&types.EventDepositObserved{
+		Version:        types.TransferEventVersion,
+		EventNonce:     1,
+		EthBlockHeight: 101,
+		EthereumSender: ethSender,
+		CosmosReceiver: myReceiver.String(),
+		TokenContract:  myTokenContractAddr,
+		Amount:         amount,
+	}}, emittedEvents(t, ctx, &types.EventDepositObserved{}))
+	require.Equal(t, []proto.Message{&types.EventDepositCredited{
+		Version:    types.TransferEventVersion,
+		EventNonce: 1,
+		Receiver:   myReceiver.String(),
+		Amount:     amount,
+	}}, emittedEvents(t, ctx, &types.EventDepositCredited{}))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgePauses = []types.BridgePause{{TokenContract: myTokenContractAddr, Deposits: true}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	deposit(2)
+	require.Len(t, emittedEvents(t, ctx, &types.EventDepositObserved{}), 2)
+	require.Len(t, emittedEvents(t, ctx, &types.EventDepositCredited{}), 1)
+	require.Equal(t, []proto.Message{&types.EventDepositQueued{
+		Version:       types.TransferEventVersion,
+		EventNonce:    2,
+		TokenContract: myTokenContractAddr,
+		Amount:        amount,
+		Reason:        types.DepositQueuedReasonPaused,
+	}}, emittedEvents(t, ctx, &types.EventDepositQueued{}))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -79,6 +80,7 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	if !val.IsJailed() {
 		k.StakingKeeper.Jail(ctx, cons)
 		k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature)
+		k.SetBridgeSlashingEvent(ctx, val.GetOperator(), types.AttributeKeyBadEthSignature)
 	}
 
 	return nil
@@ -91,6 +93,18 @@ func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte
 	store.Set(types.GetPastEthSignatureCheckpointKey(checkpoint), []byte{0x1})
 }
 
+// IteratePastEthSignatureCheckpoints iterates over every checkpoint which has ever existed
+func (k Keeper) IteratePastEthSignatureCheckpoints(ctx sdk.Context, cb func(checkpoint []byte) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PastEthSignatureCheckpointKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
 // GetPastEthSignatureCheckpoint tells you whether a given checkpoint has ever existed
 func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) (found bool) {
 	store := ctx.KVStore(k.storeKey)
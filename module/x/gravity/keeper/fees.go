@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// this file contains the parts of MsgSendToEth fees which are paid on the Cosmos side rather than to the relayer
+
+// bridgeFeeShare returns the part of a bridge fee which is paid to Cosmos side stakers, see Params.BridgeFeeShare
+func (k Keeper) bridgeFeeShare(ctx sdk.Context, fee sdk.Coin) sdk.Coin {
+	share := k.GetParams(ctx).BridgeFeeShare
+	if share.IsNil() || !share.IsPositive() {
+		return sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(fee.Denom, share.MulInt(fee.Amount).TruncateInt())
+}
+
+// payBridgeFeeShare moves the bridge fee share, already held by the gravity module, to the fee collector to be
+// distributed to stakers, or to the community pool if governance has chosen so
+func (k Keeper) payBridgeFeeShare(ctx sdk.Context, feeShare sdk.Coin) error {
+	if !feeShare.IsPositive() {
+		return nil
+	}
+	coins := sdk.NewCoins(feeShare)
+	if k.GetParams(ctx).BridgeFeeShareToCommunityPool {
+		return k.SendToCommunityPool(ctx, coins)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins); err != nil {
+		return sdkerrors.Wrap(err, "transfer to fee collector failed")
+	}
+	return nil
+}
+
+// payChainFee charges the sender of a transfer of amount to Ethereum the Params.ChainFee, which is paid to the
+// fee collector to be distributed to stakers, and returns the fee charged
+func (k Keeper) payChainFee(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coins, error) {
+	chainFee := k.GetParams(ctx).ChainFee.Compute(amount)
+	if chainFee.IsZero() {
+		return chainFee, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, chainFee); err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to pay chain fee %s", chainFee)
+	}
+	return chainFee, nil
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that the bridge fee share is held with a transfer and paid once its batch is executed, and that cancels
+// and timed out batches refund or keep it along with the rest of the transfer
+func TestBridgeFeeShare(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom                  = types.GravityDenom(*myTokenContractAddr)
+		feeCollector           = input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgeFeeShare = sdk.NewDecWithPrec(25, 2)
+	input.GravityKeeper.SetParams(ctx, params)
+
+	balance := func(addr sdk.AccAddress) int64 {
+		return input.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+	send := func() uint64 {
+		id, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+		require.NoError(t, err)
+		return id
+	}
+
+	// a quarter of the fee, rounded down, is held with the tx and the relayer is left the rest
+	id := send()
+	require.Zero(t, balance(feeCollector))
+	tx, err := input.GravityKeeper.GetUnbatchedTxById(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(8), tx.Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(2), tx.FeeShare)
+
+	// the share is refunded by a cancel
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, id, mySender))
+	require.Equal(t, int64(1000), balance(mySender))
+	require.Zero(t, balance(feeCollector))
+
+	// a timed out batch returns the transfer to the pool with its share still held
+	id = send()
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	require.NoError(t, input.GravityKeeper.CancelOutgoingTXBatch(ctx, *myTokenContractAddr, batch.BatchNonce, types.UnbatchReasonTimeout))
+	require.Zero(t, balance(feeCollector))
+	tx, err = input.GravityKeeper.GetUnbatchedTxById(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(8), tx.Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(2), tx.FeeShare)
+
+	// the share is paid to the fee collector once the batch is executed
+	batch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce)
+	require.Equal(t, int64(2), balance(feeCollector))
+	require.Equal(t, int64(890), balance(mySender))
+
+	// governance may send the share to the community pool instead
+	params.BridgeFeeShareToCommunityPool = true
+	input.GravityKeeper.SetParams(ctx, params)
+	poolBefore := input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(denom)
+	send()
+	batch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *myTokenContractAddr, batch.BatchNonce)
+	require.Equal(t, int64(2), balance(feeCollector))
+	require.Equal(t, poolBefore.Add(sdk.NewDec(2)), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(denom))
+}
+
+// Tests that transfers below the token's minimum are rejected and that the chain fee is charged to the fee
+// collector on top of the amount and is not refunded by a cancel
+func TestChainFeeAndMinTransferAmount(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom                  = types.GravityDenom(*myTokenContractAddr)
+		feeCollector           = input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		funds                  = sdk.NewCoins(sdk.NewInt64Coin(denom, 10000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, funds))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.ChainFee = types.ChainFee{BasisPoints: 25, FlatFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)}
+	params.MinTransferAmounts = []types.MinTransferAmount{{TokenContract: myTokenContractAddr.GetAddress().Hex(), Amount: sdk.NewInt(500)}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	balance := func(addr sdk.AccAddress, denom string) int64 {
+		return input.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+
+	// dust is rejected without charging anything
+	_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 499), sdk.NewInt64Coin(denom, 1))
+	require.ErrorIs(t, err, types.ErrTransferTooSmall)
+	require.Equal(t, int64(10000), balance(mySender, denom))
+	require.Equal(t, int64(100), balance(mySender, sdk.DefaultBondDenom))
+
+	// 0.25% of the amount, rounded down, and the flat fee go to the fee collector
+	id, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 1000), sdk.NewInt64Coin(denom, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), balance(feeCollector, denom))
+	require.Equal(t, int64(10), balance(feeCollector, sdk.DefaultBondDenom))
+	require.Equal(t, int64(10000-1000-1-2), balance(mySender, denom))
+	require.Equal(t, int64(90), balance(mySender, sdk.DefaultBondDenom))
+	tx, err := input.GravityKeeper.GetUnbatchedTxById(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000), tx.Erc20Token.Amount)
+
+	// the chain fee is not refunded by a cancel
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, id, mySender))
+	require.Equal(t, int64(10000-2), balance(mySender, denom))
+	require.Equal(t, int64(90), balance(mySender, sdk.DefaultBondDenom))
+
+	// a sender who cannot pay the chain fee cannot send, the failed tx is discarded as it would be on chain
+	params.ChainFee.FlatFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	input.GravityKeeper.SetParams(ctx, params)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = input.GravityKeeper.AddToOutgoingPool(cacheCtx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 1000), sdk.NewInt64Coin(denom, 1))
+	require.Error(t, err)
+}
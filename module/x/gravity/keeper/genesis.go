@@ -55,6 +55,12 @@ func initBridgeDataFromGenesis(ctx sdk.Context, k Keeper, data types.GenesisStat
 
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	// the bank module is initialized first, so the module account balances can be checked against the escrow
+	moduleBalances := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	if violations := data.ValidateConsistency(moduleBalances); len(violations) > 0 {
+		panic(violations)
+	}
+
 	k.SetParams(ctx, *data.Params)
 
 	// restore various nonces, this MUST match GravityNonces in genesis
@@ -65,6 +71,13 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetLastSlashedLogicCallBlock(ctx, data.GravityNonces.LastSlashedLogicCallBlock)
 	k.setID(ctx, data.GravityNonces.LastTxPoolId, []byte(types.KeyLastTXPoolID))
 	k.setID(ctx, data.GravityNonces.LastBatchId, []byte(types.KeyLastOutgoingBatchID))
+	k.SetLastUnBondingBlockHeight(ctx, data.GravityNonces.LastUnbondingBlockHeight)
+	k.setID(ctx, data.GravityNonces.LastMerkleAirdropId, types.LastMerkleAirdropIDKey)
+	k.setID(ctx, data.GravityNonces.LastExecutedBatchId, types.LastExecutedBatchIDKey)
+	k.setLastObservedEthereumBlockHeight(ctx, data.LastObservedEthereumBlockHeight)
+	if data.LastObservedValset != nil {
+		k.SetLastObservedValset(ctx, *data.LastObservedValset)
+	}
 
 	initBridgeDataFromGenesis(ctx, k, data)
 
@@ -124,15 +137,18 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
-	// reset delegate keys in state
-	if hasDuplicates(data.DelegateKeys) {
-		panic("Duplicate delegate key found in Genesis!")
-	}
-	for _, keys := range data.DelegateKeys {
-		err := keys.ValidateBasic()
+	// restore the exported event nonces of specific validators, which also covers validators whose
+	// attestations have all been pruned, genesis files without them keep the nonces reconstructed above
+	for _, last := range data.LastEventNoncesByValidator {
+		val, err := sdk.ValAddressFromBech32(last.Validator)
 		if err != nil {
-			panic("Invalid delegate key in Genesis!")
+			panic(sdkerrors.Wrapf(err, "invalid validator in last event nonces: %s", last.Validator))
 		}
+		k.SetLastEventNonceByValidator(ctx, val, last.Nonce)
+	}
+
+	// reset delegate keys in state
+	for _, keys := range data.DelegateKeys {
 		val, err := sdk.ValAddressFromBech32(keys.Validator)
 		if err != nil {
 			panic(err)
@@ -153,38 +169,109 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetEthAddressForValidator(ctx, val, *ethAddr)
 	}
 
-	// populate state with cosmos originated denom-erc20 mapping
+	// populate state with cosmos originated denom-erc20 mapping, deprecated ERC20s only map back to their denom
+	deprecated := make(map[string]bool, len(data.Erc20Migrations))
+	for _, migration := range data.Erc20Migrations {
+		oldErc20, err := types.NewEthAddress(migration.OldErc20)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid old erc20 in Erc20Migrations: %s", migration.OldErc20))
+		}
+		k.SetERC20Migration(ctx, migration)
+		deprecated[oldErc20.GetAddress().Hex()] = true
+	}
 	for i, item := range data.Erc20ToDenoms {
 		ethAddr, err := types.NewEthAddress(item.Erc20)
 		if err != nil {
 			panic(fmt.Errorf("invalid erc20 address in Erc20ToDenoms for item %d: %s", i, item.Erc20))
 		}
-		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, *ethAddr)
+		if deprecated[ethAddr.GetAddress().Hex()] {
+			k.setCosmosOriginatedERC20ToDenom(ctx, item.Denom, *ethAddr)
+		} else {
+			k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, *ethAddr)
+		}
+	}
+	for _, request := range data.Erc20DeploymentRequests {
+		k.SetERC20DeploymentRequest(ctx, request)
+	}
+
+	initBridgeHistoryFromGenesis(ctx, k, data)
+	initBridgeFlowsFromGenesis(ctx, k, data)
+}
+
+// initBridgeHistoryFromGenesis restores the records kept about past bridge activity, validator slashing
+// evidence, per validator signing obligations and the receipts of observed Ethereum transactions
+func initBridgeHistoryFromGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	for _, checkpoint := range data.PastEthSignatureCheckpoints {
+		k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
 	}
 
-	// now that we have the denom-erc20 mapping we need to validate
-	// that the valset reward is possible and cosmos originated remove
-	// this if you want a non-cosmos originated reward
-	valsetReward := k.GetParams(ctx).ValsetReward
-	if valsetReward.IsValid() && !valsetReward.IsZero() {
-		_, exists := k.GetCosmosOriginatedERC20(ctx, valsetReward.Denom)
-		if !exists {
-			panic("Invalid Cosmos originated denom for valset reward")
+	for _, bonded := range data.ValidatorBondedHeights {
+		val, err := sdk.ValAddressFromBech32(bonded.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid validator in bonded heights: %s", bonded.Validator))
 		}
+		k.SetValidatorBondedHeight(ctx, val, bonded.Height)
+	}
+	for _, registered := range data.DelegateKeyRegistrationHeights {
+		val, err := sdk.ValAddressFromBech32(registered.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid validator in delegate key registration heights: %s", registered.Validator))
+		}
+		k.SetDelegateKeyRegistrationHeight(ctx, val, registered.Height)
+	}
+	for _, event := range data.BridgeSlashingEvents {
+		k.storeBridgeSlashingEvent(ctx, event)
 	}
 
+	for _, receipt := range data.DepositReceipts {
+		k.storeDepositReceipt(ctx, receipt)
+	}
+	for _, execution := range data.BatchExecutions {
+		k.storeBatchExecution(ctx, execution)
+	}
+	for _, archived := range data.ExecutedBatches {
+		k.setExecutedBatch(ctx, archived.ArchiveId, archived.Batch)
+	}
 }
 
-func hasDuplicates(d []types.MsgSetOrchestratorAddress) bool {
-	ethMap := make(map[string]struct{}, len(d))
-	orchMap := make(map[string]struct{}, len(d))
-	// creates a hashmap then ensures that the hashmap and the array
-	// have the same length, this acts as an O(n) duplicates check
-	for i := range d {
-		ethMap[d[i].EthAddress] = struct{}{}
-		orchMap[d[i].Orchestrator] = struct{}{}
+// initBridgeFlowsFromGenesis restores funds which are in flight through the module, IBC auto forwards, rate
+// limited deposits and Merkle airdrops, along with the rate limit and circuit breaker state
+func initBridgeFlowsFromGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	for _, forward := range data.PendingIbcAutoForwards {
+		k.setPendingIbcAutoForward(ctx, forward)
+	}
+
+	initFlows := func(flowPrefix []byte, flows []types.RateLimitFlow) {
+		for _, flow := range flows {
+			tokenContract, err := types.NewEthAddress(flow.TokenContract)
+			if err != nil {
+				panic(sdkerrors.Wrapf(err, "invalid token contract in rate limit flows: %s", flow.TokenContract))
+			}
+			k.setRateLimitFlow(ctx, flowPrefix, *tokenContract, flow.Height, flow.Amount)
+		}
+	}
+	initFlows(types.RateLimitInflowKey, data.RateLimitInflows)
+	initFlows(types.RateLimitOutflowKey, data.RateLimitOutflows)
+	for _, claim := range data.RateLimitedDeposits {
+		k.setRateLimitedDeposit(ctx, claim)
+	}
+	for _, claim := range data.PausedDeposits {
+		k.setPausedDeposit(ctx, claim)
+	}
+	if data.CircuitBreakerTrip != nil {
+		k.setCircuitBreakerTrip(ctx, *data.CircuitBreakerTrip)
+	}
+
+	for _, airdrop := range data.MerkleAirdrops {
+		k.setMerkleAirdrop(ctx, airdrop)
+	}
+	for _, claim := range data.AirdropClaims {
+		claimer, err := sdk.AccAddressFromBech32(claim.Claimer)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid claimer in airdrop claims: %s", claim.Claimer))
+		}
+		k.setAirdropClaim(ctx, claim.AirdropId, claimer)
 	}
-	return len(ethMap) != len(d) || len(orchMap) != len(d)
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -244,7 +331,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		unbatchedTxs[i] = v.ToExternal()
 	}
 
-	return types.GenesisState{
+	forwards := []types.PendingIbcAutoForward{}
+	for _, forward := range k.PendingIbcAutoForwards(ctx, 0) {
+		forwards = append(forwards, *forward)
+	}
+
+	state := types.GenesisState{
 		Params: &p,
 		GravityNonces: types.GravityNonces{
 			LatestValsetNonce:         k.GetLatestValsetNonce(ctx),
@@ -254,16 +346,127 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 			LastSlashedLogicCallBlock: k.GetLastSlashedLogicCallBlock(ctx),
 			LastTxPoolId:              k.getID(ctx, types.KeyLastTXPoolID),
 			LastBatchId:               k.getID(ctx, types.KeyLastOutgoingBatchID),
+			LastUnbondingBlockHeight:  k.GetLastUnBondingBlockHeight(ctx),
+			LastMerkleAirdropId:       k.getIDOrZero(ctx, types.LastMerkleAirdropIDKey),
+			LastExecutedBatchId:       k.getIDOrZero(ctx, types.LastExecutedBatchIDKey),
 		},
-		Valsets:            valsets,
-		ValsetConfirms:     vsconfs,
-		Batches:            extBatches,
-		BatchConfirms:      batchconfs,
-		LogicCalls:         calls,
-		LogicCallConfirms:  callconfs,
-		Attestations:       attestations,
-		DelegateKeys:       delegates,
-		Erc20ToDenoms:      erc20ToDenoms,
-		UnbatchedTransfers: unbatchedTxs,
+		Valsets:                         valsets,
+		ValsetConfirms:                  vsconfs,
+		Batches:                         extBatches,
+		BatchConfirms:                   batchconfs,
+		LogicCalls:                      calls,
+		LogicCallConfirms:               callconfs,
+		Attestations:                    attestations,
+		DelegateKeys:                    delegates,
+		Erc20ToDenoms:                   erc20ToDenoms,
+		UnbatchedTransfers:              unbatchedTxs,
+		PendingIbcAutoForwards:          forwards,
+		LastObservedEthereumBlockHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+		LastObservedValset:              k.GetLastObservedValset(ctx),
+	}
+	if trip, tripped := k.GetCircuitBreakerTrip(ctx); tripped {
+		state.CircuitBreakerTrip = &trip
 	}
+	exportBridgeHistory(ctx, k, &state)
+	exportBridgeFlows(ctx, k, &state)
+
+	return state
+}
+
+// exportBridgeHistory exports the records kept about past bridge activity, the counterpart of
+// initBridgeHistoryFromGenesis
+func exportBridgeHistory(ctx sdk.Context, k Keeper, state *types.GenesisState) {
+	state.PastEthSignatureCheckpoints = [][]byte{}
+	k.IteratePastEthSignatureCheckpoints(ctx, func(checkpoint []byte) bool {
+		state.PastEthSignatureCheckpoints = append(state.PastEthSignatureCheckpoints, checkpoint)
+		return false
+	})
+
+	state.LastEventNoncesByValidator = []types.ValidatorNonce{}
+	k.IterateLastEventNonceByValidator(ctx, func(validator sdk.ValAddress, nonce uint64) bool {
+		state.LastEventNoncesByValidator = append(state.LastEventNoncesByValidator,
+			types.ValidatorNonce{Validator: validator.String(), Nonce: nonce})
+		return false
+	})
+	state.ValidatorBondedHeights = []types.ValidatorHeight{}
+	k.IterateValidatorBondedHeights(ctx, func(validator sdk.ValAddress, height uint64) bool {
+		state.ValidatorBondedHeights = append(state.ValidatorBondedHeights,
+			types.ValidatorHeight{Validator: validator.String(), Height: height})
+		return false
+	})
+	state.DelegateKeyRegistrationHeights = []types.ValidatorHeight{}
+	k.IterateDelegateKeyRegistrationHeights(ctx, func(validator sdk.ValAddress, height uint64) bool {
+		state.DelegateKeyRegistrationHeights = append(state.DelegateKeyRegistrationHeights,
+			types.ValidatorHeight{Validator: validator.String(), Height: height})
+		return false
+	})
+	state.BridgeSlashingEvents = []types.BridgeSlashingEvent{}
+	k.IterateBridgeSlashingEvents(ctx, func(event types.BridgeSlashingEvent) bool {
+		state.BridgeSlashingEvents = append(state.BridgeSlashingEvents, event)
+		return false
+	})
+
+	state.Erc20DeploymentRequests = []types.ERC20DeploymentRequest{}
+	k.IterateERC20DeploymentRequests(ctx, func(request types.ERC20DeploymentRequest) bool {
+		state.Erc20DeploymentRequests = append(state.Erc20DeploymentRequests, request)
+		return false
+	})
+	state.Erc20Migrations = []types.ERC20Migration{}
+	k.IterateERC20Migrations(ctx, func(migration types.ERC20Migration) bool {
+		state.Erc20Migrations = append(state.Erc20Migrations, migration)
+		return false
+	})
+
+	state.DepositReceipts = []types.DepositReceipt{}
+	k.IterateDepositReceipts(ctx, func(receipt types.DepositReceipt) bool {
+		state.DepositReceipts = append(state.DepositReceipts, receipt)
+		return false
+	})
+	state.BatchExecutions = []types.BatchExecution{}
+	k.IterateBatchExecutions(ctx, func(execution types.BatchExecution) bool {
+		state.BatchExecutions = append(state.BatchExecutions, execution)
+		return false
+	})
+	state.ExecutedBatches = []types.ArchivedBatch{}
+	k.IterateExecutedBatches(ctx, func(archiveID uint64, executed types.ExecutedBatch) bool {
+		state.ExecutedBatches = append(state.ExecutedBatches, types.ArchivedBatch{ArchiveId: archiveID, Batch: executed})
+		return false
+	})
+}
+
+// exportBridgeFlows exports the rate limit flows, rate limited deposits and Merkle airdrops, the counterpart
+// of initBridgeFlowsFromGenesis
+func exportBridgeFlows(ctx sdk.Context, k Keeper, state *types.GenesisState) {
+	exportFlows := func(flowPrefix []byte) []types.RateLimitFlow {
+		flows := []types.RateLimitFlow{}
+		k.IterateRateLimitFlows(ctx, flowPrefix, func(tokenContract types.EthAddress, height uint64, amount sdk.Int) bool {
+			flows = append(flows, types.RateLimitFlow{TokenContract: tokenContract.GetAddress().Hex(), Height: height, Amount: amount})
+			return false
+		})
+		return flows
+	}
+	state.RateLimitInflows = exportFlows(types.RateLimitInflowKey)
+	state.RateLimitOutflows = exportFlows(types.RateLimitOutflowKey)
+
+	state.RateLimitedDeposits = []types.MsgSendToCosmosClaim{}
+	k.IterateRateLimitedDeposits(ctx, func(claim types.MsgSendToCosmosClaim) bool {
+		state.RateLimitedDeposits = append(state.RateLimitedDeposits, claim)
+		return false
+	})
+	state.PausedDeposits = []types.MsgSendToCosmosClaim{}
+	k.IteratePausedDeposits(ctx, func(claim types.MsgSendToCosmosClaim) bool {
+		state.PausedDeposits = append(state.PausedDeposits, claim)
+		return false
+	})
+
+	state.MerkleAirdrops = []types.MerkleAirdrop{}
+	k.IterateMerkleAirdrops(ctx, func(airdrop types.MerkleAirdrop) bool {
+		state.MerkleAirdrops = append(state.MerkleAirdrops, airdrop)
+		return false
+	})
+	state.AirdropClaims = []types.AirdropClaimRecord{}
+	k.IterateAirdropClaims(ctx, func(id uint64, claimer sdk.AccAddress) bool {
+		state.AirdropClaims = append(state.AirdropClaims, types.AirdropClaimRecord{AirdropId: id, Claimer: claimer.String()})
+		return false
+	})
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
@@ -36,6 +37,26 @@ func RegisterProposalTypes() {
 		govtypes.RegisterProposalType(types.ProposalTypeAirdrop)
 		govtypes.RegisterProposalTypeCodec(&types.AirdropProposal{}, airdrop)
 	}
+	erc20Metadata := "gravity/ERC20Metadata"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(erc20Metadata, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeERC20Metadata)
+		govtypes.RegisterProposalTypeCodec(&types.ERC20MetadataProposal{}, erc20Metadata)
+	}
+	erc20Deployment := "gravity/RequestERC20Deployment"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(erc20Deployment, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeRequestERC20Deployment)
+		govtypes.RegisterProposalTypeCodec(&types.RequestERC20DeploymentProposal{}, erc20Deployment)
+	}
+	airdropV2 := "gravity/AirdropV2"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(airdropV2, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeAirdropV2)
+		govtypes.RegisterProposalTypeCodec(&types.AirdropV2Proposal{}, airdropV2)
+	}
+	deprecateErc20 := "gravity/DeprecateERC20"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(deprecateErc20, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeDeprecateERC20)
+		govtypes.RegisterProposalTypeCodec(&types.DeprecateERC20Proposal{}, deprecateErc20)
+	}
 }
 
 func NewGravityProposalHandler(k Keeper) govtypes.Handler {
@@ -45,8 +66,16 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 			return k.HandleUnhaltBridgeProposal(ctx, c)
 		case *types.AirdropProposal:
 			return k.HandleAirdropProposal(ctx, c)
+		case *types.AirdropV2Proposal:
+			return k.HandleAirdropV2Proposal(ctx, c)
 		case *types.IBCMetadataProposal:
 			return k.HandleIBCMetadataProposal(ctx, c)
+		case *types.ERC20MetadataProposal:
+			return k.HandleERC20MetadataProposal(ctx, c)
+		case *types.RequestERC20DeploymentProposal:
+			return k.HandleRequestERC20DeploymentProposal(ctx, c)
+		case *types.DeprecateERC20Proposal:
+			return k.HandleDeprecateERC20Proposal(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized Gravity proposal content type: %T", c)
@@ -60,61 +89,176 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 // history, we roll back oracle history and reset the parameters
 func (k Keeper) HandleUnhaltBridgeProposal(ctx sdk.Context, p *types.UnhaltBridgeProposal) error {
 	ctx.Logger().Info("Gov vote passed: Resetting oracle history", "nonce", p.TargetNonce)
-	pruneAttestationsAfterNonce(ctx, k, p.TargetNonce)
+	if err := pruneAttestationsAfterNonce(ctx, k, p.TargetNonce); err != nil {
+		return err
+	}
+	if k.resetCircuitBreaker(ctx) {
+		ctx.Logger().Info("Gov vote passed: Resuming bridge halted by the circuit breaker")
+	}
 	return nil
 }
 
 // Iterate over all attestations currently being voted on in order of nonce
-// and prune those that are older than nonceCutoff
-func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64) {
+// and prune those that are older than nonceCutoff, returns an error without
+// pruning anything if nonceCutoff is before the last observed event
+func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64) error {
 	// Decide on the most recent nonce we can actually roll back to
+	if err := validateUnhaltNonce(ctx, k, nonceCutoff); err != nil {
+		ctx.Logger().Error("Attempted to reset to a nonce before the last \"observed\" event, which is not allowed", "lastObserved", k.GetLastObservedEventNonce(ctx), "nonce", nonceCutoff)
+		return err
+	}
+
+	attestations, resets := attestationsAfterNonce(ctx, k, nonceCutoff)
+
+	// Delete all reverted attestations
+	for _, att := range attestations {
+		ctx.Logger().Info(fmt.Sprintf("Deleting attestation at height %v", att.Height))
+		k.DeleteAttestation(ctx, att)
+	}
+
+	// Reset the last event nonce for all validators affected by history deletion
+	for _, reset := range resets {
+		val, _ := sdk.ValAddressFromBech32(reset.Validator)
+		ctx.Logger().Info("Resetting validator's last event nonce due to bridge unhalt", "validator", reset.Validator, "lastEventNonce", reset.LastEventNonce, "resetNonce", nonceCutoff)
+		k.SetLastEventNonceByValidator(ctx, val, nonceCutoff)
+	}
+	return nil
+}
+
+// validateUnhaltNonce checks that nonceCutoff does not roll back past the last observed event
+func validateUnhaltNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64) error {
 	lastObserved := k.GetLastObservedEventNonce(ctx)
 	if nonceCutoff < lastObserved || nonceCutoff == 0 {
-		ctx.Logger().Error("Attempted to reset to a nonce before the last \"observed\" event, which is not allowed", "lastObserved", lastObserved, "nonce", nonceCutoff)
-		return
+		return sdkerrors.Wrapf(types.ErrInvalid, "cannot reset to nonce %d before the last observed event %d", nonceCutoff, lastObserved)
 	}
+	return nil
+}
 
+// attestationsAfterNonce returns the attestations an unhalt to nonceCutoff deletes, in order of nonce, and the
+// validators who voted on any of them whose last event nonce must be reset, in order of validator address
+func attestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64) (attestations []types.Attestation, resets []types.OrchestratorNonceReset) {
 	// Get relevant event nonces
 	attmap, keys := k.GetAttestationMapping(ctx)
 
 	// Discover all affected validators whose LastEventNonce must be reset to nonceCutoff
-
-	numValidators := len(k.StakingKeeper.GetBondedValidatorsByPower(ctx))
-	// void and setMember are necessary for sets to work
-	type void struct{}
-	var setMember void
-	// Initialize a Set of validators
-	affectedValidatorsSet := make(map[string]void, numValidators)
-
-	// Delete all reverted attestations, keeping track of the validators who attested to any of them
+	affectedValidators := make(map[string]bool)
 	for _, nonce := range keys {
+		// we delete all attestations after the cutoff event nonce
+		if nonce <= nonceCutoff {
+			continue
+		}
 		for _, att := range attmap[nonce] {
-			// we delete all attestations earlier than the cutoff event nonce
-			if nonce > nonceCutoff {
-				ctx.Logger().Info(fmt.Sprintf("Deleting attestation at height %v", att.Height))
-				for _, vote := range att.Votes {
-					if _, ok := affectedValidatorsSet[vote]; !ok { // if set does not contain vote
-						affectedValidatorsSet[vote] = setMember // add key to set
-					}
-				}
-
-				k.DeleteAttestation(ctx, att)
+			for _, vote := range att.Votes {
+				affectedValidators[vote] = true
 			}
+			attestations = append(attestations, att)
 		}
 	}
 
-	// Reset the last event nonce for all validators affected by history deletion
-	for vote := range affectedValidatorsSet {
+	votes := make([]string, 0, len(affectedValidators))
+	for vote := range affectedValidators {
+		votes = append(votes, vote)
+	}
+	sort.Strings(votes)
+	for _, vote := range votes {
 		val, err := sdk.ValAddressFromBech32(vote)
 		if err != nil {
 			panic(sdkerrors.Wrap(err, "invalid validator address affected by bridge reset"))
 		}
 		valLastNonce := k.GetLastEventNonceByValidator(ctx, val)
-		if valLastNonce > nonceCutoff {
-			ctx.Logger().Info("Resetting validator's last event nonce due to bridge unhalt", "validator", vote, "lastEventNonce", valLastNonce, "resetNonce", nonceCutoff)
-			k.SetLastEventNonceByValidator(ctx, val, nonceCutoff)
+		if valLastNonce <= nonceCutoff {
+			continue
 		}
+		reset := types.OrchestratorNonceReset{
+			Validator:      vote,
+			LastEventNonce: valLastNonce,
+			ResetNonce:     nonceCutoff,
+		}
+		if orch, found := k.GetOrchestratorAddressByValidator(ctx, val); found {
+			reset.Orchestrator = orch.String()
+		}
+		resets = append(resets, reset)
 	}
+	return attestations, resets
+}
+
+// SimulateUnhaltBridge reports what an UnhaltBridgeProposal with the given TargetNonce would do, without
+// changing any state, so that voters can see the consequences of the proposal before voting
+func (k Keeper) SimulateUnhaltBridge(ctx sdk.Context, targetNonce uint64) (*types.QueryUnhaltBridgeImpactResponse, error) {
+	if err := validateUnhaltNonce(ctx, k, targetNonce); err != nil {
+		return nil, err
+	}
+	attestations, resets := attestationsAfterNonce(ctx, k, targetNonce)
+	_, tripped := k.GetCircuitBreakerTrip(ctx)
+	impact := &types.QueryUnhaltBridgeImpactResponse{
+		LastObservedNonce:      k.GetLastObservedEventNonce(ctx),
+		DeletedAttestations:    attestations,
+		ResetOrchestrators:     resets,
+		DelayedIbcAutoForwards: []types.PendingIbcAutoForward{},
+		AffectedBatches:        []types.OutgoingTxBatch{},
+		ResetsCircuitBreaker:   tripped,
+	}
+	if impact.DeletedAttestations == nil {
+		impact.DeletedAttestations = []types.Attestation{}
+	}
+	if impact.ResetOrchestrators == nil {
+		impact.ResetOrchestrators = []types.OrchestratorNonceReset{}
+	}
+
+	seenBatches := make(map[string]bool)
+	for _, att := range attestations {
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to unpack attestation claim")
+		}
+		switch claim := claim.(type) {
+		case *types.MsgSendToCosmosClaim:
+			if forward, ok := k.ibcAutoForwardOf(ctx, *claim); ok {
+				impact.DelayedIbcAutoForwards = append(impact.DelayedIbcAutoForwards, forward)
+			}
+		case *types.MsgBatchSendToEthClaim:
+			tokenContract, err := types.NewEthAddress(claim.TokenContract)
+			if err != nil {
+				continue
+			}
+			batch := k.GetOutgoingTXBatch(ctx, *tokenContract, claim.BatchNonce)
+			key := fmt.Sprintf("%s/%d", tokenContract.GetAddress().Hex(), claim.BatchNonce)
+			if batch != nil && !seenBatches[key] {
+				seenBatches[key] = true
+				impact.AffectedBatches = append(impact.AffectedBatches, batch.ToExternal())
+			}
+		}
+	}
+	return impact, nil
+}
+
+// ibcAutoForwardOf returns the IBC auto-forward a deposit would queue when observed, if its receiver has a
+// registered foreign prefix
+func (k Keeper) ibcAutoForwardOf(ctx sdk.Context, claim types.MsgSendToCosmosClaim) (types.PendingIbcAutoForward, bool) {
+	accountPrefix, err := types.GetPrefixFromBech32(claim.CosmosReceiver)
+	if err != nil {
+		return types.PendingIbcAutoForward{}, false
+	}
+	nativePrefix, err := k.bech32IbcKeeper.GetNativeHrp(ctx)
+	if err != nil || accountPrefix == nativePrefix {
+		return types.PendingIbcAutoForward{}, false
+	}
+	hrpIbcRecord, err := k.bech32IbcKeeper.GetHrpIbcRecord(ctx, accountPrefix)
+	if err != nil {
+		return types.PendingIbcAutoForward{}, false
+	}
+	tokenContract, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		return types.PendingIbcAutoForward{}, false
+	}
+	_, denom := k.ERC20ToDenomLookup(ctx, *tokenContract)
+	coin := sdk.NewCoin(denom, claim.Amount)
+	return types.PendingIbcAutoForward{
+		ForeignReceiver: claim.CosmosReceiver,
+		Token:           &coin,
+		IbcChannel:      hrpIbcRecord.SourceChannel,
+		EventNonce:      claim.EventNonce,
+	}, true
 }
 
 // Allows governance to deploy an airdrop to a provided list of addresses
@@ -181,7 +325,7 @@ func (k Keeper) HandleAirdropProposal(ctx sdk.Context, p *types.AirdropProposal)
 		} else {
 			// return an err to prevent execution from finishing, this will prevent the changes we
 			// have made so far from taking effect the governance proposal will instead time out
-			ctx.Logger().Info("invalid address in airdrop! not executing", "address", addr)
+			ctx.Logger().Info("airdrop transfer failed! not executing", "address", addr, "cause", err.Error())
 			return err
 		}
 	}
@@ -208,6 +352,43 @@ func (k Keeper) HandleAirdropProposal(ctx sdk.Context, p *types.AirdropProposal)
 	return nil
 }
 
+// HandleAirdropV2Proposal pays listed recipients directly from the community pool, or for a proposal with a
+// Merkle root sets the total aside in the gravity module for recipients to claim with MsgClaimAirdrop
+func (k Keeper) HandleAirdropV2Proposal(ctx sdk.Context, p *types.AirdropV2Proposal) error {
+	ctx.Logger().Info("Gov vote passed: Performing airdrop v2")
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if p.MerkleRoot != "" {
+		airdrop, err := k.createMerkleAirdrop(ctx, p.MerkleRoot, p.Total, p.ClaimWindow)
+		if err != nil {
+			ctx.Logger().Info("Airdrop failed to execute", "cause", err.Error())
+			return err
+		}
+		ctx.Logger().Info("Merkle airdrop created", "id", airdrop.Id, "total", airdrop.Total.String(), "endHeight", airdrop.EndHeight)
+		return nil
+	}
+
+	total := p.TotalAmount()
+	if err := k.withdrawFromCommunityPool(ctx, total); err != nil {
+		ctx.Logger().Info("Airdrop failed to execute insufficient tokens in the community pool!")
+		return err
+	}
+	for _, r := range p.Recipients {
+		addr, err := sdk.AccAddressFromBech32(r.Address)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalid, "invalid recipient")
+		}
+		// returning an error prevents any of the changes made so far from taking effect
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, r.Amount); err != nil {
+			ctx.Logger().Info("airdrop transfer failed! not executing", "address", addr, "cause", err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
 // handles a governance proposal for setting the metadata of an IBC token, this takes the normal
 // metadata struct with one key difference, the base unit must be set as the ibc path string in order
 // for setting the denom metadata to work.
@@ -250,3 +431,119 @@ func (k Keeper) HandleIBCMetadataProposal(ctx sdk.Context, p *types.IBCMetadataP
 
 	return nil
 }
+
+// handles a governance proposal for setting or overriding the metadata of an Ethereum originated voucher,
+// normally this metadata is set from the ERC20 details in the first deposit of the token but those details
+// may be missing or wrong. The base unit must be the gravity denom of the token contract
+func (k Keeper) HandleERC20MetadataProposal(ctx sdk.Context, p *types.ERC20MetadataProposal) error {
+	ctx.Logger().Info("Gov vote passed: Setting ERC20 Metadata", "contract", p.TokenContract)
+
+	tokenContract, err := types.NewEthAddress(p.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract")
+	}
+
+	// Cosmos originated assets already have metadata, which was used to deploy their ERC20
+	if _, isCosmosOriginated := k.GetCosmosOriginatedDenom(ctx, *tokenContract); isCosmosOriginated {
+		ctx.Logger().Info("invalid token contract for metadata proposal, token is Cosmos originated", "contract", p.TokenContract)
+		return sdkerrors.Wrap(types.ErrInvalid, "Target token is not an Ethereum originated asset")
+	}
+
+	denom := types.GravityDenom(*tokenContract)
+	if p.Metadata.Base != denom {
+		ctx.Logger().Info("invalid metadata for metadata proposal must be the same as the gravity denom", "base", p.Metadata.Base)
+		return sdkerrors.Wrap(types.ErrInvalid, "Metadata base must be the same as the gravity denom!")
+	}
+
+	// outsource validating this to the bank validation function
+	if err := p.Metadata.Validate(); err != nil {
+		ctx.Logger().Info("invalid metadata for metadata proposal", "validation error", err)
+		return sdkerrors.Wrap(err, "Invalid metadata")
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, p.Metadata)
+
+	return nil
+}
+
+// handles a governance proposal whitelisting a Cosmos originated denom for ERC20 deployment. The denom must
+// already have bank metadata, since that is what the deployed ERC20 will be checked against, and must not
+// already have a canonical ERC20 or an outstanding deployment request
+func (k Keeper) HandleRequestERC20DeploymentProposal(ctx sdk.Context, p *types.RequestERC20DeploymentProposal) error {
+	ctx.Logger().Info("Gov vote passed: Requesting ERC20 deployment", "denom", p.Denom)
+
+	if _, err := types.GravityDenomToERC20(p.Denom); err == nil {
+		ctx.Logger().Info("invalid denom for erc20 deployment proposal, denom is Ethereum originated", "denom", p.Denom)
+		return sdkerrors.Wrap(types.ErrInvalid, "Target denom is not a Cosmos originated asset")
+	}
+
+	metadata, metadataExists := k.bankKeeper.GetDenomMetaData(ctx, p.Denom)
+	if !metadataExists || metadata.Base == "" {
+		ctx.Logger().Info("invalid erc20 deployment proposal, denom has no metadata", "denom", p.Denom)
+		return sdkerrors.Wrap(types.ErrInvalid, "Metadata must be set before an ERC20 deployment can be requested")
+	}
+
+	if existingERC20, exists := k.GetCosmosOriginatedERC20(ctx, p.Denom); exists {
+		ctx.Logger().Info("invalid erc20 deployment proposal, erc20 already exists", "erc20", existingERC20.GetAddress().Hex())
+		return sdkerrors.Wrap(types.ErrInvalid, "ERC20 has already been deployed for this denom")
+	}
+
+	if _, exists := k.GetERC20DeploymentRequest(ctx, p.Denom); exists {
+		ctx.Logger().Info("invalid erc20 deployment proposal, deployment already requested", "denom", p.Denom)
+		return sdkerrors.Wrap(types.ErrDuplicate, "ERC20 deployment has already been requested for this denom")
+	}
+
+	k.SetERC20DeploymentRequest(ctx, types.ERC20DeploymentRequest{
+		Denom:          p.Denom,
+		ApprovedHeight: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// handles a governance proposal deprecating the ERC20 representation of a Cosmos originated denom. New transfers
+// to the old contract are refused, unbatched transfers to it are refunded, deposits from it are honored until the
+// migration window closes and a new deployment request is created so a replacement ERC20 can be deployed
+func (k Keeper) HandleDeprecateERC20Proposal(ctx sdk.Context, p *types.DeprecateERC20Proposal) error {
+	ctx.Logger().Info("Gov vote passed: Deprecating ERC20", "denom", p.Denom)
+
+	oldErc20, exists := k.GetCosmosOriginatedERC20(ctx, p.Denom)
+	if !exists {
+		ctx.Logger().Info("invalid deprecate erc20 proposal, no erc20 for denom", "denom", p.Denom)
+		return sdkerrors.Wrap(types.ErrInvalid, "Target denom does not have a Cosmos originated ERC20")
+	}
+	if p.MigrationWindow == 0 {
+		return sdkerrors.Wrap(types.ErrInvalid, "Migration window must be at least one block")
+	}
+
+	// refund any transfers which have not been batched yet, they can't be batched once the mapping is removed
+	for _, tx := range k.GetUnbatchedTransactionsByContract(ctx, *oldErc20) {
+		if err := k.RemoveFromOutgoingPoolAndRefund(ctx, tx.Id, tx.Sender); err != nil {
+			return sdkerrors.Wrapf(err, "unable to refund tx %d", tx.Id)
+		}
+	}
+
+	k.deprecateCosmosOriginatedERC20(ctx, p.Denom)
+
+	migration := types.ERC20Migration{
+		Denom:       p.Denom,
+		OldErc20:    oldErc20.GetAddress().Hex(),
+		StartHeight: uint64(ctx.BlockHeight()),
+		EndHeight:   uint64(ctx.BlockHeight()) + p.MigrationWindow,
+	}
+	k.SetERC20Migration(ctx, migration)
+
+	// the replacement must be requested like any other deployment, overwriting the completed request
+	k.SetERC20DeploymentRequest(ctx, types.ERC20DeploymentRequest{
+		Denom:          p.Denom,
+		ApprovedHeight: uint64(ctx.BlockHeight()),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventERC20Deprecated{
+			Denom:     p.Denom,
+			OldToken:  migration.OldErc20,
+			EndHeight: fmt.Sprint(migration.EndHeight),
+		},
+	)
+}
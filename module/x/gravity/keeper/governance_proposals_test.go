@@ -4,9 +4,13 @@ import (
 	"testing"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/gogo/protobuf/proto"
+	bech32ibctypes "github.com/osmosis-labs/bech32-ibc/x/bech32ibc/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -191,3 +195,192 @@ func TestIBCMetadataProposal(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+func TestERC20MetadataProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	tokenContract, err := types.NewEthAddress("0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e")
+	require.NoError(t, err)
+	denom := types.GravityDenom(*tokenContract)
+	goodProposal := types.ERC20MetadataProposal{
+		Title:         "test tile",
+		Description:   "test description",
+		TokenContract: tokenContract.GetAddress().Hex(),
+		Metadata:      types.GravityDenomMetadata(*tokenContract, "Yearn", "YFI", 18),
+	}
+	require.NoError(t, goodProposal.ValidateBasic())
+
+	gk := input.GravityKeeper
+
+	// wrong metadata from a deposit is overridden
+	gk.bankKeeper.SetDenomMetaData(ctx, types.GravityDenomMetadata(*tokenContract, "Wrong", "WRONG", 6))
+	err = gk.HandleERC20MetadataProposal(ctx, &goodProposal)
+	require.NoError(t, err)
+	metadata, exists := gk.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, exists)
+	require.Equal(t, goodProposal.Metadata, metadata)
+
+	// base unit must be the gravity denom
+	badProposal := goodProposal
+	badProposal.Metadata = types.GravityDenomMetadata(*tokenContract, "Yearn", "YFI", 18)
+	badProposal.Metadata.Base = "yfi"
+	require.Error(t, badProposal.ValidateBasic())
+	require.Error(t, gk.HandleERC20MetadataProposal(ctx, &badProposal))
+
+	// Cosmos originated tokens can not be changed with this proposal
+	gk.setCosmosOriginatedDenomToERC20(ctx, "ugraviton", *tokenContract)
+	require.Error(t, gk.HandleERC20MetadataProposal(ctx, &goodProposal))
+}
+
+func TestRequestERC20DeploymentProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	proposal := types.RequestERC20DeploymentProposal{
+		Title:       "test tile",
+		Description: "test description",
+		Denom:       "ustake",
+	}
+	require.NoError(t, proposal.ValidateBasic())
+
+	// metadata must be set first
+	require.Error(t, gk.HandleRequestERC20DeploymentProposal(ctx, &proposal))
+	gk.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "stake",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ustake", Exponent: 0},
+			{Denom: "stake", Exponent: 6},
+		},
+		Base:    "ustake",
+		Display: "stake",
+		Name:    "Stake",
+		Symbol:  "STAKE",
+	})
+	require.NoError(t, gk.HandleRequestERC20DeploymentProposal(ctx, &proposal))
+
+	// can't be requested twice
+	require.Error(t, gk.HandleRequestERC20DeploymentProposal(ctx, &proposal))
+
+	res, err := gk.ERC20DeploymentRequests(sdk.WrapSDKContext(ctx), &types.QueryERC20DeploymentRequestsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ERC20DeploymentRequest{{Denom: "ustake", ApprovedHeight: uint64(ctx.BlockHeight())}}, res.Pending)
+	require.Empty(t, res.Completed)
+
+	// Ethereum originated vouchers can't be requested
+	voucher := proposal
+	voucherContract, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	voucher.Denom = types.GravityDenom(*voucherContract)
+	require.Error(t, voucher.ValidateBasic())
+	require.Error(t, gk.HandleRequestERC20DeploymentProposal(ctx, &voucher))
+
+	// denoms which already have an ERC20 can't be requested
+	deployed := proposal
+	deployed.Denom = "ugraviton"
+	gk.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ugraviton", Exponent: 0}},
+		Base:       "ugraviton",
+		Display:    "ugraviton",
+	})
+	deployedContract, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	gk.setCosmosOriginatedDenomToERC20(ctx, "ugraviton", *deployedContract)
+	require.Error(t, gk.HandleRequestERC20DeploymentProposal(ctx, &deployed))
+}
+
+// Tests that simulating an UnhaltBridgeProposal reports its consequences without changing any state
+func TestSimulateUnhaltBridge(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	token, err := types.NewEthAddress(tokenContract)
+	require.NoError(t, err)
+
+	k.bech32IbcKeeper.SetHrpIbcRecords(ctx, []bech32ibctypes.HrpIbcRecord{{Hrp: "cosmos", SourceChannel: "channel-0"}})
+	foreignReceiver, err := bech32.ConvertAndEncode("cosmos", AccAddrs[0])
+	require.NoError(t, err)
+
+	k.setLastObservedEventNonce(ctx, 1)
+	k.StoreBatch(ctx, types.InternalOutgoingTxBatch{BatchNonce: 5, BatchTimeout: 1000, TokenContract: *token})
+	k.setID(ctx, 5, types.KeyLastOutgoingBatchID)
+
+	attest := func(claim types.EthereumClaim, voters ...sdk.ValAddress) {
+		any, err := codectypes.NewAnyWithValue(claim.(proto.Message))
+		require.NoError(t, err)
+		att := &types.Attestation{Height: uint64(ctx.BlockHeight()), Claim: any}
+		for _, val := range voters {
+			att.Votes = append(att.Votes, val.String())
+			if k.GetLastEventNonceByValidator(ctx, val) < claim.GetEventNonce() {
+				k.SetLastEventNonceByValidator(ctx, val, claim.GetEventNonce())
+			}
+		}
+		hash, err := claim.ClaimHash()
+		require.NoError(t, err)
+		k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
+	}
+	attest(&types.MsgSendToCosmosClaim{
+		EventNonce:     2,
+		BlockHeight:    10,
+		TokenContract:  tokenContract,
+		Amount:         sdk.NewInt(100),
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: foreignReceiver,
+	}, ValAddrs[0], ValAddrs[1])
+	attest(&types.MsgBatchSendToEthClaim{
+		EventNonce:    3,
+		BlockHeight:   11,
+		BatchNonce:    5,
+		TokenContract: tokenContract,
+	}, ValAddrs[0])
+
+	// resetting to before the last observed event is not allowed
+	_, err = k.UnhaltBridgeImpact(sdk.WrapSDKContext(ctx), &types.QueryUnhaltBridgeImpactRequest{TargetNonce: 0})
+	require.Error(t, err)
+
+	impact, err := k.UnhaltBridgeImpact(sdk.WrapSDKContext(ctx), &types.QueryUnhaltBridgeImpactRequest{TargetNonce: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), impact.LastObservedNonce)
+	require.Len(t, impact.DeletedAttestations, 2)
+	require.Len(t, impact.ResetOrchestrators, 2)
+	for _, reset := range impact.ResetOrchestrators {
+		require.Equal(t, uint64(1), reset.ResetNonce)
+		require.NotEmpty(t, reset.Orchestrator)
+		if reset.Validator == ValAddrs[0].String() {
+			require.Equal(t, uint64(3), reset.LastEventNonce)
+		} else {
+			require.Equal(t, ValAddrs[1].String(), reset.Validator)
+			require.Equal(t, uint64(2), reset.LastEventNonce)
+		}
+	}
+	require.Len(t, impact.DelayedIbcAutoForwards, 1)
+	require.Equal(t, foreignReceiver, impact.DelayedIbcAutoForwards[0].ForeignReceiver)
+	require.Equal(t, "channel-0", impact.DelayedIbcAutoForwards[0].IbcChannel)
+	require.Equal(t, sdk.NewInt(100), impact.DelayedIbcAutoForwards[0].Token.Amount)
+	require.Len(t, impact.AffectedBatches, 1)
+	require.Equal(t, uint64(5), impact.AffectedBatches[0].BatchNonce)
+	require.False(t, impact.ResetsCircuitBreaker)
+
+	// keeping the deposit only affects the batch claim and its voter
+	impact, err = k.UnhaltBridgeImpact(sdk.WrapSDKContext(ctx), &types.QueryUnhaltBridgeImpactRequest{TargetNonce: 2})
+	require.NoError(t, err)
+	require.Len(t, impact.DeletedAttestations, 1)
+	require.Len(t, impact.ResetOrchestrators, 1)
+	require.Empty(t, impact.DelayedIbcAutoForwards)
+	require.Len(t, impact.AffectedBatches, 1)
+
+	// the simulation did not change any state, the proposal itself does exactly what was reported
+	require.Equal(t, uint64(3), k.GetLastEventNonceByValidator(ctx, ValAddrs[0]))
+	_, keys := k.GetAttestationMapping(ctx)
+	require.Equal(t, []uint64{2, 3}, keys)
+	require.NoError(t, k.HandleUnhaltBridgeProposal(ctx, &types.UnhaltBridgeProposal{Title: "Unhalt", Description: "Reset", TargetNonce: 1}))
+	_, keys = k.GetAttestationMapping(ctx)
+	require.Empty(t, keys)
+	require.Equal(t, uint64(1), k.GetLastEventNonceByValidator(ctx, ValAddrs[0]))
+	require.Equal(t, uint64(1), k.GetLastEventNonceByValidator(ctx, ValAddrs[1]))
+}
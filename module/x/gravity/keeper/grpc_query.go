@@ -8,6 +8,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
@@ -26,8 +30,7 @@ const QUERY_ATTESTATIONS_LIMIT uint64 = 1000
 
 // Params queries the params of the gravity module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
-	var params types.Params
-	k.paramSpace.GetParamSet(sdk.UnwrapSDKContext(c), &params)
+	params := k.GetParams(sdk.UnwrapSDKContext(c))
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
@@ -473,3 +476,217 @@ func (k Keeper) GetPendingIbcAutoForwards(
 	pendingForwards := k.PendingIbcAutoForwards(ctx, req.Limit)
 	return &types.QueryPendingIbcAutoForwardsResponse{PendingIbcAutoForwards: pendingForwards}, nil
 }
+
+// MissedSignatures returns every valset, batch and logic call the given validator owes a signature for
+// but has not yet signed, along with the height at which the validator may be slashed for it
+func (k Keeper) MissedSignatures(
+	c context.Context,
+	req *types.QueryMissedSignaturesRequest,
+) (*types.QueryMissedSignaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator address")
+	}
+	validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, req.ValidatorAddress)
+	}
+	return &types.QueryMissedSignaturesResponse{MissedSignatures: k.GetMissedSignatures(ctx, validator)}, nil
+}
+
+// ValidatorBridgeStatus combines everything we know about a validator's bridge duties, its delegate keys,
+// how far behind its oracle is, which signatures it owes and its past gravity slashing events
+func (k Keeper) ValidatorBridgeStatus(
+	c context.Context,
+	req *types.QueryValidatorBridgeStatusRequest,
+) (*types.QueryValidatorBridgeStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator address")
+	}
+	validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, req.ValidatorAddress)
+	}
+
+	res := types.QueryValidatorBridgeStatusResponse{
+		ValidatorAddress:       valAddr.String(),
+		Jailed:                 validator.IsJailed(),
+		LastClaimedEventNonce:  k.GetLastEventNonceByValidator(ctx, valAddr),
+		LastObservedEventNonce: k.GetLastObservedEventNonce(ctx),
+		MissedSignatures:       k.GetMissedSignatures(ctx, validator),
+		SlashingEvents:         k.GetBridgeSlashingEvents(ctx, valAddr),
+	}
+	if orchestrator, found := k.GetOrchestratorAddressByValidator(ctx, valAddr); found {
+		res.OrchestratorAddress = orchestrator.String()
+	}
+	if ethAddress, found := k.GetEthAddressByValidator(ctx, valAddr); found {
+		res.EthAddress = ethAddress.GetAddress().Hex()
+	}
+
+	for _, missed := range res.MissedSignatures {
+		if res.NextSlashingHeight == 0 || missed.SlashingHeight < res.NextSlashingHeight {
+			res.NextSlashingHeight = missed.SlashingHeight
+		}
+	}
+	if res.NextSlashingHeight > uint64(ctx.BlockHeight()) {
+		res.BlocksUntilNextSlashing = res.NextSlashingHeight - uint64(ctx.BlockHeight())
+	}
+
+	return &res, nil
+}
+
+// ERC20DeploymentRequests returns every governance approved ERC20 deployment request, split into those
+// still waiting for an ERC20DeployedClaim and those which have been fulfilled
+func (k Keeper) ERC20DeploymentRequests(
+	c context.Context,
+	req *types.QueryERC20DeploymentRequestsRequest,
+) (*types.QueryERC20DeploymentRequestsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := types.QueryERC20DeploymentRequestsResponse{
+		Pending:   []types.ERC20DeploymentRequest{},
+		Completed: []types.ERC20DeploymentRequest{},
+	}
+	k.IterateERC20DeploymentRequests(ctx, func(request types.ERC20DeploymentRequest) bool {
+		if request.Erc20 == "" {
+			res.Pending = append(res.Pending, request)
+		} else {
+			res.Completed = append(res.Completed, request)
+		}
+		return false
+	})
+	return &res, nil
+}
+
+// ERC20Migrations returns every deprecated Cosmos originated ERC20, split into those whose migration window
+// is still open and those whose window has closed
+func (k Keeper) ERC20Migrations(
+	c context.Context,
+	req *types.QueryERC20MigrationsRequest,
+) (*types.QueryERC20MigrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	res := types.QueryERC20MigrationsResponse{
+		Active:   []types.ERC20Migration{},
+		Finished: []types.ERC20Migration{},
+	}
+	k.IterateERC20Migrations(ctx, func(migration types.ERC20Migration) bool {
+		if uint64(ctx.BlockHeight()) <= migration.EndHeight {
+			res.Active = append(res.Active, migration)
+		} else {
+			res.Finished = append(res.Finished, migration)
+		}
+		return false
+	})
+	return &res, nil
+}
+
+// RateLimitCapacity returns how much of each rate limited token may still enter and leave the bridge within
+// the current window, along with any deposits queued waiting for inflow capacity
+func (k Keeper) RateLimitCapacity(
+	c context.Context,
+	req *types.QueryRateLimitCapacityRequest,
+) (*types.QueryRateLimitCapacityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var tokenContract *types.EthAddress
+	if req.TokenContract != "" {
+		contract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract")
+		}
+		tokenContract = contract
+	}
+	return &types.QueryRateLimitCapacityResponse{Capacities: k.GetRateLimitCapacities(ctx, tokenContract)}, nil
+}
+
+// UnhaltBridgeImpact simulates an UnhaltBridgeProposal at the requested TargetNonce and reports the
+// attestations, orchestrator nonces, IBC auto-forwards and batches it would affect
+func (k Keeper) UnhaltBridgeImpact(
+	c context.Context,
+	req *types.QueryUnhaltBridgeImpactRequest,
+) (*types.QueryUnhaltBridgeImpactResponse, error) {
+	return k.SimulateUnhaltBridge(sdk.UnwrapSDKContext(c), req.TargetNonce)
+}
+
+// MerkleAirdrops returns the airdrops whose recipients may still claim their share with MsgClaimAirdrop
+func (k Keeper) MerkleAirdrops(
+	c context.Context,
+	req *types.QueryMerkleAirdropsRequest,
+) (*types.QueryMerkleAirdropsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	airdrops := []types.MerkleAirdrop{}
+	k.IterateMerkleAirdrops(ctx, func(airdrop types.MerkleAirdrop) bool {
+		airdrops = append(airdrops, airdrop)
+		return false
+	})
+	return &types.QueryMerkleAirdropsResponse{Airdrops: airdrops}, nil
+}
+
+// AirdropClaimed returns true if the address has claimed its share of the given Merkle airdrop
+func (k Keeper) AirdropClaimed(
+	c context.Context,
+	req *types.QueryAirdropClaimedRequest,
+) (*types.QueryAirdropClaimedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid address")
+	}
+	if _, found := k.GetMerkleAirdrop(ctx, req.AirdropId); !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "airdrop %d does not exist or has expired", req.AirdropId)
+	}
+	return &types.QueryAirdropClaimedResponse{Claimed: k.HasClaimedAirdrop(ctx, req.AirdropId, addr)}, nil
+}
+
+// DepositReceipts returns the receipts of the deposits observed from an Ethereum transaction
+func (k Keeper) DepositReceipts(
+	c context.Context,
+	req *types.QueryDepositReceiptsRequest,
+) (*types.QueryDepositReceiptsResponse, error) {
+	if err := types.ValidateEthTxHash(req.EthTxHash); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+	receipts := k.GetDepositReceipts(sdk.UnwrapSDKContext(c), gethcommon.HexToHash(req.EthTxHash))
+	return &types.QueryDepositReceiptsResponse{Receipts: receipts}, nil
+}
+
+// BatchExecution returns the Ethereum transaction which executed a batch
+func (k Keeper) BatchExecution(
+	c context.Context,
+	req *types.QueryBatchExecutionRequest,
+) (*types.QueryBatchExecutionResponse, error) {
+	contract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+	execution := k.GetBatchExecution(sdk.UnwrapSDKContext(c), *contract, req.BatchNonce)
+	if execution == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "batch has not been observed executed")
+	}
+	return &types.QueryBatchExecutionResponse{Execution: *execution}, nil
+}
+
+// ExecutedBatchByNonce returns an executed batch from the archive by its batch nonce
+func (k Keeper) ExecutedBatchByNonce(
+	c context.Context,
+	req *types.QueryExecutedBatchByNonceRequest,
+) (*types.QueryExecutedBatchResponse, error) {
+	executed := k.GetExecutedBatchByNonce(sdk.UnwrapSDKContext(c), req.BatchNonce)
+	if executed == nil {
+		return nil, status.Error(codes.NotFound, "batch not found in the executed batch archive")
+	}
+	return &types.QueryExecutedBatchResponse{Batch: *executed}, nil
+}
+
+// ExecutedBatchByTxId returns the executed batch which delivered a pool transaction from the archive
+func (k Keeper) ExecutedBatchByTxId(
+	c context.Context,
+	req *types.QueryExecutedBatchByTxIdRequest,
+) (*types.QueryExecutedBatchResponse, error) {
+	executed := k.GetExecutedBatchByTxId(sdk.UnwrapSDKContext(c), req.TxId)
+	if executed == nil {
+		return nil, status.Error(codes.NotFound, "tx not found in the executed batch archive")
+	}
+	return &types.QueryExecutedBatchResponse{Batch: *executed}, nil
+}
@@ -153,3 +153,49 @@ func createAttestations(t *testing.T, k keeper.Keeper, ctx sdk.Context, length i
 		k.SetAttestation(ctx, nonce, hash, att)
 	}
 }
+
+func TestQueryValidatorBridgeStatus(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	encCfg := app.MakeEncodingConfig()
+	k := input.GravityKeeper
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	params := k.GetParams(ctx)
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  0,
+		Transactions:  []types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         uint64(ctx.BlockHeight() + 1),
+	})
+	require.NoError(t, err)
+	k.StoreBatch(ctx, *batch)
+	k.SetLastEventNonceByValidator(ctx, keeper.ValAddrs[0], 3)
+	k.SetBridgeSlashingEvent(ctx, keeper.ValAddrs[0], types.AttributeKeyValsetSignatureSlashing)
+
+	res, err := queryClient.ValidatorBridgeStatus(gocontext.Background(), &types.QueryValidatorBridgeStatusRequest{
+		ValidatorAddress: keeper.ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.False(t, res.Jailed)
+	require.Equal(t, keeper.OrchAddrs[0].String(), res.OrchestratorAddress)
+	require.Equal(t, keeper.EthAddrs[0].Hex(), res.EthAddress)
+	require.Equal(t, uint64(3), res.LastClaimedEventNonce)
+	require.Equal(t, k.GetLastObservedEventNonce(ctx), res.LastObservedEventNonce)
+	require.Len(t, res.MissedSignatures, 1)
+	require.Equal(t, batch.Block+params.SignedBatchesWindow+1, res.NextSlashingHeight)
+	require.Equal(t, params.SignedBatchesWindow+2, res.BlocksUntilNextSlashing)
+	require.Equal(t, []types.BridgeSlashingEvent{{
+		Validator:   keeper.ValAddrs[0].String(),
+		SlashType:   types.AttributeKeyValsetSignatureSlashing,
+		BlockHeight: uint64(ctx.BlockHeight()),
+	}}, res.SlashingEvents)
+
+	_, err = queryClient.ValidatorBridgeStatus(gocontext.Background(), &types.QueryValidatorBridgeStatusRequest{
+		ValidatorAddress: "invalid",
+	})
+	require.Error(t, err)
+}
@@ -111,7 +111,7 @@ func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, forward types.PendingI
 			"Pending IBC Auto-Forward Queue already has an entry with nonce %v", forward.EventNonce,
 		)
 	}
-	store.Set(key, k.cdc.MustMarshal(&forward))
+	k.setPendingIbcAutoForward(ctx, forward)
 
 	k.logger(ctx).Info("SendToCosmos Pending IBC Auto-Forward", "ibcReceiver", forward.ForeignReceiver,
 		"token", token, "denom", forward.Token.Denom, "amount", forward.Token.Amount.String(),
@@ -119,15 +119,30 @@ func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, forward types.PendingI
 		"cosmosBlockTime", ctx.BlockTime(), "cosmosBlockHeight", ctx.BlockHeight(),
 	)
 
-	return ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosPendingIbcAutoForward{
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosPendingIbcAutoForward{
 		Nonce:    fmt.Sprint(forward.EventNonce),
 		Receiver: forward.ForeignReceiver,
 		Token:    token,
 		Amount:   forward.Token.Amount.String(),
 		Channel:  forward.IbcChannel,
+	}); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventDepositAutoForwardQueued{
+		Version:         types.TransferEventVersion,
+		EventNonce:      forward.EventNonce,
+		ForeignReceiver: forward.ForeignReceiver,
+		IbcChannel:      forward.IbcChannel,
+		Amount:          *forward.Token,
 	})
 }
 
+// setPendingIbcAutoForward stores a pending IBC Auto-Forward under its event nonce without validating it
+func (k Keeper) setPendingIbcAutoForward(ctx sdk.Context, forward types.PendingIbcAutoForward) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingIbcAutoForwardKey(forward.EventNonce), k.cdc.MustMarshal(&forward))
+}
+
 // deletePendingIbcAutoForward removes a single pending IBC Auto-Forward send to an IBC-enabled chain from the store
 // WARNING: this should only be called while clearing the queue in ClearNextPendingIbcAutoForward
 func (k Keeper) deletePendingIbcAutoForward(ctx sdk.Context, eventNonce uint64) error {
@@ -193,7 +208,15 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context) (stop bool, er
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fallback, coins)
 	if err != nil {
 		// Couldn't send to fallback account, need to try community pool
-		return false, k.SendToCommunityPool(ctx, coins)
+		if err := k.SendToCommunityPool(ctx, coins); err != nil {
+			return false, err
+		}
+		return false, ctx.EventManager().EmitTypedEvent(&types.EventDepositToCommunityPool{
+			Version:        types.TransferEventVersion,
+			EventNonce:     forward.EventNonce,
+			CosmosReceiver: forward.ForeignReceiver,
+			Amount:         *forward.Token,
+		})
 	}
 
 	timeoutTime := thirtyDaysInFuture(ctx) // Set the ibc transfer to expire ~one month from now
@@ -270,6 +293,13 @@ func (k Keeper) logEmitIbcForwardSuccessEvent(
 		TimeoutHeight: msgTransfer.TimeoutHeight.String(),
 		TimeoutTime:   fmt.Sprint(msgTransfer.TimeoutTimestamp),
 	})
+	ctx.EventManager().EmitTypedEvent(&types.EventDepositAutoForwarded{
+		Version:         types.TransferEventVersion,
+		EventNonce:      forward.EventNonce,
+		ForeignReceiver: forward.ForeignReceiver,
+		IbcChannel:      forward.IbcChannel,
+		Amount:          *forward.Token,
+	})
 }
 
 // logEmitIbcForwardFailureEvent logs failed IBC Auto-Forwarding and emits a EventSendToCosmosLocal type event
@@ -288,4 +318,10 @@ func (k Keeper) logEmitIbcForwardFailureEvent(ctx sdk.Context, forward types.Pen
 		Token:    forward.Token.Denom,
 		Amount:   forward.Token.Amount.String(),
 	})
+	ctx.EventManager().EmitTypedEvent(&types.EventDepositCredited{
+		Version:    types.TransferEventVersion,
+		EventNonce: forward.EventNonce,
+		Receiver:   localReceiver.String(),
+		Amount:     *forward.Token,
+	})
 }
@@ -1,25 +1,31 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// TODO: Add any future invariants here
-// TODO: (see the sdk docs for more info https://docs.cosmos.network/master/building-modules/invariants.html)
+// AllInvariants runs every gravity invariant, stopping at the first one which is broken
+// (see the sdk docs for more info https://docs.cosmos.network/master/building-modules/invariants.html)
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleBalanceInvariant(k)(ctx)
-
-		// Example additional invariants
-		//  res, stop := FutureInvariant(k)(ctx)
-		//	if stop {
-		//		return res, stop
-		//	}
-		//
-		//	return AnotherFutureInvariant(k)(ctx)
+		for _, invariant := range []sdk.Invariant{
+			ModuleBalanceInvariant(k),
+			UnbatchedTxIndexInvariant(k),
+			PoolBatchTxIdsInvariant(k),
+			BatchNonceInvariant(k),
+			LastObservedEventNonceInvariant(k),
+			DelegateKeyInvariant(k),
+			ConfirmsInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
 	}
 }
 
@@ -37,12 +43,17 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 		expectedBals = sumUnconfirmedBatchModuleBalances(ctx, k, expectedBals)
 		expectedBals = sumUnbatchedTxModuleBalances(ctx, k, expectedBals)
 		expectedBals = sumPendingIbcAutoForwards(ctx, k, expectedBals)
+		expectedBals = sumUnclaimedAirdrops(ctx, k, expectedBals)
 
 		// Compare actual vs expected balances
 		for _, actual := range actualBals {
 			denom := actual.GetDenom()
 			cosmosOriginated, _, err := k.DenomToERC20Lookup(ctx, denom)
 			if err != nil {
+				// Merkle airdrops may hold Cosmos assets with no erc20 representation
+				if expected := expectedBals[denom]; expected != nil && actual.Amount.Equal(*expected) {
+					continue
+				}
 				// Here we do not return because a user could halt the chain by gifting gravity a cosmos asset with no erc20 repr
 				ctx.Logger().Error("Unexpected gravity module balance of cosmos-originated asset with no erc20 representation", "asset", denom)
 				continue
@@ -67,9 +78,9 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
 	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
 		batchTotal := sdk.NewInt(0)
-		// Collect the send amount + fee amount for each tx
+		// Collect the send amount + fee amount + held fee share for each tx
 		for _, tx := range batch.Transactions {
-			newTotal := batchTotal.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
+			newTotal := batchTotal.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount).Add(tx.FeeShare))
 			batchTotal = newTotal
 		}
 		contract := batch.TokenContract
@@ -96,8 +107,8 @@ func sumUnbatchedTxModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[st
 		contract := tx.Erc20Token.Contract
 		_, denom := k.ERC20ToDenomLookup(ctx, contract)
 
-		// Collect the send amount + fee amount for each tx
-		txTotal := tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount)
+		// Collect the send amount + fee amount + held fee share for each tx
+		txTotal := tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount).Add(tx.FeeShare)
 		_, ok := expectedBals[denom]
 		if !ok {
 			zero := sdk.ZeroInt()
@@ -116,10 +127,218 @@ func sumPendingIbcAutoForwards(ctx sdk.Context, k Keeper, expectedBals map[strin
 		if _, ok := expectedBals[forward.Token.Denom]; !ok {
 			zero := sdk.ZeroInt()
 			expectedBals[forward.Token.Denom] = &zero
-		} else {
-			*expectedBals[forward.Token.Denom] = expectedBals[forward.Token.Denom].Add(forward.Token.Amount)
 		}
+		*expectedBals[forward.Token.Denom] = expectedBals[forward.Token.Denom].Add(forward.Token.Amount)
 	}
 
 	return expectedBals
 }
+
+// sumUnclaimedAirdrops calculates the value the module should hold for Merkle airdrops which have not been fully claimed
+func sumUnclaimedAirdrops(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	k.IterateMerkleAirdrops(ctx, func(airdrop types.MerkleAirdrop) bool {
+		for _, coin := range airdrop.Remaining() {
+			if _, ok := expectedBals[coin.Denom]; !ok {
+				zero := sdk.ZeroInt()
+				expectedBals[coin.Denom] = &zero
+			}
+			*expectedBals[coin.Denom] = expectedBals[coin.Denom].Add(coin.Amount)
+		}
+		return false
+	})
+
+	return expectedBals
+}
+
+// UnbatchedTxIndexInvariant checks that every unbatched tx is stored under the fee index built from its own fee and
+// id, and that no tx id is stored under more than one index
+func UnbatchedTxIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		seen := make(map[uint64]bool)
+		var res string
+		k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+			if !bytes.Equal(key, types.GetOutgoingTxPoolKey(*tx.Erc20Fee, tx.Id)) {
+				res = fmt.Sprintf("Unbatched tx %d is stored under the index %X which does not match its fee %v", tx.Id, key, tx.Erc20Fee)
+				return true
+			}
+			if seen[tx.Id] {
+				res = fmt.Sprintf("Unbatched tx %d is stored under more than one index", tx.Id)
+				return true
+			}
+			seen[tx.Id] = true
+			return false
+		})
+		return res, res != ""
+	}
+}
+
+// PoolBatchTxIdsInvariant checks that no tx id is both unbatched and in a batch, or in more than one batch
+func PoolBatchTxIdsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		unbatched := make(map[uint64]bool)
+		k.IterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+			unbatched[tx.Id] = true
+			return false
+		})
+
+		batched := make(map[uint64]uint64) // tx id => batch nonce
+		var res string
+		k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+			for _, tx := range batch.Transactions {
+				if unbatched[tx.Id] {
+					res = fmt.Sprintf("Tx %d is in the unbatched pool and in batch %d", tx.Id, batch.BatchNonce)
+					return true
+				}
+				if nonce, found := batched[tx.Id]; found {
+					res = fmt.Sprintf("Tx %d is in batches %d and %d", tx.Id, nonce, batch.BatchNonce)
+					return true
+				}
+				batched[tx.Id] = batch.BatchNonce
+			}
+			return false
+		})
+		return res, res != ""
+	}
+}
+
+// BatchNonceInvariant checks that every batch is stored under its own token and nonce, so batch nonces are unique
+// per token, and that no batch nonce is above the last batch id handed out
+func BatchNonceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastBatchID := k.getID(ctx, types.KeyLastOutgoingBatchID)
+		var res string
+		k.IterateOutgoingTXBatches(ctx, func(key []byte, batch types.InternalOutgoingTxBatch) bool {
+			fullKey := types.AppendBytes(types.OutgoingTXBatchKey, key)
+			if !bytes.Equal(fullKey, types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)) {
+				res = fmt.Sprintf("Batch %d of %s is stored under the key %X", batch.BatchNonce, batch.TokenContract.GetAddress().Hex(), fullKey)
+				return true
+			}
+			if batch.BatchNonce > lastBatchID {
+				res = fmt.Sprintf("Batch %d of %s has a nonce above the last batch id %d", batch.BatchNonce, batch.TokenContract.GetAddress().Hex(), lastBatchID)
+				return true
+			}
+			return false
+		})
+		return res, res != ""
+	}
+}
+
+// LastObservedEventNonceInvariant checks that at most one attestation is observed at each event nonce and that the
+// last observed event nonce is the nonce of the latest observed attestation. Attestations are pruned, so a last
+// observed event nonce with no observed attestations left in the store is not a violation
+func LastObservedEventNonceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastObserved := k.GetLastObservedEventNonce(ctx)
+		observed := make(map[uint64]bool)
+		var (
+			res           string
+			latestNonce   uint64
+			foundObserved bool
+		)
+		k.IterateAttestations(ctx, false, func(key []byte, att types.Attestation) bool {
+			if !att.Observed {
+				return false
+			}
+			// attestations are keyed by event nonce and then claim hash
+			nonce := types.UInt64FromBytes(key[len(types.OracleAttestationKey) : len(types.OracleAttestationKey)+8])
+			if observed[nonce] {
+				res = fmt.Sprintf("More than one attestation is observed at event nonce %d", nonce)
+				return true
+			}
+			observed[nonce] = true
+			foundObserved = true
+			if nonce > latestNonce {
+				latestNonce = nonce
+			}
+			return false
+		})
+		if res != "" {
+			return res, true
+		}
+		if foundObserved && latestNonce != lastObserved {
+			return fmt.Sprintf("Last observed event nonce %d does not match the latest observed attestation at %d", lastObserved, latestNonce), true
+		}
+		return "", false
+	}
+}
+
+// DelegateKeyInvariant checks that the validator => ethereum address and ethereum address => validator indexes are
+// inverses of each other, and that every validator with an ethereum address has exactly one orchestrator
+func DelegateKeyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(k.storeKey)
+
+		ethByValidator := make(map[string][]byte)
+		iter := store.Iterator(prefixRange(types.EthAddressByValidatorKey))
+		for ; iter.Valid(); iter.Next() {
+			validator, _ := types.SplitLengthPrefixed(iter.Key()[len(types.EthAddressByValidatorKey):])
+			ethByValidator[string(validator)] = iter.Value()
+		}
+		iter.Close()
+
+		validatorByEth := make(map[string][]byte)
+		iter = store.Iterator(prefixRange(types.ValidatorByEthAddressKey))
+		for ; iter.Valid(); iter.Next() {
+			validatorByEth[string(iter.Key()[len(types.ValidatorByEthAddressKey):])] = iter.Value()
+		}
+		iter.Close()
+
+		orchestrators := make(map[string]int)
+		iter = store.Iterator(prefixRange(types.KeyOrchestratorAddress))
+		for ; iter.Valid(); iter.Next() {
+			orchestrators[string(iter.Value())]++
+		}
+		iter.Close()
+
+		if len(ethByValidator) != len(validatorByEth) {
+			return fmt.Sprintf("%d validators have an ethereum address but %d ethereum addresses have a validator", len(ethByValidator), len(validatorByEth)), true
+		}
+		for val, eth := range ethByValidator {
+			valAddr := sdk.ValAddress(val)
+			if !bytes.Equal(validatorByEth[string(eth)], valAddr) {
+				return fmt.Sprintf("Ethereum address %X of validator %s does not map back to it", eth, valAddr), true
+			}
+			if orchestrators[val] != 1 {
+				return fmt.Sprintf("Validator %s has %d orchestrators", valAddr, orchestrators[val]), true
+			}
+		}
+		if len(orchestrators) != len(ethByValidator) {
+			return fmt.Sprintf("%d validators have an orchestrator but %d have an ethereum address", len(orchestrators), len(ethByValidator)), true
+		}
+		return "", false
+	}
+}
+
+// ConfirmsInvariant checks that every batch confirm is for a stored batch and every valset confirm is for a stored valset
+func ConfirmsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(k.storeKey)
+
+		iter := store.Iterator(prefixRange(types.ValsetConfirmKey))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()[len(types.ValsetConfirmKey):]
+			nonce := types.UInt64FromBytes(key[:8])
+			if k.GetValset(ctx, nonce) == nil {
+				orchestrator, _ := types.SplitLengthPrefixed(key[8:])
+				return fmt.Sprintf("Valset confirm by %s is for unknown valset %d", sdk.AccAddress(orchestrator), nonce), true
+			}
+		}
+
+		batchIter := store.Iterator(prefixRange(types.BatchConfirmKey))
+		defer batchIter.Close()
+		for ; batchIter.Valid(); batchIter.Next() {
+			key := batchIter.Key()[len(types.BatchConfirmKey):]
+			tokenContract, err := types.NewEthAddressFromBytes(key[:20])
+			if err != nil {
+				return fmt.Sprintf("Batch confirm under the key %X has an invalid token contract", batchIter.Key()), true
+			}
+			nonce := types.UInt64FromBytes(key[20:28])
+			if k.GetOutgoingTXBatch(ctx, *tokenContract, nonce) == nil {
+				orchestrator, _ := types.SplitLengthPrefixed(key[28:])
+				return fmt.Sprintf("Batch confirm by %s is for unknown batch %d of %s", sdk.AccAddress(orchestrator), nonce, tokenContract.GetAddress().Hex()), true
+			}
+		}
+		return "", false
+	}
+}
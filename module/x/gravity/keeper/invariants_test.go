@@ -186,3 +186,179 @@ func checkImbalancedModule(t *testing.T, ctx sdk.Context, gravityKeeper Keeper,
 	// Rebalance the module
 	bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins)
 }
+
+// requireInvariant checks that the invariant is broken, or holds, in the given context
+func requireInvariant(t *testing.T, ctx sdk.Context, invariant sdk.Invariant, broken bool) {
+	res, stop := invariant(ctx)
+	require.Equal(t, broken, stop, res)
+	if broken {
+		require.NotEmpty(t, res)
+	} else {
+		require.Empty(t, res)
+	}
+}
+
+// newInvariantTestTx creates an unbatched tx with the given id and fee for the invariant tests
+func newInvariantTestTx(t *testing.T, id uint64, fee int64) *types.InternalOutgoingTransferTx {
+	tx, err := types.NewInternalOutgoingTransferTx(
+		id,
+		AccAddrs[0].String(),
+		EthAddrs[0].String(),
+		types.NewERC20Token(100, TokenContractAddrs[0]),
+		types.NewERC20Token(uint64(fee), TokenContractAddrs[0]),
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestUnbatchedTxIndexInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	ctx, _ := input.Context.CacheContext()
+
+	require.NoError(t, k.addUnbatchedTX(ctx, newInvariantTestTx(t, 1, 2)))
+	require.NoError(t, k.addUnbatchedTX(ctx, newInvariantTestTx(t, 2, 2)))
+	requireInvariant(t, ctx, UnbatchedTxIndexInvariant(k), false)
+
+	// the same tx stored under a second fee index
+	duplicate := newInvariantTestTx(t, 1, 2)
+	external := duplicate.ToExternal()
+	bz := input.Marshaler.MustMarshal(&external)
+	fee, err := types.NewInternalERC20Token(sdk.NewInt(3), TokenContractAddrs[0])
+	require.NoError(t, err)
+	store := ctx.KVStore(input.GravityStoreKey)
+	store.Set(types.GetOutgoingTxPoolKey(*fee, duplicate.Id), bz)
+	requireInvariant(t, ctx, UnbatchedTxIndexInvariant(k), true)
+}
+
+func TestPoolBatchTxIdsInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	ctx, _ := input.Context.CacheContext()
+	token, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+
+	require.NoError(t, k.addUnbatchedTX(ctx, newInvariantTestTx(t, 1, 2)))
+	k.StoreBatch(ctx, types.InternalOutgoingTxBatch{
+		BatchNonce: 1, BatchTimeout: 1000, TokenContract: *token,
+		Transactions: []*types.InternalOutgoingTransferTx{newInvariantTestTx(t, 2, 2)},
+	})
+	requireInvariant(t, ctx, PoolBatchTxIdsInvariant(k), false)
+
+	// a tx which is both unbatched and in a batch
+	inPool, _ := ctx.CacheContext()
+	require.NoError(t, k.addUnbatchedTX(inPool, newInvariantTestTx(t, 2, 3)))
+	requireInvariant(t, inPool, PoolBatchTxIdsInvariant(k), true)
+
+	// a tx which is in two batches
+	k.StoreBatch(ctx, types.InternalOutgoingTxBatch{
+		BatchNonce: 2, BatchTimeout: 1000, TokenContract: *token,
+		Transactions: []*types.InternalOutgoingTransferTx{newInvariantTestTx(t, 2, 2)},
+	})
+	requireInvariant(t, ctx, PoolBatchTxIdsInvariant(k), true)
+}
+
+func TestBatchNonceInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	ctx, _ := input.Context.CacheContext()
+	token, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	otherToken, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+
+	batch := types.InternalOutgoingTxBatch{BatchNonce: 1, BatchTimeout: 1000, TokenContract: *token}
+	k.StoreBatch(ctx, batch)
+	// the batch nonce is above the last batch id
+	requireInvariant(t, ctx, BatchNonceInvariant(k), true)
+
+	k.setID(ctx, 1, types.KeyLastOutgoingBatchID)
+	requireInvariant(t, ctx, BatchNonceInvariant(k), false)
+
+	// the batch stored under another token's key
+	external := batch.ToExternal()
+	ctx.KVStore(input.GravityStoreKey).Set(types.GetOutgoingTxBatchKey(*otherToken, 1), input.Marshaler.MustMarshal(&external))
+	requireInvariant(t, ctx, BatchNonceInvariant(k), true)
+}
+
+func TestLastObservedEventNonceInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	ctx, _ := input.Context.CacheContext()
+
+	k.SetAttestation(ctx, 1, []byte("claim1"), &types.Attestation{Observed: true, Height: 1})
+	k.SetAttestation(ctx, 2, []byte("claim2"), &types.Attestation{Observed: false, Height: 2})
+	k.setLastObservedEventNonce(ctx, 1)
+	requireInvariant(t, ctx, LastObservedEventNonceInvariant(k), false)
+
+	// the last observed event nonce is behind the latest observed attestation
+	behind, _ := ctx.CacheContext()
+	k.SetAttestation(behind, 3, []byte("claim3"), &types.Attestation{Observed: true, Height: 3})
+	requireInvariant(t, behind, LastObservedEventNonceInvariant(k), true)
+
+	// two attestations observed at the same event nonce
+	k.SetAttestation(ctx, 1, []byte("claim1b"), &types.Attestation{Observed: true, Height: 1})
+	requireInvariant(t, ctx, LastObservedEventNonceInvariant(k), true)
+}
+
+func TestDelegateKeyInvariant(t *testing.T) {
+	input, _ := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	requireInvariant(t, input.Context, DelegateKeyInvariant(k), false)
+
+	// a second orchestrator for a validator
+	ctx, _ := input.Context.CacheContext()
+	k.SetOrchestratorValidator(ctx, ValAddrs[0], AccAddrs[0])
+	requireInvariant(t, ctx, DelegateKeyInvariant(k), true)
+
+	// an ethereum address without the reverse index
+	ctx, _ = input.Context.CacheContext()
+	ethAddr, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	ctx.KVStore(input.GravityStoreKey).Delete(types.GetValidatorByEthAddressKey(*ethAddr))
+	requireInvariant(t, ctx, DelegateKeyInvariant(k), true)
+
+	// an ethereum address pointing at another validator
+	ctx, _ = input.Context.CacheContext()
+	ctx.KVStore(input.GravityStoreKey).Set(types.GetValidatorByEthAddressKey(*ethAddr), ValAddrs[1])
+	requireInvariant(t, ctx, DelegateKeyInvariant(k), true)
+
+	// two validators with the same ethereum address
+	ctx, _ = input.Context.CacheContext()
+	ctx.KVStore(input.GravityStoreKey).Set(types.GetEthAddressByValidatorKey(ValAddrs[1]), ethAddr.GetAddress().Bytes())
+	requireInvariant(t, ctx, DelegateKeyInvariant(k), true)
+
+	// a validator with an ethereum address but no orchestrator
+	ctx, _ = input.Context.CacheContext()
+	ctx.KVStore(input.GravityStoreKey).Delete(types.GetOrchestratorAddressKey(OrchAddrs[0]))
+	requireInvariant(t, ctx, DelegateKeyInvariant(k), true)
+}
+
+func TestConfirmsInvariant(t *testing.T) {
+	input, _ := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	ctx, _ := input.Context.CacheContext()
+	token, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+
+	k.StoreValset(ctx, types.Valset{Nonce: 1, RewardAmount: sdk.ZeroInt()})
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{Nonce: 1, Orchestrator: OrchAddrs[0].String(), EthAddress: EthAddrs[0].String()})
+	k.StoreBatch(ctx, types.InternalOutgoingTxBatch{BatchNonce: 1, BatchTimeout: 1000, TokenContract: *token})
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{Nonce: 1, TokenContract: token.GetAddress().Hex(), Orchestrator: OrchAddrs[0].String()})
+	requireInvariant(t, ctx, ConfirmsInvariant(k), false)
+
+	// a confirm for a valset which was never stored
+	valsetCtx, _ := ctx.CacheContext()
+	k.SetValsetConfirm(valsetCtx, types.MsgValsetConfirm{Nonce: 2, Orchestrator: OrchAddrs[0].String(), EthAddress: EthAddrs[0].String()})
+	requireInvariant(t, valsetCtx, ConfirmsInvariant(k), true)
+
+	// a confirm left behind by a deleted batch
+	k.DeleteBatch(ctx, types.InternalOutgoingTxBatch{BatchNonce: 1, BatchTimeout: 1000, TokenContract: *token})
+	requireInvariant(t, ctx, ConfirmsInvariant(k), true)
+}
@@ -138,9 +138,17 @@ func (k Keeper) SendToCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
 //       PARAMETERS        //
 /////////////////////////////
 
-// GetParams returns the parameters from the store
+// GetParams returns the parameters from the store, the params added since v2 which have not been stored yet
+// (see types.IsOptionalParam) take their default values
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = *types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if types.IsOptionalParam(pair.Key) {
+			k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+		} else {
+			k.paramSpace.Get(ctx, pair.Key, pair.Value)
+		}
+	}
 	return
 }
 
@@ -235,7 +243,7 @@ func (k Keeper) GetDelegateKeys(ctx sdk.Context) []types.MsgSetOrchestratorAddre
 		// to cut off the starting bytes, if you don't do this a valid
 		// cosmos key will be made out of EthAddressByValidatorKey + the starting bytes
 		// of the actual key
-		key := iter.Key()[len(types.EthAddressByValidatorKey):]
+		key, _ := types.SplitLengthPrefixed(iter.Key()[len(types.EthAddressByValidatorKey):])
 		value := iter.Value()
 		ethAddress, err := types.NewEthAddressFromBytes(value)
 		if err != nil {
@@ -256,7 +264,7 @@ func (k Keeper) GetDelegateKeys(ctx sdk.Context) []types.MsgSetOrchestratorAddre
 	orchAddresses := make(map[string]string)
 
 	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()[len(types.KeyOrchestratorAddress):]
+		key, _ := types.SplitLengthPrefixed(iter.Key()[len(types.KeyOrchestratorAddress):])
 		value := iter.Value()
 		orchAddress := sdk.AccAddress(key)
 		if err := sdk.VerifyAddressFormat(orchAddress); err != nil {
@@ -335,7 +343,7 @@ func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out [
 	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
 	calls := k.GetOutgoingLogicCalls(ctx)
 	for _, call := range calls {
-		if call.Block > lastSlashedLogicCallBlock {
+		if call.Block > lastSlashedLogicCallBlock && call.Block < maxHeight {
 			out = append(out, call)
 		}
 	}
@@ -130,12 +130,16 @@ func (k Keeper) GetOrchestratorValidatorAddr(ctx sdk.Context, orch sdk.AccAddres
 //       ETH ADDRESS       //
 /////////////////////////////
 
-// SetEthAddress sets the ethereum address for a given validator
+// SetEthAddress sets the ethereum address for a given validator, replacing any address it had before
 func (k Keeper) SetEthAddressForValidator(ctx sdk.Context, validator sdk.ValAddress, ethAddr types.EthAddress) {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
 	store := ctx.KVStore(k.storeKey)
+	// remove the reverse index of the replaced address so that it no longer resolves to this validator
+	if oldEthAddr, found := k.GetEthAddressByValidator(ctx, validator); found {
+		store.Delete(types.GetValidatorByEthAddressKey(*oldEthAddr))
+	}
 	store.Set(types.GetEthAddressByValidatorKey(validator), ethAddr.GetAddress().Bytes())
 	store.Set(types.GetValidatorByEthAddressKey(ethAddr), []byte(validator))
 }
@@ -234,3 +238,18 @@ func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddre
 
 	return validator, true
 }
+
+// GetOrchestratorAddressByValidator returns the orchestrator key registered by a given validator, since orchestrators
+// are only indexed by orchestrator address this iterates all delegate keys
+func (k Keeper) GetOrchestratorAddressByValidator(ctx sdk.Context, validator sdk.ValAddress) (orchestrator sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(prefixRange(types.KeyOrchestratorAddress))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if sdk.ValAddress(iter.Value()).Equals(validator) {
+			orchestrator, _ := types.SplitLengthPrefixed(iter.Key()[len(types.KeyOrchestratorAddress):])
+			return orchestrator, true
+		}
+	}
+	return nil, false
+}
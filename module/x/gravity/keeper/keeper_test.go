@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -140,6 +142,7 @@ func TestAttestationIterator(t *testing.T) {
 
 	input.GravityKeeper.SetAttestation(ctx, dep1.EventNonce, hash1, att1)
 	input.GravityKeeper.SetAttestation(ctx, dep2.EventNonce, hash2, att2)
+	input.GravityKeeper.setLastObservedEventNonce(ctx, dep2.EventNonce)
 
 	atts := []types.Attestation{}
 	input.GravityKeeper.IterateAttestations(ctx, false, func(_ []byte, att types.Attestation) bool {
@@ -249,3 +252,36 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	assert.Equal(t, len(unslashedValsets), 6)
 	fmt.Println("unslashedValsetsRange", unslashedValsets)
 }
+
+// Tests that the params added since v2 read as their defaults on a chain which has not stored them yet
+func TestGetParamsMissingOptional(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramSpace := paramtypes.NewSubspace(MakeTestMarshaler(), MakeTestCodec(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	k := Keeper{paramSpace: paramSpace}
+
+	params := types.DefaultParams()
+	params.GravityId = "v2-gravity-id"
+	for _, pair := range params.ParamSetPairs() {
+		if !types.IsOptionalParam(pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	require.Panics(t, func() {
+		var stored types.Params
+		paramSpace.GetParamSet(ctx, &stored)
+	})
+
+	got := k.GetParams(ctx)
+	require.Equal(t, "v2-gravity-id", got.GravityId)
+	require.Equal(t, types.DefaultParams().ExecutedBatchArchiveSize, got.ExecutedBatchArchiveSize)
+	require.True(t, got.BridgeFeeShare.Equal(types.DefaultParams().BridgeFeeShare))
+	require.Empty(t, got.RateLimits)
+
+	// once stored the param is read from the store
+	got.ExecutedBatchArchiveSize = 7
+	k.SetParams(ctx, got)
+	require.Equal(t, uint64(7), k.GetParams(ctx).ExecutedBatchArchiveSize)
+}
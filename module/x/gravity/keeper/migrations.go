@@ -2,6 +2,7 @@ package keeper
 
 import (
 	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,3 +21,10 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Mercury Upgrade: Enter Migrate1to2()")
 	return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("v3 Upgrade: Enter Migrate2to3()")
+	v3.MigrateParams(ctx, m.keeper.paramSpace)
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
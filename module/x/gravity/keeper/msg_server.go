@@ -69,6 +69,7 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	// set the orchestrator address and the ethereum address
 	k.SetOrchestratorValidator(ctx, val, orch)
 	k.SetEthAddressForValidator(ctx, val, *ethAddr)
+	k.SetDelegateKeyRegistrationHeight(ctx, val, uint64(ctx.BlockHeight()))
 
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventSetOperatorAddress{
@@ -221,6 +222,15 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 			BatchConfirmKey: string(key),
 		},
 	)
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventBatchConfirmed{
+			Version:       types.TransferEventVersion,
+			BatchNonce:    msg.Nonce,
+			TokenContract: contract.GetAddress().Hex(),
+			Orchestrator:  orchaddr.String(),
+			EthSigner:     msg.EthSigner,
+		},
+	)
 
 	return nil, nil
 }
@@ -281,6 +291,20 @@ func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator
 	return nil
 }
 
+// checkClaimData drops the optional data of a claim for an event before Params.ClaimDataHeight, so that every
+// orchestrator votes for the same claim, and requires it for events from the height on
+func (k msgServer) checkClaimData(ctx sdk.Context, claim types.OptionalClaimData) error {
+	height := k.GetParams(ctx).ClaimDataHeight
+	if height == 0 || claim.GetBlockHeight() < height {
+		claim.ClearClaimData()
+		return nil
+	}
+	if !claim.HasClaimData() {
+		return sdkerrors.Wrapf(types.ErrClaimDataRequired, "event at Ethereum height %d, claim data height %d", claim.GetBlockHeight(), height)
+	}
+	return nil
+}
+
 // claimHandlerCommon is an internal function that provides common code for processing claims once they are
 // translated from the message to the Ethereum claim interface
 func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, msg types.EthereumClaim) error {
@@ -360,11 +384,15 @@ func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosm
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not check orchstrator validator inset")
 	}
-	any, err := codectypes.NewAnyWithValue(msg)
+	claim := *msg
+	if err := k.checkClaimData(ctx, &claim); err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(&claim)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not check Any value")
 	}
-	err = k.claimHandlerCommon(ctx, any, msg)
+	err = k.claimHandlerCommon(ctx, any, &claim)
 	if err != nil {
 		return nil, err
 	}
@@ -398,11 +426,15 @@ func (k msgServer) BatchSendToEthClaim(c context.Context, msg *types.MsgBatchSen
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not check orchestrator validator")
 	}
-	any, err := codectypes.NewAnyWithValue(msg)
+	claim := *msg
+	if err := k.checkClaimData(ctx, &claim); err != nil {
+		return nil, err
+	}
+	any, err := codectypes.NewAnyWithValue(&claim)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not check Any value")
 	}
-	err = k.claimHandlerCommon(ctx, any, msg)
+	err = k.claimHandlerCommon(ctx, any, &claim)
 	if err != nil {
 		return nil, err
 	}
@@ -499,3 +531,20 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 
 	return &types.MsgSubmitBadSignatureEvidenceResponse{}, err
 }
+
+// ClaimAirdrop handles MsgClaimAirdrop
+func (k msgServer) ClaimAirdrop(c context.Context, msg *types.MsgClaimAirdrop) (*types.MsgClaimAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid claimer")
+	}
+	proof, err := types.DecodeAirdropProof(msg.Proof)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	if err := k.Keeper.ClaimAirdrop(ctx, claimer, msg.AirdropId, msg.Amount, proof); err != nil {
+		return nil, err
+	}
+	return &types.MsgClaimAirdropResponse{}, nil
+}
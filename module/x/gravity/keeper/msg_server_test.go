@@ -30,7 +30,6 @@ func TestConfirmHandlerCommon(t *testing.T) {
 	require.NoError(t, err)
 
 	input.GravityKeeper.SetEthAddressForValidator(ctx, ValAddrs[0], *ethAddress)
-	input.GravityKeeper.SetOrchestratorValidator(ctx, ValAddrs[0], AccAddrs[0])
 
 	batch := types.OutgoingTxBatch{
 		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
@@ -43,7 +42,7 @@ func TestConfirmHandlerCommon(t *testing.T) {
 	require.NoError(t, err)
 
 	sv := msgServer{input.GravityKeeper}
-	err = sv.confirmHandlerCommon(input.Context, ethAddress.GetAddress().Hex(), AccAddrs[0], hex.EncodeToString(ethSignature), checkpoint)
+	err = sv.confirmHandlerCommon(input.Context, ethAddress.GetAddress().Hex(), OrchAddrs[0], hex.EncodeToString(ethSignature), checkpoint)
 	assert.Nil(t, err)
 }
 func confirmHandlerCommonWithAddress(t *testing.T, address string, testVar testInitStruct) error {
@@ -56,7 +55,6 @@ func confirmHandlerCommonWithAddress(t *testing.T, address string, testVar testI
 	require.NoError(t, err)
 
 	input.GravityKeeper.SetEthAddressForValidator(ctx, ValAddrs[0], *ethAddress)
-	input.GravityKeeper.SetOrchestratorValidator(ctx, ValAddrs[0], AccAddrs[0])
 
 	batch := types.OutgoingTxBatch{
 		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
@@ -70,7 +68,7 @@ func confirmHandlerCommonWithAddress(t *testing.T, address string, testVar testI
 
 	sv := msgServer{input.GravityKeeper}
 
-	err = sv.confirmHandlerCommon(input.Context, address, AccAddrs[0], hex.EncodeToString(ethSignature), checkpoint)
+	err = sv.confirmHandlerCommon(input.Context, address, OrchAddrs[0], hex.EncodeToString(ethSignature), checkpoint)
 
 	return err
 }
@@ -14,7 +14,11 @@ import (
 
 // AddToOutgoingPool creates a transaction and adds it to the pool, returns the id of the unbatched transaction
 // - checks a counterpart denominator exists for the given voucher type
+// - checks governance has not paused withdrawals of the token
+// - checks the amount is not below the token's Params.MinTransferAmounts
+// - charges the Params.ChainFee
 // - burns the voucher for transfer amount and fees
+// - holds the Params.BridgeFeeShare of the fee until the tx is executed, the OutgoingTx only pays the rest to the relayer
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool
 func (k Keeper) AddToOutgoingPool(
@@ -39,11 +43,37 @@ func (k Keeper) AddToOutgoingPool(
 		return 0, err
 	}
 
+	if k.GetParams(ctx).WithdrawalsPaused(*tokenContract) {
+		return 0, sdkerrors.Wrapf(types.ErrBridgePaused, "withdrawals of %s are paused by governance", tokenContract.GetAddress().Hex())
+	}
+
+	// reject dust transfers which are not worth batching
+	if min := k.GetParams(ctx).MinTransferAmount(*tokenContract); amount.Amount.LT(min) {
+		return 0, sdkerrors.Wrapf(types.ErrTransferTooSmall, "amount %s is below the minimum of %s", amount.Amount, min)
+	}
+
+	// reject transfers over the token's outflow limit, the sender can retry once capacity frees up, only the
+	// amount counts since the fees do not leave Cosmos as a transfer to the receiver
+	if err := k.checkOutflowRateLimit(ctx, *tokenContract, amount.Amount); err != nil {
+		return 0, err
+	}
+	k.recordRateLimitFlow(ctx, types.RateLimitOutflowKey, *tokenContract, amount.Amount)
+
 	// lock coins in module
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
 		return 0, err
 	}
 
+	chainFee, err := k.payChainFee(ctx, sender, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	// split off the Cosmos side share of the fee, it stays locked with the tx and is only paid to stakers
+	// once the tx is executed on Ethereum, so that a cancel refunds it along with the rest
+	feeShare := k.bridgeFeeShare(ctx, fee)
+	fee = fee.Sub(feeShare)
+
 	// get next tx id from keeper
 	nextID := k.autoIncrementID(ctx, types.KeyLastTXPoolID)
 
@@ -66,6 +96,7 @@ func (k Keeper) AddToOutgoingPool(
 		DestAddress: counterpartReceiver.GetAddress().Hex(),
 		Erc20Token:  erc20Token.ToExternal(),
 		Erc20Fee:    erc20Fee.ToExternal(),
+		FeeShare:    feeShare.Amount,
 	}.ToInternal()
 	if err != nil { // This should never happen since all the components are validated
 		panic(sdkerrors.Wrap(err, "unable to create InternalOutgoingTransferTx"))
@@ -88,6 +119,19 @@ func (k Keeper) AddToOutgoingPool(
 			Nonce:          fmt.Sprint(nextID),
 		},
 	)
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventTransferQueued{
+			Version:       types.TransferEventVersion,
+			TxId:          nextID,
+			Sender:        sender.String(),
+			Receiver:      counterpartReceiver.GetAddress().Hex(),
+			TokenContract: tokenContract.GetAddress().Hex(),
+			Amount:        amount,
+			Fee:           fee,
+			FeeShare:      feeShare,
+			ChainFee:      chainFee,
+		},
+	)
 	return nextID, nil
 }
 
@@ -128,10 +172,10 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d was not fully removed from the pool, a duplicate must exist", txId)
 	}
 
-	// Calculate refund
+	// Calculate refund, the bridge fee share is held with the tx until it is executed so it is refunded too
 	_, denom := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
 	totalToRefund := sdk.NewCoin(denom, tx.Erc20Token.Amount)
-	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
+	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount).Add(tx.FeeShare)
 	totalToRefundCoins := sdk.NewCoins(totalToRefund)
 
 	// Perform refund
@@ -147,6 +191,15 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx))),
 		},
 	)
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventTransferCancelled{
+			Version:       types.TransferEventVersion,
+			TxId:          txId,
+			Sender:        sender.String(),
+			TokenContract: tx.Erc20Token.Contract.GetAddress().Hex(),
+			Refund:        totalToRefund,
+		},
+	)
 	return nil
 }
 
@@ -356,6 +409,14 @@ func (k Keeper) getID(ctx sdk.Context, idKey []byte) uint64 {
 	return id
 }
 
+// gets a generic uint64 counter from the store, returning zero if the counter has not been created yet
+func (k Keeper) getIDOrZero(ctx sdk.Context, idKey []byte) uint64 {
+	if !ctx.KVStore(k.storeKey).Has(idKey) {
+		return 0
+	}
+	return k.getID(ctx, idKey)
+}
+
 // sets a generic uint64 counter in the store
 func (k Keeper) setID(ctx sdk.Context, id uint64, idKey []byte) {
 	store := ctx.KVStore(k.storeKey)
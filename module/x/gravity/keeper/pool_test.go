@@ -73,6 +73,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 		{
 			Id:          2,
 			Erc20Fee:    threeTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  oneHundredOneTok,
@@ -80,6 +81,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 		{
 			Id:          3,
 			Erc20Fee:    twoTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  oneHundredTwoTok,
@@ -87,6 +89,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 		{
 			Id:          1,
 			Erc20Fee:    twoTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  oneHundredTok,
@@ -94,6 +97,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 		{
 			Id:          4,
 			Erc20Fee:    oneTok,
+			FeeShare:    sdk.ZeroInt(),
 			Sender:      mySender,
 			DestAddress: receiverAddr,
 			Erc20Token:  oneHundredThreeTok,
@@ -576,6 +580,7 @@ func TestRefundInconsistentTx(t *testing.T) {
 		DestAddress: myReceiver,
 		Erc20Token:  amountToken,
 		Erc20Fee:    badFeeToken,
+		FeeShare:    sdk.ZeroInt(),
 	})
 	origBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.NoError(t, err, "someone added validation to addUnbatchedTx")
@@ -719,6 +724,7 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 			DestAddress: myReceiver,
 			Erc20Token:  amountToken1.ToExternal(),
 			Erc20Fee:    feeToken1.ToExternal(),
+			FeeShare:    sdk.ZeroInt(),
 		}
 		amountToken2, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[i]), myTokenContractAddr2)
 		require.NoError(t, err)
@@ -736,6 +742,7 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 			DestAddress: myReceiver,
 			Erc20Token:  amountToken2.ToExternal(),
 			Erc20Fee:    feeToken2.ToExternal(),
+			FeeShare:    sdk.ZeroInt(),
 		}
 	}
 
@@ -864,6 +871,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 			DestAddress: myReceiver,
 			Erc20Token:  amount1.ToExternal(),
 			Erc20Fee:    fee1.ToExternal(),
+			FeeShare:    sdk.ZeroInt(),
 		}
 		amount2, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[i]), myTokenContractAddr2)
 		require.NoError(t, err)
@@ -879,6 +887,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 			DestAddress: myReceiver,
 			Erc20Token:  amount2.ToExternal(),
 			Erc20Fee:    fee2.ToExternal(),
+			FeeShare:    sdk.ZeroInt(),
 		}
 	}
 	// IterateUnbatchedTransactionsByContract
@@ -973,6 +982,7 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 			DestAddress: myReceiver,
 			Erc20Token:  amountToken.ToExternal(),
 			Erc20Fee:    feeToken.ToExternal(),
+			FeeShare:    sdk.ZeroInt(),
 		}
 		foundTxsMap[r] = false
 
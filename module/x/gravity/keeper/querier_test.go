@@ -32,6 +32,7 @@ func TestQueryValsetConfirm(t *testing.T) {
 	sdkCtx := input.Context
 	ctx := sdk.WrapSDKContext(input.Context)
 	k := input.GravityKeeper
+	k.StoreValset(sdkCtx, types.Valset{Nonce: nonce, RewardAmount: sdk.ZeroInt()})
 	input.GravityKeeper.SetValsetConfirm(sdkCtx, types.MsgValsetConfirm{
 		Nonce:        nonce,
 		Orchestrator: myValidatorCosmosAddr.String(),
@@ -108,6 +109,7 @@ func TestAllValsetConfirmsBynonce(t *testing.T) {
 	sdkCtx := input.Context
 	ctx := sdk.WrapSDKContext(input.Context)
 	k := input.GravityKeeper
+	k.StoreValset(sdkCtx, types.Valset{Nonce: nonce, RewardAmount: sdk.ZeroInt()})
 
 	// seed confirmations
 	for i := 0; i < 3; i++ {
@@ -506,6 +508,7 @@ func TestLastPendingBatchRequest(t *testing.T) {
 								Amount:   sdk.NewInt(3),
 								Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 							},
+							FeeShare: sdk.ZeroInt(),
 						},
 						{
 							Id:          3,
@@ -519,6 +522,7 @@ func TestLastPendingBatchRequest(t *testing.T) {
 								Amount:   sdk.NewInt(2),
 								Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 							},
+							FeeShare: sdk.ZeroInt(),
 						},
 					},
 					TokenContract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
@@ -612,6 +616,11 @@ func TestQueryAllBatchConfirms(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	token, err := types.NewEthAddress(tokenContract)
+	require.NoError(t, err)
+	k.StoreBatch(sdkCtx, types.InternalOutgoingTxBatch{BatchNonce: 1, BatchTimeout: 1000, TokenContract: *token})
+	k.setID(sdkCtx, 1, types.KeyLastOutgoingBatchID)
+
 	input.GravityKeeper.SetBatchConfirm(sdkCtx, &types.MsgConfirmBatch{
 		Nonce:         1,
 		TokenContract: tokenContract,
@@ -666,6 +675,7 @@ func TestQueryLogicCalls(t *testing.T) {
 			ethAddr, err := types.NewEthAddress(gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String())
 			require.NoError(t, err)
 			input.GravityKeeper.SetEthAddressForValidator(sdkCtx, valAddr, *ethAddr)
+			input.GravityKeeper.SetOrchestratorValidator(sdkCtx, valAddr, valAddr)
 			validators = append(validators, valAddr)
 		}
 	}
@@ -727,6 +737,7 @@ func TestQueryLogicCallsConfirms(t *testing.T) {
 			ethAddr, err := types.NewEthAddress(gethcommon.BytesToAddress(bytes.Repeat([]byte{byte(j + 1)}, 20)).String())
 			require.NoError(t, err)
 			input.GravityKeeper.SetEthAddressForValidator(sdkCtx, valAddr, *ethAddr)
+			input.GravityKeeper.SetOrchestratorValidator(sdkCtx, valAddr, valAddr)
 			validators = append(validators, valAddr)
 		}
 	}
@@ -791,6 +802,7 @@ func TestQueryBatch(t *testing.T) {
 						Amount:   sdk.NewInt(3),
 						Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 					},
+					FeeShare: sdk.ZeroInt(),
 					DestAddress: "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 					Erc20Token: types.ERC20Token{
 						Amount:   sdk.NewInt(101),
@@ -804,6 +816,7 @@ func TestQueryBatch(t *testing.T) {
 						Amount:   sdk.NewInt(2),
 						Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 					},
+					FeeShare: sdk.ZeroInt(),
 					DestAddress: "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 					Erc20Token: types.ERC20Token{
 						Amount:   sdk.NewInt(102),
@@ -847,6 +860,7 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(3),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
+						FeeShare: sdk.ZeroInt(),
 						DestAddress: "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 						Erc20Token: types.ERC20Token{
 							Amount:   sdk.NewInt(101),
@@ -860,6 +874,7 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(2),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
+						FeeShare: sdk.ZeroInt(),
 						DestAddress: "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 						Erc20Token: types.ERC20Token{
 							Amount:   sdk.NewInt(102),
@@ -873,6 +888,7 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(2),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
+						FeeShare: sdk.ZeroInt(),
 						DestAddress: "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 						Erc20Token: types.ERC20Token{
 							Amount:   sdk.NewInt(100),
@@ -894,6 +910,7 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(3),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
+						FeeShare: sdk.ZeroInt(),
 						DestAddress: "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 						Erc20Token: types.ERC20Token{
 							Amount:   sdk.NewInt(101),
@@ -907,6 +924,7 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(2),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
+						FeeShare: sdk.ZeroInt(),
 						DestAddress: "0x320915BD0F1bad11cBf06e85D5199DBcAC4E9934",
 						Erc20Token: types.ERC20Token{
 							Amount:   sdk.NewInt(102),
@@ -1093,6 +1111,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 				Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 				Amount:   sdk.NewInt(3),
 			},
+			FeeShare: sdk.ZeroInt(),
 		},
 		{
 			Id:          3,
@@ -1106,6 +1125,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 				Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 				Amount:   sdk.NewInt(2),
 			},
+			FeeShare: sdk.ZeroInt(),
 		},
 	},
 
@@ -1122,6 +1142,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 					Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 					Amount:   sdk.NewInt(2),
 				},
+				FeeShare: sdk.ZeroInt(),
 			},
 			{
 				Id:          4,
@@ -1135,6 +1156,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 					Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 					Amount:   sdk.NewInt(1),
 				},
+				FeeShare: sdk.ZeroInt(),
 			},
 		},
 	}
@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//       RATE LIMITS       //
+/////////////////////////////
+
+// recordRateLimitFlow adds amount to the inflow or outflow (selected by flowPrefix) of tokenContract at the current
+// block height and prunes flows which have left the rolling window. Flows are only recorded for tokens with a
+// configured rate limit
+func (k Keeper) recordRateLimitFlow(ctx sdk.Context, flowPrefix []byte, tokenContract types.EthAddress, amount sdk.Int) {
+	limit, found := k.GetParams(ctx).GetRateLimit(tokenContract)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	height := uint64(ctx.BlockHeight())
+	key := types.GetRateLimitFlowKey(flowPrefix, tokenContract, height)
+
+	total := amount
+	if bz := store.Get(key); bz != nil {
+		var existing sdk.Int
+		if err := existing.Unmarshal(bz); err != nil {
+			panic(sdkerrors.Wrap(err, "invalid rate limit flow in store"))
+		}
+		total = total.Add(existing)
+	}
+	k.setRateLimitFlow(ctx, flowPrefix, tokenContract, height, total)
+
+	// prune flows at heights which can no longer be inside the window
+	if height < limit.Window {
+		return
+	}
+	prefixStore := prefix.NewStore(store, types.GetRateLimitFlowPrefix(flowPrefix, tokenContract))
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(height-limit.Window+1))
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, iter.Key())
+	}
+	iter.Close()
+	for _, key := range expired {
+		prefixStore.Delete(key)
+	}
+}
+
+// setRateLimitFlow sets the amount of tokenContract which flowed in the direction selected by flowPrefix at height
+func (k Keeper) setRateLimitFlow(ctx sdk.Context, flowPrefix []byte, tokenContract types.EthAddress, height uint64, amount sdk.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "unable to marshal rate limit flow"))
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetRateLimitFlowKey(flowPrefix, tokenContract, height), bz)
+}
+
+// clearRateLimitFlows deletes every recorded flow of tokenContract in the direction selected by flowPrefix
+func (k Keeper) clearRateLimitFlows(ctx sdk.Context, flowPrefix []byte, tokenContract types.EthAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRateLimitFlowPrefix(flowPrefix, tokenContract))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// IterateRateLimitFlows iterates over the recorded flows in the direction selected by flowPrefix, grouped by token
+// and in height order
+func (k Keeper) IterateRateLimitFlows(ctx sdk.Context, flowPrefix []byte, cb func(tokenContract types.EthAddress, height uint64, amount sdk.Int) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), flowPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// the key is the 20 byte token contract followed by the height
+		tokenContract, err := types.NewEthAddressFromBytes(iter.Key()[:20])
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid token contract in rate limit flow key"))
+		}
+		var amount sdk.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(sdkerrors.Wrap(err, "invalid rate limit flow in store"))
+		}
+		if cb(*tokenContract, types.UInt64FromBytes(iter.Key()[20:]), amount) {
+			break
+		}
+	}
+}
+
+// getRateLimitFlow returns the amount of tokenContract which has flowed in the direction selected by flowPrefix
+// within the last window blocks, including the current block
+func (k Keeper) getRateLimitFlow(ctx sdk.Context, flowPrefix []byte, tokenContract types.EthAddress, window uint64) sdk.Int {
+	height := uint64(ctx.BlockHeight())
+	start := uint64(0)
+	if height >= window {
+		start = height - window + 1
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRateLimitFlowPrefix(flowPrefix, tokenContract))
+	iter := prefixStore.Iterator(types.UInt64Bytes(start), nil)
+	defer iter.Close()
+
+	total := sdk.ZeroInt()
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(sdkerrors.Wrap(err, "invalid rate limit flow in store"))
+		}
+		total = total.Add(amount)
+	}
+	return total
+}
+
+// remainingCapacity returns how much more may flow given a limit and the amount already used, a zero limit
+// means the direction is not limited and a zero capacity is returned
+func remainingCapacity(limit sdk.Int, used sdk.Int) sdk.Int {
+	if limit.IsZero() || used.GTE(limit) {
+		return sdk.ZeroInt()
+	}
+	return limit.Sub(used)
+}
+
+// withinLimit decides if amount may flow given a limit and the amount already used. An amount larger than the
+// limit itself never fits, withdrawals of that size are rejected
+func withinLimit(limit sdk.Int, used sdk.Int, amount sdk.Int) bool {
+	return limit.IsZero() || used.Add(amount).LTE(limit)
+}
+
+// withinInflowLimit decides if a deposit of amount may be credited given the inflow limit and the inflow already
+// used. The tokens of a deposit are already locked on Ethereum, so one larger than the limit itself is credited
+// alone once nothing else has flowed in within the window rather than blocking the deposits behind it forever
+func withinInflowLimit(limit sdk.Int, used sdk.Int, amount sdk.Int) bool {
+	return used.IsZero() || withinLimit(limit, used, amount)
+}
+
+// checkOutflowRateLimit returns an error if sending amount of tokenContract to Ethereum would exceed its outflow limit
+func (k Keeper) checkOutflowRateLimit(ctx sdk.Context, tokenContract types.EthAddress, amount sdk.Int) error {
+	limit, found := k.GetParams(ctx).GetRateLimit(tokenContract)
+	if !found {
+		return nil
+	}
+	used := k.getRateLimitFlow(ctx, types.RateLimitOutflowKey, tokenContract, limit.Window)
+	if !withinLimit(limit.OutflowLimit, used, amount) {
+		return sdkerrors.Wrapf(types.ErrRateLimited,
+			"sending %s of %s would exceed the outflow limit of %s per %d blocks, remaining capacity is %s",
+			amount, tokenContract.GetAddress().Hex(), limit.OutflowLimit, limit.Window, remainingCapacity(limit.OutflowLimit, used))
+	}
+	return nil
+}
+
+// inflowAllowed returns true if a deposit of amount of tokenContract may be credited now. Deposits are never
+// allowed to skip ahead of deposits of the same token which are already queued or paused
+func (k Keeper) inflowAllowed(ctx sdk.Context, tokenContract types.EthAddress, amount sdk.Int) bool {
+	if k.hasDeposits(ctx, types.GetRateLimitedDepositPrefix(tokenContract)) || k.hasDeposits(ctx, types.GetPausedDepositPrefix(tokenContract)) {
+		return false
+	}
+	limit, found := k.GetParams(ctx).GetRateLimit(tokenContract)
+	if !found {
+		return true
+	}
+	used := k.getRateLimitFlow(ctx, types.RateLimitInflowKey, tokenContract, limit.Window)
+	return withinInflowLimit(limit.InflowLimit, used, amount)
+}
+
+// hasDeposits returns true if any deposit is stored under depositPrefix, without loading them
+func (k Keeper) hasDeposits(ctx sdk.Context, depositPrefix []byte) bool {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), depositPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// setRateLimitedDeposit queues an observed deposit until there is enough inflow capacity to credit it
+func (k Keeper) setRateLimitedDeposit(ctx sdk.Context, claim types.MsgSendToCosmosClaim) {
+	tokenContract, err := types.NewEthAddress(claim.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid token contract on rate limited deposit"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRateLimitedDepositKey(*tokenContract, claim.EventNonce), k.cdc.MustMarshal(&claim))
+}
+
+// GetRateLimitedDeposits returns the deposits of tokenContract waiting for inflow capacity, in event nonce order
+func (k Keeper) GetRateLimitedDeposits(ctx sdk.Context, tokenContract types.EthAddress) (out []types.MsgSendToCosmosClaim) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRateLimitedDepositPrefix(tokenContract))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.MsgSendToCosmosClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		out = append(out, claim)
+	}
+	return out
+}
+
+// IterateRateLimitedDeposits iterates over every queued deposit, grouped by token and in event nonce order
+func (k Keeper) IterateRateLimitedDeposits(ctx sdk.Context, cb func(types.MsgSendToCosmosClaim) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitedDepositKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.MsgSendToCosmosClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		// cb returns true to stop early
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+// ProcessRateLimitedDeposits credits queued deposits in order for as long as each token has inflow capacity and
+// is not paused, once a deposit does not fit the remaining deposits of that token stay queued so that order is
+// preserved. Paused deposits of tokens which are no longer paused join the queue first. A deposit which fails to
+// be credited stays queued, holding back the deposits behind it, and is retried in the next block, the tokens are
+// locked on Ethereum so it must never be dropped
+func (k Keeper) ProcessRateLimitedDeposits(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	k.releasePausedDeposits(ctx, params)
+
+	var queued []types.MsgSendToCosmosClaim
+	k.IterateRateLimitedDeposits(ctx, func(claim types.MsgSendToCosmosClaim) bool {
+		queued = append(queued, claim)
+		return false
+	})
+
+	handler := AttestationHandler{keeper: &k}
+	blocked := make(map[string]bool)
+	for _, claim := range queued {
+		// the token contract was validated when the deposit was queued
+		tokenContract, _ := types.NewEthAddress(claim.TokenContract)
+		if blocked[tokenContract.GetAddress().Hex()] {
+			continue
+		}
+		if params.DepositsPaused(*tokenContract) {
+			blocked[tokenContract.GetAddress().Hex()] = true
+			continue
+		}
+		if limit, found := params.GetRateLimit(*tokenContract); found {
+			used := k.getRateLimitFlow(ctx, types.RateLimitInflowKey, *tokenContract, limit.Window)
+			if !withinInflowLimit(limit.InflowLimit, used, claim.Amount) {
+				blocked[tokenContract.GetAddress().Hex()] = true
+				continue
+			}
+		}
+
+		// credit in a cache context like processAttestation, the deposit only leaves the queue if it succeeds
+		xCtx, commit := ctx.CacheContext()
+		xCtx.KVStore(k.storeKey).Delete(types.GetRateLimitedDepositKey(*tokenContract, claim.EventNonce))
+		if err := handler.creditSendToCosmos(xCtx, claim); err != nil {
+			k.logger(ctx).Error("rate limited deposit failed, keeping it queued",
+				"cause", err.Error(),
+				"token", claim.TokenContract,
+				"nonce", fmt.Sprint(claim.EventNonce),
+			)
+			blocked[tokenContract.GetAddress().Hex()] = true
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
+}
+
+// GetRateLimitCapacities returns the used and remaining inflow and outflow capacity of every rate limited token,
+// or only of tokenContract if it is not nil
+func (k Keeper) GetRateLimitCapacities(ctx sdk.Context, tokenContract *types.EthAddress) (out []types.RateLimitCapacity) {
+	for _, limit := range k.GetParams(ctx).RateLimits {
+		contract, err := types.NewEthAddress(limit.TokenContract)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid rate limit token contract in params"))
+		}
+		if tokenContract != nil && *contract != *tokenContract {
+			continue
+		}
+		inflow := k.getRateLimitFlow(ctx, types.RateLimitInflowKey, *contract, limit.Window)
+		outflow := k.getRateLimitFlow(ctx, types.RateLimitOutflowKey, *contract, limit.Window)
+		out = append(out, types.RateLimitCapacity{
+			TokenContract:    contract.GetAddress().Hex(),
+			Window:           limit.Window,
+			InflowLimit:      limit.InflowLimit,
+			InflowUsed:       inflow,
+			InflowRemaining:  remainingCapacity(limit.InflowLimit, inflow),
+			OutflowLimit:     limit.OutflowLimit,
+			OutflowUsed:      outflow,
+			OutflowRemaining: remainingCapacity(limit.OutflowLimit, outflow),
+			QueuedDeposits:   k.GetRateLimitedDeposits(ctx, *contract),
+		})
+	}
+	return out
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that transfers to Ethereum over the outflow limit are rejected until the window moves on
+func TestOutflowRateLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.RateLimits = []types.TokenRateLimit{{
+		TokenContract:           myTokenContractAddr,
+		Window:                  10,
+		InflowLimit:             sdk.ZeroInt(),
+		OutflowLimit:            sdk.NewInt(250),
+		CircuitBreakerThreshold: sdk.ZeroInt(),
+	}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	voucher := func(amount int64) sdk.Coin {
+		token, err := types.NewInternalERC20Token(sdk.NewInt(amount), myTokenContractAddr)
+		require.NoError(t, err)
+		return token.GravityCoin()
+	}
+
+	// only the amount counts against the limit, not the fee
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, voucher(100), voucher(10))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, voucher(100), voucher(10))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, voucher(100), voucher(10))
+	require.ErrorIs(t, err, types.ErrRateLimited)
+
+	capacities := input.GravityKeeper.GetRateLimitCapacities(ctx, tokenContract)
+	require.Len(t, capacities, 1)
+	require.Equal(t, sdk.NewInt(200), capacities[0].OutflowUsed)
+	require.Equal(t, sdk.NewInt(50), capacities[0].OutflowRemaining)
+	require.Equal(t, sdk.ZeroInt(), capacities[0].InflowRemaining)
+
+	// once the first transfer leaves the window there is room again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, voucher(100), voucher(10))
+	require.NoError(t, err)
+	capacities = input.GravityKeeper.GetRateLimitCapacities(ctx, nil)
+	require.Len(t, capacities, 1)
+	require.Equal(t, sdk.NewInt(200), capacities[0].OutflowUsed)
+
+	// other tokens are not limited
+	otherToken, err := types.NewInternalERC20Token(sdk.NewInt(1000), "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{otherToken.GravityCoin()}))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.Coins{otherToken.GravityCoin()}))
+	otherFee, err := types.NewInternalERC20Token(sdk.NewInt(1), "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	otherAmount, err := types.NewInternalERC20Token(sdk.NewInt(500), "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, otherAmount.GravityCoin(), otherFee.GravityCoin())
+	require.NoError(t, err)
+}
+
+// Tests that deposits over the inflow limit are queued, in order, and credited once capacity frees up
+func TestInflowRateLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		myReceiver, _       = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	denom := types.GravityDenom(*tokenContract)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.RateLimits = []types.TokenRateLimit{{
+		TokenContract:           myTokenContractAddr,
+		Window:                  10,
+		InflowLimit:             sdk.NewInt(100),
+		OutflowLimit:            sdk.ZeroInt(),
+		CircuitBreakerThreshold: sdk.ZeroInt(),
+	}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	deposit := func(nonce uint64, amount int64) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  myTokenContractAddr,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: myReceiver.String(),
+		}
+		require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	balance := func() sdk.Int {
+		return input.BankKeeper.GetBalance(ctx, myReceiver, denom).Amount
+	}
+
+	deposit(1, 60)
+	deposit(2, 60) // over the limit, queued
+	deposit(3, 10) // would fit, but may not skip the queue
+	require.Equal(t, sdk.NewInt(60), balance())
+	require.Len(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract), 2)
+
+	capacities := input.GravityKeeper.GetRateLimitCapacities(ctx, tokenContract)
+	require.Len(t, capacities, 1)
+	require.Equal(t, sdk.NewInt(40), capacities[0].InflowRemaining)
+	require.Len(t, capacities[0].QueuedDeposits, 2)
+
+	// nothing is released while the window is full
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Equal(t, sdk.NewInt(60), balance())
+
+	// once the first deposit leaves the window both queued deposits fit
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Equal(t, sdk.NewInt(130), balance())
+	require.Empty(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract))
+
+	// a deposit larger than the limit is credited alone once nothing else has flowed in within the window
+	deposit(4, 500)
+	deposit(5, 10)
+	require.Equal(t, sdk.NewInt(130), balance())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Equal(t, sdk.NewInt(630), balance())
+	require.Len(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract), 1)
+
+	// the deposits behind it wait for it to leave the window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Equal(t, sdk.NewInt(640), balance())
+	require.Empty(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract))
+}
+
+// Tests that a single withdrawal larger than the limit is refused even when nothing else has flowed within the
+// window, that a deposit larger than the limit is credited alone once the window is empty, and that a queued
+// deposit which can not be credited is kept rather than dropped and holds back the deposits behind it
+func TestRateLimitOversizedTransfers(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	denom := types.GravityDenom(*tokenContract)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.RateLimits = []types.TokenRateLimit{{
+		TokenContract:           myTokenContractAddr,
+		Window:                  10,
+		InflowLimit:             sdk.NewInt(100),
+		OutflowLimit:            sdk.NewInt(100),
+		CircuitBreakerThreshold: sdk.ZeroInt(),
+	}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// outflow: the window is empty but the transfer alone exceeds the limit
+	vouchers := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000)))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, vouchers))
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, sdk.NewCoin(denom, sdk.NewInt(500)), sdk.NewCoin(denom, sdk.NewInt(1)))
+	require.ErrorIs(t, err, types.ErrRateLimited)
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+
+	// inflow: a deposit which alone exceeds the limit waits for the window to empty
+	deposit := func(nonce uint64, amount int64, sender string) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  myTokenContractAddr,
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: sender,
+			CosmosReceiver: mySender.String(),
+		}
+		require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+	}
+	sender := "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+	deposit(1, 50, sender)
+	deposit(2, 500, sender)
+	require.Len(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract), 1)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Len(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract), 1)
+	require.Equal(t, sdk.NewInt(1050), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	require.Empty(t, input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract))
+	require.Equal(t, sdk.NewInt(1550), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	// a queued deposit which fails to be credited stays queued, and so do the deposits behind it
+	deposit(3, 10, "not an ethereum address")
+	deposit(4, 10, sender)
+	params.RateLimits[0].InflowLimit = sdk.NewInt(1000)
+	input.GravityKeeper.SetParams(ctx, params)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	queued := input.GravityKeeper.GetRateLimitedDeposits(ctx, *tokenContract)
+	require.Len(t, queued, 2)
+	require.Equal(t, uint64(3), queued[0].EventNonce)
+	require.Equal(t, uint64(4), queued[1].EventNonce)
+	require.Equal(t, sdk.NewInt(1550), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// this file contains code which records the Ethereum transactions behind observed claims, so that a
+// deposit or a batch execution can be traced from the Ethereum tx hash a user has at hand
+
+// setDepositReceipt records what an observed deposit resulted in under the Ethereum tx hash reported
+// with the claim, claims without a tx hash are not indexed
+func (k Keeper) setDepositReceipt(ctx sdk.Context, claim types.MsgSendToCosmosClaim, coin sdk.Coin, status string) {
+	if claim.EthTxHash == "" {
+		return
+	}
+	claimHash, err := claim.ClaimHash()
+	if err != nil {
+		panic(err)
+	}
+	k.storeDepositReceipt(ctx, types.DepositReceipt{
+		EthTxHash:      claim.EthTxHash,
+		EventNonce:     claim.EventNonce,
+		ClaimHash:      hex.EncodeToString(claimHash),
+		CosmosReceiver: claim.CosmosReceiver,
+		Amount:         coin,
+		Status:         status,
+		UpdatedHeight:  uint64(ctx.BlockHeight()),
+	})
+}
+
+// storeDepositReceipt stores a receipt under the Ethereum tx hash and event nonce it records
+func (k Keeper) storeDepositReceipt(ctx sdk.Context, receipt types.DepositReceipt) {
+	key := types.GetDepositReceiptKey(gethcommon.HexToHash(receipt.EthTxHash), receipt.EventNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&receipt))
+}
+
+// IterateDepositReceipts iterates over the receipts of every deposit which reported its Ethereum tx hash
+func (k Keeper) IterateDepositReceipts(ctx sdk.Context, cb func(types.DepositReceipt) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepositReceiptKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var receipt types.DepositReceipt
+		k.cdc.MustUnmarshal(iter.Value(), &receipt)
+		if cb(receipt) {
+			break
+		}
+	}
+}
+
+// GetDepositReceipts returns the receipts of every deposit observed from the given Ethereum transaction,
+// in event nonce order
+func (k Keeper) GetDepositReceipts(ctx sdk.Context, ethTxHash gethcommon.Hash) (out []types.DepositReceipt) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDepositReceiptPrefix(ethTxHash))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var receipt types.DepositReceipt
+		k.cdc.MustUnmarshal(iter.Value(), &receipt)
+		out = append(out, receipt)
+	}
+	return out
+}
+
+// setBatchExecution records the Ethereum transaction which executed a batch as reported by the observed claim
+func (k Keeper) setBatchExecution(ctx sdk.Context, claim types.MsgBatchSendToEthClaim, tokenContract types.EthAddress) {
+	k.storeBatchExecution(ctx, types.BatchExecution{
+		TokenContract:     tokenContract.GetAddress().Hex(),
+		BatchNonce:        claim.BatchNonce,
+		EthTxHash:         claim.EthTxHash,
+		EthBlockHeight:    claim.BlockHeight,
+		EventNonce:        claim.EventNonce,
+		CosmosBlockHeight: uint64(ctx.BlockHeight()),
+	})
+}
+
+// storeBatchExecution stores an execution under the token contract and nonce of the batch it records
+func (k Keeper) storeBatchExecution(ctx sdk.Context, execution types.BatchExecution) {
+	tokenContract, err := types.NewEthAddress(execution.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid token contract in batch execution"))
+	}
+	key := types.GetBatchExecutionKey(*tokenContract, execution.BatchNonce)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&execution))
+}
+
+// IterateBatchExecutions iterates over the recorded executions of every batch, grouped by token contract
+func (k Keeper) IterateBatchExecutions(ctx sdk.Context, cb func(types.BatchExecution) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchExecutionKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var execution types.BatchExecution
+		k.cdc.MustUnmarshal(iter.Value(), &execution)
+		if cb(execution) {
+			break
+		}
+	}
+}
+
+// GetBatchExecution returns the execution of the given batch, or nil if it has not been observed executed
+func (k Keeper) GetBatchExecution(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) *types.BatchExecution {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBatchExecutionKey(tokenContract, nonce))
+	if len(bz) == 0 {
+		return nil
+	}
+	var execution types.BatchExecution
+	k.cdc.MustUnmarshal(bz, &execution)
+	return &execution
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that deposits reporting their Ethereum tx hash can be traced from the hash to their attestation and credit
+func TestDepositReceipts(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		myReceiver, _       = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		ethTxHash           = "0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	amount := sdk.NewInt64Coin(types.GravityDenom(*tokenContract), 50)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.BridgePauses = []types.BridgePause{{TokenContract: myTokenContractAddr, Deposits: true}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// two deposits made by the same transaction and one which does not report its tx hash
+	claims := make([]types.MsgSendToCosmosClaim, 3)
+	for i := range claims {
+		claims[i] = types.MsgSendToCosmosClaim{
+			EventNonce:     uint64(i + 1),
+			BlockHeight:    10,
+			TokenContract:  myTokenContractAddr,
+			Amount:         amount.Amount,
+			EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+			CosmosReceiver: myReceiver.String(),
+			EthTxHash:      ethTxHash,
+		}
+	}
+	claims[2].EthTxHash = ""
+	for i := range claims {
+		require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claims[i]))
+	}
+
+	query := func() []types.DepositReceipt {
+		res, err := input.GravityKeeper.DepositReceipts(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsRequest{EthTxHash: ethTxHash})
+		require.NoError(t, err)
+		return res.Receipts
+	}
+	receipts := query()
+	require.Len(t, receipts, 2)
+	for i, receipt := range receipts {
+		claimHash, err := claims[i].ClaimHash()
+		require.NoError(t, err)
+		require.Equal(t, types.DepositReceipt{
+			EthTxHash:      ethTxHash,
+			EventNonce:     uint64(i + 1),
+			ClaimHash:      hex.EncodeToString(claimHash),
+			CosmosReceiver: myReceiver.String(),
+			Amount:         amount,
+			Status:         types.DepositReceiptQueued,
+			UpdatedHeight:  uint64(ctx.BlockHeight()),
+		}, receipt)
+	}
+
+	// releasing the queued deposits updates their receipts
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	params.BridgePauses = []types.BridgePause{}
+	input.GravityKeeper.SetParams(ctx, params)
+	input.GravityKeeper.ProcessRateLimitedDeposits(ctx)
+	for _, receipt := range query() {
+		require.Equal(t, types.DepositReceiptCredited, receipt.Status)
+		require.Equal(t, uint64(ctx.BlockHeight()), receipt.UpdatedHeight)
+	}
+
+	_, err = input.GravityKeeper.DepositReceipts(sdk.WrapSDKContext(ctx), &types.QueryDepositReceiptsRequest{EthTxHash: "0x1234"})
+	require.Error(t, err)
+}
+
+// Tests that the Ethereum tx reported by a batch execution claim is recorded against the batch
+func TestBatchExecution(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom                  = types.GravityDenom(*myTokenContractAddr)
+		allVouchers            = sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+		ethTxHash              = "0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1"
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	_, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 1))
+	require.NoError(t, err)
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	require.NoError(t, err)
+
+	request := &types.QueryBatchExecutionRequest{TokenContract: myTokenContractAddr.GetAddress().Hex(), BatchNonce: batch.BatchNonce}
+	_, err = input.GravityKeeper.BatchExecution(sdk.WrapSDKContext(ctx), request)
+	require.Error(t, err)
+
+	claim := types.MsgBatchSendToEthClaim{
+		EventNonce:    1,
+		BlockHeight:   1234,
+		BatchNonce:    batch.BatchNonce,
+		TokenContract: myTokenContractAddr.GetAddress().Hex(),
+		EthTxHash:     ethTxHash,
+	}
+	require.NoError(t, input.GravityKeeper.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+
+	res, err := input.GravityKeeper.BatchExecution(sdk.WrapSDKContext(ctx), request)
+	require.NoError(t, err)
+	require.Equal(t, types.BatchExecution{
+		TokenContract:     myTokenContractAddr.GetAddress().Hex(),
+		BatchNonce:        batch.BatchNonce,
+		EthTxHash:         ethTxHash,
+		EthBlockHeight:    1234,
+		EventNonce:        1,
+		CosmosBlockHeight: uint64(ctx.BlockHeight()),
+	}, res.Execution)
+}
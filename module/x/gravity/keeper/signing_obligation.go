@@ -0,0 +1,290 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//   SIGNING OBLIGATIONS   //
+/////////////////////////////
+
+// SetValidatorBondedHeight records the block height at which a validator most recently entered the bonded set,
+// this is updated every time a validator is bonded, including when it returns from being jailed
+func (k Keeper) SetValidatorBondedHeight(ctx sdk.Context, validator sdk.ValAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondedHeightKey(validator), types.UInt64Bytes(height))
+}
+
+// GetValidatorBondedHeight returns the block height at which a validator most recently entered the bonded set,
+// validators bonded before this value was tracked will not be found
+func (k Keeper) GetValidatorBondedHeight(ctx sdk.Context, validator sdk.ValAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetValidatorBondedHeightKey(validator))
+	if len(bytes) == 0 {
+		return 0, false
+	}
+	return types.UInt64FromBytes(bytes), true
+}
+
+// IterateValidatorBondedHeights iterates over the validators which have a recorded bonded height
+func (k Keeper) IterateValidatorBondedHeights(ctx sdk.Context, cb func(validator sdk.ValAddress, height uint64) (stop bool)) {
+	k.iterateValidatorHeights(ctx, types.ValidatorBondedHeightKey, cb)
+}
+
+// SetDelegateKeyRegistrationHeight records the block height at which a validator registered its delegate keys
+func (k Keeper) SetDelegateKeyRegistrationHeight(ctx sdk.Context, validator sdk.ValAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegateKeyRegistrationHeightKey(validator), types.UInt64Bytes(height))
+}
+
+// GetDelegateKeyRegistrationHeight returns the block height at which a validator registered its delegate keys,
+// keys registered before this value was tracked (or set in genesis) will not be found
+func (k Keeper) GetDelegateKeyRegistrationHeight(ctx sdk.Context, validator sdk.ValAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetDelegateKeyRegistrationHeightKey(validator))
+	if len(bytes) == 0 {
+		return 0, false
+	}
+	return types.UInt64FromBytes(bytes), true
+}
+
+// IterateDelegateKeyRegistrationHeights iterates over the validators which have a recorded delegate key
+// registration height
+func (k Keeper) IterateDelegateKeyRegistrationHeights(ctx sdk.Context, cb func(validator sdk.ValAddress, height uint64) (stop bool)) {
+	k.iterateValidatorHeights(ctx, types.DelegateKeyRegistrationHeightKey, cb)
+}
+
+// iterateValidatorHeights iterates over a store of heights keyed by validator address
+func (k Keeper) iterateValidatorHeights(ctx sdk.Context, heightPrefix []byte, cb func(validator sdk.ValAddress, height uint64) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), heightPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		validator, _ := types.SplitLengthPrefixed(iter.Key())
+		if cb(validator, types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
+
+// SignatureOwed is the single place where we decide if a validator was obligated to sign a valset, batch or
+// logic call created at itemHeight. Slashing and the MissedSignatures query both use this so that validators
+// can predict exactly what they will be slashed for. A signature is owed only if all of the following hold:
+//   - the validator is not jailed, a jailed validator has already been punished and is not in the active set
+//   - the validator is bonded, or is unbonding and the item is a valset created within
+//     UnbondSlashingValsetsWindow blocks of the validator starting to unbond
+//   - the validator's slashing signing info started before the item was created
+//   - the validator was last bonded (for example after being unjailed) before the item was created
+//   - the validator's delegate keys, if registered while tracked, were registered before the item was created
+func (k Keeper) SignatureOwed(ctx sdk.Context, params types.Params, val stakingtypes.Validator, signType types.SignType, itemHeight uint64) bool {
+	if val.IsJailed() {
+		return false
+	}
+
+	switch {
+	case val.IsBonded():
+	case val.IsUnbonding():
+		// validator set updates must succeed as validators leave the set, other items will just be re-created
+		if signType != types.SIGN_TYPE_ORCHESTRATOR_SIGNED_MULTI_SIG_UPDATE {
+			return false
+		}
+		if itemHeight >= uint64(val.UnbondingHeight)+params.UnbondSlashingValsetsWindow {
+			return false
+		}
+	default:
+		return false
+	}
+
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "failed to get validator consensus addr"))
+	}
+	valSigningInfo, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found || valSigningInfo.StartHeight >= int64(itemHeight) {
+		return false
+	}
+
+	if bondedHeight, found := k.GetValidatorBondedHeight(ctx, val.GetOperator()); found && bondedHeight >= itemHeight {
+		return false
+	}
+	if registrationHeight, found := k.GetDelegateKeyRegistrationHeight(ctx, val.GetOperator()); found && registrationHeight >= itemHeight {
+		return false
+	}
+
+	return true
+}
+
+// SignatureSlashingHeight returns the first block height at which a validator may be slashed for not signing
+// an item of the given type created at itemHeight
+func SignatureSlashingHeight(params types.Params, signType types.SignType, itemHeight uint64) uint64 {
+	switch signType {
+	case types.SIGN_TYPE_ORCHESTRATOR_SIGNED_MULTI_SIG_UPDATE:
+		// valset slashing does not start until SignedValsetsWindow blocks have passed
+		if itemHeight == 0 {
+			return params.SignedValsetsWindow + 1
+		}
+		return itemHeight + params.SignedValsetsWindow
+	case types.SIGN_TYPE_ORCHESTRATOR_SIGNED_WITHDRAW_BATCH:
+		return itemHeight + params.SignedBatchesWindow + 1
+	case types.SIGN_TYPE_ORCHESTRATOR_SIGNED_LOGIC_CALL:
+		return itemHeight + params.SignedLogicCallsWindow + 1
+	default:
+		panic("unknown sign type")
+	}
+}
+
+// GetMissedSignatures returns every valset, batch and logic call currently in the store which the given validator
+// owes a signature for but has not yet signed. Items the slashing cursors have already moved past are skipped,
+// the validator can no longer be slashed for them
+func (k Keeper) GetMissedSignatures(ctx sdk.Context, val stakingtypes.Validator) (out []types.MissedSignature) {
+	params := k.GetParams(ctx)
+	orchestrator, hasOrchestrator := k.GetOrchestratorAddressByValidator(ctx, val.GetOperator())
+	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
+	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
+	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
+
+	for _, vs := range k.GetValsets(ctx) {
+		signType := types.SIGN_TYPE_ORCHESTRATOR_SIGNED_MULTI_SIG_UPDATE
+		if vs.Nonce <= lastSlashedValsetNonce || !k.SignatureOwed(ctx, params, val, signType, vs.Height) {
+			continue
+		}
+		if hasOrchestrator && k.GetValsetConfirm(ctx, vs.Nonce, orchestrator) != nil {
+			continue
+		}
+		out = append(out, types.MissedSignature{
+			SignType:       signType,
+			Nonce:          vs.Nonce,
+			CreatedHeight:  vs.Height,
+			SlashingHeight: SignatureSlashingHeight(params, signType, vs.Height),
+		})
+	}
+
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		signType := types.SIGN_TYPE_ORCHESTRATOR_SIGNED_WITHDRAW_BATCH
+		if batch.Block <= lastSlashedBatchBlock || !k.SignatureOwed(ctx, params, val, signType, batch.Block) {
+			continue
+		}
+		if hasOrchestrator && k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, orchestrator) != nil {
+			continue
+		}
+		out = append(out, types.MissedSignature{
+			SignType:       signType,
+			Nonce:          batch.BatchNonce,
+			TokenContract:  batch.TokenContract.GetAddress().Hex(),
+			CreatedHeight:  batch.Block,
+			SlashingHeight: SignatureSlashingHeight(params, signType, batch.Block),
+		})
+	}
+
+	for _, call := range k.GetOutgoingLogicCalls(ctx) {
+		signType := types.SIGN_TYPE_ORCHESTRATOR_SIGNED_LOGIC_CALL
+		if call.Block <= lastSlashedLogicCallBlock || !k.SignatureOwed(ctx, params, val, signType, call.Block) {
+			continue
+		}
+		if hasOrchestrator && k.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, orchestrator) != nil {
+			continue
+		}
+		out = append(out, types.MissedSignature{
+			SignType:       signType,
+			Nonce:          call.InvalidationNonce,
+			InvalidationId: call.InvalidationId,
+			CreatedHeight:  call.Block,
+			SlashingHeight: SignatureSlashingHeight(params, signType, call.Block),
+		})
+	}
+
+	return out
+}
+
+// SetBridgeSlashingEvent records that a validator was slashed by the gravity module in the current block
+func (k Keeper) SetBridgeSlashingEvent(ctx sdk.Context, validator sdk.ValAddress, slashType string) {
+	k.storeBridgeSlashingEvent(ctx, types.BridgeSlashingEvent{
+		Validator:   validator.String(),
+		SlashType:   slashType,
+		BlockHeight: uint64(ctx.BlockHeight()),
+	})
+}
+
+// storeBridgeSlashingEvent stores a slashing event under the validator, height and slash type it records
+func (k Keeper) storeBridgeSlashingEvent(ctx sdk.Context, event types.BridgeSlashingEvent) {
+	validator, err := sdk.ValAddressFromBech32(event.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator in bridge slashing event"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBridgeSlashingEventKey(validator, event.BlockHeight, event.SlashType), k.cdc.MustMarshal(&event))
+}
+
+// BridgeSlashingEventPruneLimit is the most slashing events the EndBlocker prunes in a single block, shortening
+// the retention is spread over several blocks
+const BridgeSlashingEventPruneLimit = 100
+
+// PruneBridgeSlashingEvents deletes the slashing events recorded more than Params.BridgeSlashingEventRetention
+// blocks ago, at most BridgeSlashingEventPruneLimit of them per call. The events of each validator are ordered by
+// height, so only the expired events and the first kept event of every validator are visited
+func (k Keeper) PruneBridgeSlashingEvents(ctx sdk.Context) {
+	retention := k.GetParams(ctx).BridgeSlashingEventRetention
+	if retention == 0 || uint64(ctx.BlockHeight()) <= retention {
+		return
+	}
+	cutoff := uint64(ctx.BlockHeight()) - retention
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BridgeSlashingEventKey)
+	var expired [][]byte
+	for start := []byte(nil); len(expired) < BridgeSlashingEventPruneLimit; {
+		iter := prefixStore.Iterator(start, nil)
+		if !iter.Valid() {
+			iter.Close()
+			break
+		}
+		validator, _ := types.SplitLengthPrefixed(iter.Key())
+		validatorPrefix := address.MustLengthPrefix(validator)
+		for ; iter.Valid() && bytes.HasPrefix(iter.Key(), validatorPrefix); iter.Next() {
+			_, rest := types.SplitLengthPrefixed(iter.Key())
+			if types.UInt64FromBytes(rest[:8]) >= cutoff || len(expired) == BridgeSlashingEventPruneLimit {
+				break
+			}
+			expired = append(expired, iter.Key())
+		}
+		iter.Close()
+		start = sdk.PrefixEndBytes(validatorPrefix)
+	}
+	for _, key := range expired {
+		prefixStore.Delete(key)
+	}
+}
+
+// IterateBridgeSlashingEvents iterates over the recorded gravity slashing events of every validator
+func (k Keeper) IterateBridgeSlashingEvents(ctx sdk.Context, cb func(types.BridgeSlashingEvent) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BridgeSlashingEventKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event types.BridgeSlashingEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		if cb(event) {
+			break
+		}
+	}
+}
+
+// GetBridgeSlashingEvents returns every recorded gravity slashing event for a validator, oldest first
+func (k Keeper) GetBridgeSlashingEvents(ctx sdk.Context, validator sdk.ValAddress) (out []types.BridgeSlashingEvent) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBridgeSlashingEventPrefix(validator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event types.BridgeSlashingEvent
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		out = append(out, event)
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that slashing events older than the retention are pruned for every validator, a limited number per block
+func TestPruneBridgeSlashingEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	params.BridgeSlashingEventRetention = 10
+	k.SetParams(ctx, params)
+
+	// every validator is slashed in each of the first blocks
+	start := ctx.BlockHeight()
+	blocks := BridgeSlashingEventPruneLimit/len(ValAddrs) + 2
+	for i := 0; i < blocks; i++ {
+		for _, val := range ValAddrs {
+			k.SetBridgeSlashingEvent(ctx.WithBlockHeight(start+int64(i)), val, types.AttributeKeyValsetSignatureSlashing)
+		}
+	}
+
+	// nothing has expired yet
+	ctx = ctx.WithBlockHeight(start + 10)
+	k.PruneBridgeSlashingEvents(ctx)
+	for _, val := range ValAddrs {
+		require.Len(t, k.GetBridgeSlashingEvents(ctx, val), blocks)
+	}
+
+	// everything has expired, but only the limit is pruned in a single block
+	ctx = ctx.WithBlockHeight(start + int64(blocks) + 10)
+	k.PruneBridgeSlashingEvents(ctx)
+	remaining := 0
+	for _, val := range ValAddrs {
+		remaining += len(k.GetBridgeSlashingEvents(ctx, val))
+	}
+	require.Equal(t, blocks*len(ValAddrs)-BridgeSlashingEventPruneLimit, remaining)
+
+	k.PruneBridgeSlashingEvents(ctx)
+	for _, val := range ValAddrs {
+		require.Empty(t, k.GetBridgeSlashingEvents(ctx, val))
+	}
+
+	// a zero retention keeps every event
+	params.BridgeSlashingEventRetention = 0
+	k.SetParams(ctx, params)
+	k.SetBridgeSlashingEvent(ctx, ValAddrs[0], types.AttributeKeyValsetSignatureSlashing)
+	k.PruneBridgeSlashingEvents(ctx.WithBlockHeight(ctx.BlockHeight() + 1000))
+	require.Len(t, k.GetBridgeSlashingEvents(ctx, ValAddrs[0]), 1)
+}
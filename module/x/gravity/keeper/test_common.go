@@ -237,6 +237,7 @@ var (
 		SlashFractionBadEthSignature: sdk.NewDecWithPrec(1, 2),
 		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
 		BridgeActive:                 true,
+		ExecutedBatchArchiveSize:     100,
 	}
 )
 
@@ -310,6 +311,183 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 	return input, input.Context
 }
 
+// SetupFullBridgeState sets up a five validator chain with an entry in every part of the gravity store: valsets,
+// batches, logic calls, their confirms, attestations, cosmos originated ERC20s, slashing history, receipts, the
+// executed batch archive, rate limits, the circuit breaker and a Merkle airdrop. Any funds this state escrows are
+// minted to the gravity module account
+func SetupFullBridgeState(t *testing.T) (TestInput, sdk.Context) {
+	t.Helper()
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	gravityID := k.GetGravityID(ctx)
+
+	// valsets, confirms and the evidence checkpoints, including one with bytes which are not valid UTF-8
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	valset.Nonce = 1
+	k.StoreValset(ctx, valset)
+	k.SetLatestValsetNonce(ctx, valset.Nonce)
+	k.SetLastObservedValset(ctx, valset)
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{Nonce: 1, Orchestrator: OrchAddrs[0].String(), EthAddress: EthAddrs[0].String()})
+	k.SetPastEthSignatureCheckpoint(ctx, valset.GetCheckpoint(gravityID))
+	k.SetPastEthSignatureCheckpoint(ctx, []byte{0x00, 0x7f, 0x80, 0xc3, 0xff})
+
+	// unbatched txs and a batch built from them, backed by the module's vouchers
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), TokenContractAddrs[0])
+	require.NoError(t, err)
+	vouchers := sdk.NewCoins(token.GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], vouchers))
+	denom := token.GravityCoin().Denom
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	for i := int64(1); i <= 3; i++ {
+		_, err := k.AddToOutgoingPool(ctx, AccAddrs[0], *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, i))
+		require.NoError(t, err)
+	}
+	batch, err := k.BuildOutgoingTXBatch(ctx, token.Contract, 2)
+	require.NoError(t, err)
+	k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:         batch.BatchNonce,
+		TokenContract: batch.TokenContract.GetAddress().Hex(),
+		EthSigner:     EthAddrs[0].String(),
+		Orchestrator:  OrchAddrs[0].String(),
+		Signature:     "d34db33f",
+	})
+
+	// a logic call and its confirm
+	call := types.OutgoingLogicCall{
+		Transfers:            []types.ERC20Token{types.NewERC20Token(10, TokenContractAddrs[0])},
+		Fees:                 []types.ERC20Token{types.NewERC20Token(1, TokenContractAddrs[0])},
+		LogicContractAddress: EthAddrs[2].String(),
+		Payload:              []byte("payload"),
+		Timeout:              10000,
+		InvalidationId:       []byte("invalidation"),
+		InvalidationNonce:    1,
+		Block:                5,
+	}
+	k.SetOutgoingLogicCall(ctx, call)
+	k.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+		InvalidationId:    fmt.Sprintf("%x", call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+		EthSigner:         EthAddrs[0].String(),
+		Orchestrator:      OrchAddrs[0].String(),
+		Signature:         "d34db33f",
+	})
+
+	// an unobserved attestation, plus a validator nonce which is ahead of every stored attestation
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    10,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(500),
+		EthereumSender: EthAddrs[3].String(),
+		CosmosReceiver: AccAddrs[1].String(),
+		Orchestrator:   OrchAddrs[0].String(),
+	}
+	anyClaim, err := codectypes.NewAnyWithValue(&claim)
+	require.NoError(t, err)
+	hash, err := claim.ClaimHash()
+	require.NoError(t, err)
+	k.SetAttestation(ctx, claim.EventNonce, hash, &types.Attestation{Votes: []string{ValAddrs[0].String()}, Height: 1, Claim: anyClaim})
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[0], claim.EventNonce)
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[1], 7)
+	k.SetLastObservedEthereumBlockHeight(ctx, 1234)
+	k.SetLastUnBondingBlockHeight(ctx, 3)
+
+	// cosmos originated ERC20s, one of which has been deprecated in favor of a new contract
+	newErc20, err := types.NewEthAddress(EthAddrs[3].String())
+	require.NoError(t, err)
+	oldErc20, err := types.NewEthAddress(EthAddrs[4].String())
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, "ufoo", *newErc20)
+	k.setCosmosOriginatedERC20ToDenom(ctx, "ufoo", *oldErc20)
+	k.SetERC20Migration(ctx, types.ERC20Migration{
+		Denom:       "ufoo",
+		OldErc20:    oldErc20.GetAddress().Hex(),
+		NewErc20:    newErc20.GetAddress().Hex(),
+		StartHeight: 2,
+		EndHeight:   200,
+	})
+	k.SetERC20DeploymentRequest(ctx, types.ERC20DeploymentRequest{Denom: "ubar", ApprovedHeight: 4})
+
+	// signing obligations and slashing history
+	k.SetValidatorBondedHeight(ctx, ValAddrs[0], 2)
+	k.SetDelegateKeyRegistrationHeight(ctx, ValAddrs[0], 3)
+	k.SetBridgeSlashingEvent(ctx, ValAddrs[1], "valset_signature_slashing")
+
+	// receipts and the executed batch archive
+	ethTxHash := "0x" + fmt.Sprintf("%064x", 42)
+	k.storeDepositReceipt(ctx, types.DepositReceipt{
+		EthTxHash:      ethTxHash,
+		EventNonce:     claim.EventNonce,
+		ClaimHash:      fmt.Sprintf("%x", hash),
+		CosmosReceiver: claim.CosmosReceiver,
+		Amount:         token.GravityCoin(),
+		Status:         "credited",
+		UpdatedHeight:  5,
+	})
+	k.storeBatchExecution(ctx, types.BatchExecution{
+		TokenContract:     token.Contract.GetAddress().Hex(),
+		BatchNonce:        9,
+		EthTxHash:         ethTxHash,
+		EthBlockHeight:    11,
+		EventNonce:        2,
+		CosmosBlockHeight: 6,
+	})
+	archivedTx := types.OutgoingTransferTx{
+		Id:          20,
+		Sender:      AccAddrs[0].String(),
+		DestAddress: EthAddrs[0].String(),
+		Erc20Token:  types.NewERC20Token(100, TokenContractAddrs[0]),
+		Erc20Fee:    types.NewERC20Token(1, TokenContractAddrs[0]),
+	}
+	k.setExecutedBatch(ctx, 1, types.ExecutedBatch{
+		BatchNonce:     9,
+		TokenContract:  token.Contract.GetAddress().Hex(),
+		Transactions:   []types.OutgoingTransferTx{archivedTx},
+		TotalFees:      sdk.NewInt(1),
+		ExecutedHeight: 6,
+	})
+	k.setID(ctx, 1, types.LastExecutedBatchIDKey)
+
+	// funds in flight through the module, rate limits and the circuit breaker
+	forwarded, err := types.NewInternalERC20Token(sdk.NewInt(50), TokenContractAddrs[1])
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(forwarded.GravityCoin())))
+	forwardedCoin := forwarded.GravityCoin()
+	k.setPendingIbcAutoForward(ctx, types.PendingIbcAutoForward{
+		ForeignReceiver: "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnwu7xpx",
+		Token:           &forwardedCoin,
+		IbcChannel:      "channel-0",
+		EventNonce:      3,
+	})
+	k.setRateLimitFlow(ctx, types.RateLimitInflowKey, token.Contract, 5, sdk.NewInt(700))
+	k.setRateLimitFlow(ctx, types.RateLimitOutflowKey, token.Contract, 6, sdk.NewInt(300))
+	limited := claim
+	limited.EventNonce = 4
+	k.setRateLimitedDeposit(ctx, limited)
+	paused := claim
+	paused.EventNonce = 5
+	k.setPausedDeposit(ctx, paused)
+	k.setCircuitBreakerTrip(ctx, types.CircuitBreakerTrip{Reason: "outflow", TokenContract: TokenContractAddrs[0], BlockHeight: 7})
+
+	// a Merkle airdrop with one claim made, the module holds what remains of it
+	airdrop := types.MerkleAirdrop{
+		Id:         1,
+		MerkleRoot: fmt.Sprintf("%064x", 7),
+		Total:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Claimed:    sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+		EndHeight:  500,
+	}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, airdrop.Remaining()))
+	k.setMerkleAirdrop(ctx, airdrop)
+	k.setID(ctx, airdrop.Id, types.LastMerkleAirdropIDKey)
+	k.setAirdropClaim(ctx, airdrop.Id, AccAddrs[2])
+
+	return input, ctx
+}
+
 // SetupTestChain sets up a test environment with the provided validator voting weights
 func SetupTestChain(t *testing.T, weights []uint64, setDelegateAddresses bool) (TestInput, sdk.Context) {
 	t.Helper()
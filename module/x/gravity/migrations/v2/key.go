@@ -6,6 +6,7 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -146,6 +147,86 @@ var (
 	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
 	// [0x1cbe0be407a979331b98e599eeedd09f]
 	PastEthSignatureCheckpointKey = HashString("PastEthSignatureCheckpointKey")
+
+	// PendingIBCAutoForwards indexes pending SendToCosmos sends via IBC, queued by event nonce
+	// [0x5b89a7c5dc9abd2a7abc2560d6eb42ea]
+	PendingIbcAutoForwards = HashString("IbcAutoForwardQueue")
+
+	// ValidatorBondedHeightKey indexes the block height at which a validator last entered the bonded set
+	// [0x154e0517c49bc95214fa4cb2954342bf]
+	ValidatorBondedHeightKey = HashString("ValidatorBondedHeightKey")
+
+	// DelegateKeyRegistrationHeightKey indexes the block height at which a validator registered its delegate keys
+	// [0xb50fe87086b5a30c69253a25b2ddceb6]
+	DelegateKeyRegistrationHeightKey = HashString("DelegateKeyRegistrationHeightKey")
+
+	// BridgeSlashingEventKey indexes past gravity slashing events by validator and block height
+	// [0xbe71664e671b943c127ca14c9d3c00f3]
+	BridgeSlashingEventKey = HashString("BridgeSlashingEventKey")
+
+	// ERC20DeploymentRequestKey indexes governance approved ERC20 deployment requests by Cosmos originated denom
+	// [0x6f84568c52aac1510e3b43ec729419cd]
+	ERC20DeploymentRequestKey = HashString("ERC20DeploymentRequestKey")
+
+	// ERC20MigrationKey indexes deprecated Cosmos originated ERC20s by their old contract address
+	// [0x4205aa46e80a8786b5e4674dd91d0a7d]
+	ERC20MigrationKey = HashString("ERC20MigrationKey")
+
+	// RateLimitInflowKey indexes the amount of each token credited by deposits at each block height
+	// [0x38cb5d37431ce268edfca0cdd73603b1]
+	RateLimitInflowKey = HashString("RateLimitInflowKey")
+
+	// RateLimitOutflowKey indexes the amount of each token sent to Ethereum at each block height
+	// [0x4c2e39d1348fccf898155147e12e87cb]
+	RateLimitOutflowKey = HashString("RateLimitOutflowKey")
+
+	// RateLimitedDepositKey indexes observed deposits waiting for inflow capacity, by token and event nonce
+	// [0x0eec622b12a318d5138b36f6dfbc2540]
+	RateLimitedDepositKey = HashString("RateLimitedDepositKey")
+
+	// CircuitBreakerTripKey stores the reason the circuit breaker halted the bridge, if it has
+	// [0x360bc4bbd83e53995eb34d45826b1f85]
+	CircuitBreakerTripKey = HashString("CircuitBreakerTripKey")
+
+	// MerkleAirdropKey indexes airdrops whose recipients claim their share with MsgClaimAirdrop, by id
+	// [0xcc5873767cc88bb10e82e7a9d0b2add9]
+	MerkleAirdropKey = HashString("MerkleAirdropKey")
+
+	// AirdropClaimKey indexes the recipients who have claimed from a Merkle airdrop
+	// [0x40180b2a54e171cb6ccd6293268d4b80]
+	AirdropClaimKey = HashString("AirdropClaimKey")
+
+	// LastMerkleAirdropIDKey stores the id of the last Merkle airdrop
+	// [0x9a507366bf3fe596c6a81530e7aeabbd]
+	LastMerkleAirdropIDKey = HashString("LastMerkleAirdropIDKey")
+
+	// DepositReceiptKey indexes the receipts of observed deposits by Ethereum tx hash and event nonce
+	// [0xe248ab20472a0084ef3da7d3ac65d9aa]
+	DepositReceiptKey = HashString("DepositReceiptKey")
+
+	// BatchExecutionKey indexes the executions of batches by token contract and batch nonce
+	// [0x2f2eea34608f9583e9227854d4f888b2]
+	BatchExecutionKey = HashString("BatchExecutionKey")
+
+	// ExecutedBatchKey indexes the executed batch archive by archive id, in the order batches were executed
+	// [0x717e78a404f0b7625609bf5d5f32a621]
+	ExecutedBatchKey = HashString("ExecutedBatchKey")
+
+	// ExecutedBatchByNonceKey indexes the archive id of an executed batch by batch nonce
+	// [0xef6dd3af8da9612fb28a7a96a8ac40ed]
+	ExecutedBatchByNonceKey = HashString("ExecutedBatchByNonceKey")
+
+	// ExecutedBatchByTxIdKey indexes the archive id of an executed batch by the pool ids of its transactions
+	// [0x8f28cfaf4d67e79187e988b5a39de231]
+	ExecutedBatchByTxIdKey = HashString("ExecutedBatchByTxIdKey")
+
+	// LastExecutedBatchIDKey stores the archive id of the last executed batch
+	// [0xa3d9c7beab3be3f206ef99732cdab7c7]
+	LastExecutedBatchIDKey = HashString("LastExecutedBatchIDKey")
+
+	// PausedDepositKey indexes observed deposits of a token whose deposits are paused, by token and event nonce
+	// [0xaf899a83b2aa9c14d5ae86e8fbfe4079]
+	PausedDepositKey = HashString("PausedDepositKey")
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -205,7 +286,7 @@ func GetValsetConfirmKey(nonce uint64, validator sdk.AccAddress) []byte {
 // [0x0][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
 // An attestation is an event multiple people are voting on, this function needs the claim
 // details because each Attestation is aggregating all claims of a specific event, lets say
-// validator X and validator y where making different claims about the same event nonce
+// validator X and validator y were making different claims about the same event nonce
 // Note that the claim hash does NOT include the claimer address and only identifies an event
 func GetAttestationKey(eventNonce uint64, claimHash []byte) []byte {
 	return AppendBytes(OracleAttestationKey, UInt64Bytes(eventNonce), claimHash)
@@ -260,7 +341,7 @@ func GetBatchConfirmKey(tokenContract types.EthAddress, batchNonce uint64, valid
 	return AppendBytes(GetBatchConfirmNonceContractPrefix(tokenContract, batchNonce), validator.Bytes())
 }
 
-// GetLastEventNonceByValidatorKey indexes lateset event nonce by validator
+// GetLastEventNonceByValidatorKey indexes latest event nonce by validator
 // GetLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
 // [0x0][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
@@ -309,3 +390,168 @@ func convertByteArrToString(value []byte) string {
 	}
 	return ret.String()
 }
+
+// GetPendingIbcAutoForwardKey returns the following key format
+// prefix		EventNonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetPendingIbcAutoForwardKey(eventNonce uint64) []byte {
+	return AppendBytes(PendingIbcAutoForwards, UInt64Bytes(eventNonce))
+}
+
+// GetValidatorBondedHeightKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetValidatorBondedHeightKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(ValidatorBondedHeightKey, validator.Bytes())
+}
+
+// GetDelegateKeyRegistrationHeightKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetDelegateKeyRegistrationHeightKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(DelegateKeyRegistrationHeightKey, validator.Bytes())
+}
+
+// GetBridgeSlashingEventKey returns the following key format
+// prefix              cosmos-validator                                    height                slash-type
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1][valset_signature_slashing]
+func GetBridgeSlashingEventKey(validator sdk.ValAddress, height uint64, slashType string) []byte {
+	return AppendBytes(GetBridgeSlashingEventPrefix(validator), UInt64Bytes(height), []byte(slashType))
+}
+
+// GetBridgeSlashingEventPrefix returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetBridgeSlashingEventPrefix(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(BridgeSlashingEventKey, validator.Bytes())
+}
+
+// GetERC20DeploymentRequestKey returns the following key format
+// prefix     denom
+// [0x0][ugraviton]
+func GetERC20DeploymentRequestKey(denom string) []byte {
+	return AppendBytes(ERC20DeploymentRequestKey, []byte(denom))
+}
+
+// GetERC20MigrationKey returns the following key format
+// prefix     old-erc20
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetERC20MigrationKey(oldErc20 types.EthAddress) []byte {
+	return AppendBytes(ERC20MigrationKey, oldErc20.GetAddress().Bytes())
+}
+
+// GetRateLimitFlowPrefix returns the following key format
+// prefix     token-contract
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// where prefix is RateLimitInflowKey or RateLimitOutflowKey
+func GetRateLimitFlowPrefix(prefix []byte, tokenContract types.EthAddress) []byte {
+	return AppendBytes(prefix, tokenContract.GetAddress().Bytes())
+}
+
+// GetRateLimitFlowKey returns the following key format
+// prefix     token-contract                                 height
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+// where prefix is RateLimitInflowKey or RateLimitOutflowKey
+func GetRateLimitFlowKey(prefix []byte, tokenContract types.EthAddress, height uint64) []byte {
+	return AppendBytes(GetRateLimitFlowPrefix(prefix, tokenContract), UInt64Bytes(height))
+}
+
+// GetRateLimitedDepositPrefix returns the following key format
+// prefix     token-contract
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetRateLimitedDepositPrefix(tokenContract types.EthAddress) []byte {
+	return AppendBytes(RateLimitedDepositKey, tokenContract.GetAddress().Bytes())
+}
+
+// GetRateLimitedDepositKey returns the following key format
+// prefix     token-contract                                 event-nonce
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetRateLimitedDepositKey(tokenContract types.EthAddress, eventNonce uint64) []byte {
+	return AppendBytes(GetRateLimitedDepositPrefix(tokenContract), UInt64Bytes(eventNonce))
+}
+
+// GetPausedDepositPrefix returns the following key format
+// prefix     token-contract
+// [0xaf899a83b2aa9c14d5ae86e8fbfe4079][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetPausedDepositPrefix(tokenContract types.EthAddress) []byte {
+	return AppendBytes(PausedDepositKey, tokenContract.GetAddress().Bytes())
+}
+
+// GetPausedDepositKey returns the following key format
+// prefix     token-contract                                 event-nonce
+// [0xaf899a83b2aa9c14d5ae86e8fbfe4079][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetPausedDepositKey(tokenContract types.EthAddress, eventNonce uint64) []byte {
+	return AppendBytes(GetPausedDepositPrefix(tokenContract), UInt64Bytes(eventNonce))
+}
+
+// GetMerkleAirdropKey returns the following key format
+// prefix     id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetMerkleAirdropKey(id uint64) []byte {
+	return AppendBytes(MerkleAirdropKey, UInt64Bytes(id))
+}
+
+// GetAirdropClaimPrefix returns the following key format
+// prefix     id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetAirdropClaimPrefix(id uint64) []byte {
+	return AppendBytes(AirdropClaimKey, UInt64Bytes(id))
+}
+
+// GetAirdropClaimKey returns the following key format
+// prefix     id                       recipient
+// [0x0][0 0 0 0 0 0 0 1][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetAirdropClaimKey(id uint64, recipient sdk.AccAddress) []byte {
+	return AppendBytes(GetAirdropClaimPrefix(id), recipient.Bytes())
+}
+
+// GetDepositReceiptPrefix returns the following key format
+// prefix     eth-tx-hash
+// [0x0][0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1]
+func GetDepositReceiptPrefix(ethTxHash gethcommon.Hash) []byte {
+	return AppendBytes(DepositReceiptKey, ethTxHash.Bytes())
+}
+
+// GetDepositReceiptKey returns the following key format
+// prefix     eth-tx-hash                                                        event-nonce
+// [0x0][0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1][0 0 0 0 0 0 0 1]
+func GetDepositReceiptKey(ethTxHash gethcommon.Hash, eventNonce uint64) []byte {
+	return AppendBytes(GetDepositReceiptPrefix(ethTxHash), UInt64Bytes(eventNonce))
+}
+
+// GetBatchExecutionKey returns the following key format
+// prefix     eth-contract-address                     nonce
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchExecutionKey(tokenContract types.EthAddress, nonce uint64) []byte {
+	return AppendBytes(BatchExecutionKey, tokenContract.GetAddress().Bytes(), UInt64Bytes(nonce))
+}
+
+// GetExecutedBatchKey returns the following key format
+// prefix     archive-id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetExecutedBatchKey(archiveID uint64) []byte {
+	return AppendBytes(ExecutedBatchKey, UInt64Bytes(archiveID))
+}
+
+// GetExecutedBatchByNonceKey returns the following key format
+// prefix     batch-nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetExecutedBatchByNonceKey(nonce uint64) []byte {
+	return AppendBytes(ExecutedBatchByNonceKey, UInt64Bytes(nonce))
+}
+
+// GetExecutedBatchByTxIdKey returns the following key format
+// prefix     tx-id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetExecutedBatchByTxIdKey(txID uint64) []byte {
+	return AppendBytes(ExecutedBatchByTxIdKey, UInt64Bytes(txID))
+}
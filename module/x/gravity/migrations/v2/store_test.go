@@ -10,6 +10,7 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
 	v1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v1"
 	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -69,6 +70,8 @@ func TestMigrateCosmosOriginatedDenomToERC20(t *testing.T) {
 
 	err := v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
+	// the keeper reads the current layout
+	assert.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
 
 	addr, found := input.GravityKeeper.GetCosmosOriginatedERC20(input.Context, denom)
 	assert.True(t, found)
@@ -86,6 +89,8 @@ func TestMigrateCosmosOriginatedERC20ToDenom(t *testing.T) {
 
 	err := v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
+	// the keeper reads the current layout
+	assert.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
 
 	tokenAddr, err := types.NewEthAddress(tokenContract)
 	assert.NoError(t, err)
@@ -114,9 +119,14 @@ func TestMigrateEthAddressByValidator(t *testing.T) {
 
 	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldEthAddressByValidatorKey(validator)), []byte(ethAddr))
 	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldEthAddressByValidatorKey(validator)), []byte(ethAddr))
+	// the reverse index and orchestrator are only set to keep the delegate keys consistent
+	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldGetValidatorByEthAddressKey(gethcommon.HexToAddress(ethAddr).Hex())), []byte(validator))
+	input.Context.KVStore(input.GravityStoreKey).Set([]byte(v1.GetOrchestratorAddressKey(sdk.AccAddress(validator))), []byte(validator))
 
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
+	// the keeper reads the current layout
+	assert.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
 
 	valEthAddr, found := input.GravityKeeper.GetEthAddressByValidator(input.Context, validator)
 	assert.True(t, found)
@@ -138,9 +148,16 @@ func TestMigrateValidatorByEthAddressKey(t *testing.T) {
 		Set([]byte(oldGetValidatorByEthAddressKey(invalidEthAddr)), []byte(validator))
 	input.Context.KVStore(input.GravityStoreKey).
 		Set([]byte(oldGetValidatorByEthAddressKey(ethAddr)), []byte(validator))
+	// the forward index and orchestrator are only set to keep the delegate keys consistent
+	input.Context.KVStore(input.GravityStoreKey).
+		Set([]byte(oldEthAddressByValidatorKey(validator)), []byte(ethAddr))
+	input.Context.KVStore(input.GravityStoreKey).
+		Set([]byte(v1.GetOrchestratorAddressKey(sdk.AccAddress(validator))), []byte(validator))
 
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
+	// the keeper reads the current layout
+	assert.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
 
 	addr, err := types.NewEthAddress(ethAddr)
 	assert.NoError(t, err)
@@ -176,8 +193,17 @@ func TestMigrateBatchConfirms(t *testing.T) {
 	input.Context.KVStore(input.GravityStoreKey).
 		Set([]byte(key), confirmBytes)
 
+	// the confirmed batch is only stored to keep the confirm consistent
+	batch := types.OutgoingTxBatch{BatchNonce: 123, TokenContract: ethAddr}
+	input.Context.KVStore(input.GravityStoreKey).
+		Set([]byte(oldGetOutgoingTxBatchKey(ethAddr, batch.BatchNonce)), input.Marshaler.MustMarshal(&batch))
+	input.Context.KVStore(input.GravityStoreKey).
+		Set([]byte(v1.KeyLastOutgoingBatchID), v2.UInt64Bytes(batch.BatchNonce))
+
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
+	// the keeper reads the current layout
+	assert.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
 
 	addr, err := types.NewEthAddress(ethAddr)
 	assert.NoError(t, err)
@@ -212,6 +238,8 @@ func TestMigrateOutgoingTxs(t *testing.T) {
 
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
+	// the keeper reads the current layout
+	assert.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
 
 	key := types.GetOutgoingTxPoolKey(*internalTx, outtx.Id)
 	res := input.Context.KVStore(input.GravityStoreKey).Get([]byte(key))
@@ -238,9 +266,12 @@ func TestMigrateOutgoingTxBatches(t *testing.T) {
 
 	inputBytes := input.Marshaler.MustMarshal(&batch)
 	input.Context.KVStore(input.GravityStoreKey).Set([]byte(oldKey), inputBytes)
+	input.Context.KVStore(input.GravityStoreKey).Set([]byte(v1.KeyLastOutgoingBatchID), v2.UInt64Bytes(batch.BatchNonce))
 
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
+	// the keeper reads the current layout
+	assert.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
 
 	key := types.GetOutgoingTxBatchKey(*addr, batch.BatchNonce)
 	res := input.Context.KVStore(input.GravityStoreKey).Get([]byte(key))
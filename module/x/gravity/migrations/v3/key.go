@@ -0,0 +1,547 @@
+package v3
+
+import (
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "gravity"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey is the module name router key
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querierer msgs
+	QuerierRoute = ModuleName
+)
+
+// Store keys start with a single byte prefix, followed by the key components in the order listed for each
+// getter below. Addresses and other variable length components which are followed by further components are
+// written with address.MustLengthPrefix so that no two keys can share the same bytes, fixed width components
+// such as nonces, Ethereum addresses and hashes are written as they are
+var (
+	// EthAddressByValidatorKey indexes cosmos validator account addresses
+	// i.e. gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm
+	// [0x01]
+	EthAddressByValidatorKey = []byte{0x01}
+
+	// ValidatorByEthAddressKey indexes ethereum addresses
+	// i.e. 0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B
+	// [0x02]
+	ValidatorByEthAddressKey = []byte{0x02}
+
+	// ValsetRequestKey indexes valset requests by nonce
+	// [0x03]
+	ValsetRequestKey = []byte{0x03}
+
+	// ValsetConfirmKey indexes valset confirmations by nonce and the validator account address
+	// i.e gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm
+	// [0x04]
+	ValsetConfirmKey = []byte{0x04}
+
+	// OracleAttestationKey attestation details by nonce and validator address
+	// i.e. gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm
+	// An attestation can be thought of as the 'event to be executed' while
+	// the Claims are an individual validator saying that they saw an event
+	// occur the Attestation is 'the event' that multiple claims vote on and
+	// eventually executes
+	// [0x05]
+	OracleAttestationKey = []byte{0x05}
+
+	// OutgoingTXPoolKey indexes the last nonce for the outgoing tx pool
+	// [0x06]
+	OutgoingTXPoolKey = []byte{0x06}
+
+	// OutgoingTXBatchKey indexes outgoing tx batches under a nonce and token address
+	// [0x07]
+	OutgoingTXBatchKey = []byte{0x07}
+
+	// BatchConfirmKey indexes validator confirmations by token contract address
+	// [0x08]
+	BatchConfirmKey = []byte{0x08}
+
+	// LastEventNonceByValidatorKey indexes lateset event nonce by validator
+	// [0x09]
+	LastEventNonceByValidatorKey = []byte{0x09}
+
+	// LastObservedEventNonceKey indexes the latest event nonce
+	// [0x0a]
+	LastObservedEventNonceKey = []byte{0x0a}
+
+	// KeyLastTXPoolID indexes the lastTxPoolID
+	// [0x0b]
+	KeyLastTXPoolID = []byte{0x0b}
+
+	// KeyLastOutgoingBatchID indexes the lastBatchID
+	// [0x0c]
+	KeyLastOutgoingBatchID = []byte{0x0c}
+
+	// KeyOrchestratorAddress indexes the validator keys for an orchestrator
+	// [0x0d]
+	KeyOrchestratorAddress = []byte{0x0d}
+
+	// KeyOutgoingLogicCall indexes the outgoing logic calls
+	// [0x0e]
+	KeyOutgoingLogicCall = []byte{0x0e}
+
+	// KeyOutgoingLogicConfirm indexes the outgoing logic confirms
+	// [0x0f]
+	KeyOutgoingLogicConfirm = []byte{0x0f}
+
+	// LastObservedEthereumBlockHeightKey indexes the latest Ethereum block height
+	// [0x10]
+	LastObservedEthereumBlockHeightKey = []byte{0x10}
+
+	// DenomToERC20Key prefixes the index of Cosmos originated asset denoms to ERC20s
+	// [0x11]
+	DenomToERC20Key = []byte{0x11}
+
+	// ERC20ToDenomKey prefixes the index of Cosmos originated assets ERC20s to denoms
+	// [0x12]
+	ERC20ToDenomKey = []byte{0x12}
+
+	// LastSlashedValsetNonce indexes the latest slashed valset nonce
+	// [0x13]
+	LastSlashedValsetNonce = []byte{0x13}
+
+	// LatestValsetNonce indexes the latest valset nonce
+	// [0x14]
+	LatestValsetNonce = []byte{0x14}
+
+	// LastSlashedBatchBlock indexes the latest slashed batch block height
+	// [0x15]
+	LastSlashedBatchBlock = []byte{0x15}
+
+	// LastSlashedLogicCallBlock indexes the latest slashed logic call block height
+	// [0x16]
+	LastSlashedLogicCallBlock = []byte{0x16}
+
+	// LastUnBondingBlockHeight indexes the last validator unbonding block height
+	// [0x17]
+	LastUnBondingBlockHeight = []byte{0x17}
+
+	// LastObservedValsetNonceKey indexes the latest observed valset nonce
+	// HERE THERE BE DRAGONS, do not use this value as an up to date validator set
+	// on Ethereum it will always lag significantly and may be totally wrong at some
+	// times.
+	// [0x18]
+	LastObservedValsetKey = []byte{0x18}
+
+	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
+	// [0x19]
+	PastEthSignatureCheckpointKey = []byte{0x19}
+
+	// PendingIBCAutoForwards indexes pending SendToCosmos sends via IBC, queued by event nonce
+	// [0x1a]
+	PendingIbcAutoForwards = []byte{0x1a}
+
+	// ValidatorBondedHeightKey indexes the block height at which a validator last entered the bonded set
+	// [0x1b]
+	ValidatorBondedHeightKey = []byte{0x1b}
+
+	// DelegateKeyRegistrationHeightKey indexes the block height at which a validator registered its delegate keys
+	// [0x1c]
+	DelegateKeyRegistrationHeightKey = []byte{0x1c}
+
+	// BridgeSlashingEventKey indexes past gravity slashing events by validator and block height
+	// [0x1d]
+	BridgeSlashingEventKey = []byte{0x1d}
+
+	// ERC20DeploymentRequestKey indexes governance approved ERC20 deployment requests by Cosmos originated denom
+	// [0x1e]
+	ERC20DeploymentRequestKey = []byte{0x1e}
+
+	// ERC20MigrationKey indexes deprecated Cosmos originated ERC20s by their old contract address
+	// [0x1f]
+	ERC20MigrationKey = []byte{0x1f}
+
+	// RateLimitInflowKey indexes the amount of each token credited by deposits at each block height
+	// [0x20]
+	RateLimitInflowKey = []byte{0x20}
+
+	// RateLimitOutflowKey indexes the amount of each token sent to Ethereum at each block height
+	// [0x21]
+	RateLimitOutflowKey = []byte{0x21}
+
+	// RateLimitedDepositKey indexes observed deposits waiting for inflow capacity, by token and event nonce
+	// [0x22]
+	RateLimitedDepositKey = []byte{0x22}
+
+	// CircuitBreakerTripKey stores the reason the circuit breaker halted the bridge, if it has
+	// [0x23]
+	CircuitBreakerTripKey = []byte{0x23}
+
+	// MerkleAirdropKey indexes airdrops whose recipients claim their share with MsgClaimAirdrop, by id
+	// [0x24]
+	MerkleAirdropKey = []byte{0x24}
+
+	// AirdropClaimKey indexes the recipients who have claimed from a Merkle airdrop
+	// [0x25]
+	AirdropClaimKey = []byte{0x25}
+
+	// LastMerkleAirdropIDKey stores the id of the last Merkle airdrop
+	// [0x26]
+	LastMerkleAirdropIDKey = []byte{0x26}
+
+	// DepositReceiptKey indexes the receipts of observed deposits by Ethereum tx hash and event nonce
+	// [0x27]
+	DepositReceiptKey = []byte{0x27}
+
+	// BatchExecutionKey indexes the executions of batches by token contract and batch nonce
+	// [0x28]
+	BatchExecutionKey = []byte{0x28}
+
+	// ExecutedBatchKey indexes the executed batch archive by archive id, in the order batches were executed
+	// [0x29]
+	ExecutedBatchKey = []byte{0x29}
+
+	// ExecutedBatchByNonceKey indexes the archive id of an executed batch by batch nonce
+	// [0x2a]
+	ExecutedBatchByNonceKey = []byte{0x2a}
+
+	// ExecutedBatchByTxIdKey indexes the archive id of an executed batch by the pool ids of its transactions
+	// [0x2b]
+	ExecutedBatchByTxIdKey = []byte{0x2b}
+
+	// LastExecutedBatchIDKey stores the archive id of the last executed batch
+	// [0x2c]
+	LastExecutedBatchIDKey = []byte{0x2c}
+
+	// PausedDepositKey indexes observed deposits of a token whose deposits are paused, by token and event nonce
+	// [0x2d]
+	PausedDepositKey = []byte{0x2d}
+)
+
+// GetOrchestratorAddressKey returns the following key format
+// prefix 	len		orchestrator address
+// [0x0d][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetOrchestratorAddressKey(orc sdk.AccAddress) []byte {
+	if err := sdk.VerifyAddressFormat(orc); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid orchestrator address"))
+	}
+	return AppendBytes(KeyOrchestratorAddress, address.MustLengthPrefix(orc.Bytes()))
+}
+
+// GetEthAddressByValidatorKey returns the following key format
+// prefix  len        cosmos-validator
+// [0x01][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetEthAddressByValidatorKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(EthAddressByValidatorKey, address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetValidatorByEthAddressKey returns the following key format
+// prefix              ethereum-address
+// [0x02][0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B]
+func GetValidatorByEthAddressKey(ethAddress types.EthAddress) []byte {
+	return AppendBytes(ValidatorByEthAddressKey, ethAddress.GetAddress().Bytes())
+}
+
+// GetValsetKey returns the following key format
+// prefix    nonce
+// [0x03][0 0 0 0 0 0 0 1]
+func GetValsetKey(nonce uint64) []byte {
+	return AppendBytes(ValsetRequestKey, UInt64Bytes(nonce))
+}
+
+// GetValsetConfirmNoncePrefix returns the following format
+// prefix   nonce
+// [0x04][0 0 0 0 0 0 0 1]
+func GetValsetConfirmNoncePrefix(nonce uint64) []byte {
+	return AppendBytes(ValsetConfirmKey, UInt64Bytes(nonce))
+}
+
+// GetValsetConfirmKey returns the following key format
+// prefix   nonce              len    validator-address
+// [0x04][0 0 0 0 0 0 0 1][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// MARK finish-batches: this is where the key is created in the old (presumed working) code
+func GetValsetConfirmKey(nonce uint64, validator sdk.AccAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(GetValsetConfirmNoncePrefix(nonce), address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetAttestationKey returns the following key format
+// prefix     nonce                             claim-details-hash
+// [0x05][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+// An attestation is an event multiple people are voting on, this function needs the claim
+// details because each Attestation is aggregating all claims of a specific event, lets say
+// validator X and validator y were making different claims about the same event nonce
+// Note that the claim hash does NOT include the claimer address and only identifies an event
+func GetAttestationKey(eventNonce uint64, claimHash []byte) []byte {
+	return AppendBytes(OracleAttestationKey, UInt64Bytes(eventNonce), claimHash)
+}
+
+// GetOutgoingTxPoolContractPrefix returns
+// prefix			feeContract
+// [0x06][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// This prefix is used for iterating over unbatched transactions for a given contract
+func GetOutgoingTxPoolContractPrefix(contractAddress types.EthAddress) []byte {
+	return AppendBytes(OutgoingTXPoolKey, contractAddress.GetAddress().Bytes())
+}
+
+// GetOutgoingTxPoolKey returns the following key format
+// prefix				feeContract					 feeAmount			id
+// [0x06][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000][0 0 0 0 0 0 0 1]
+func GetOutgoingTxPoolKey(fee types.InternalERC20Token, id uint64) []byte {
+	amount := make([]byte, 32)
+	amount = fee.Amount.BigInt().FillBytes(amount)
+	return AppendBytes(OutgoingTXPoolKey, fee.Contract.GetAddress().Bytes(), amount, UInt64Bytes(id))
+}
+
+// GetOutgoingTxBatchContractPrefix returns the following format
+// prefix     eth-contract-address
+// [0x07][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetOutgoingTxBatchContractPrefix(tokenContract types.EthAddress) []byte {
+	return AppendBytes(OutgoingTXBatchKey, tokenContract.GetAddress().Bytes())
+}
+
+// GetOutgoingTxBatchKey returns the following key format
+// prefix     eth-contract-address                     nonce
+// [0x07][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetOutgoingTxBatchKey(tokenContract types.EthAddress, nonce uint64) []byte {
+	return AppendBytes(GetOutgoingTxBatchContractPrefix(tokenContract), UInt64Bytes(nonce))
+}
+
+// GetBatchConfirmNonceContractPrefix returns
+// prefix           eth-contract-address                BatchNonce
+// [0x08][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchConfirmNonceContractPrefix(tokenContract types.EthAddress, batchNonce uint64) []byte {
+	return AppendBytes(BatchConfirmKey, tokenContract.GetAddress().Bytes(), UInt64Bytes(batchNonce))
+}
+
+// GetBatchConfirmKey returns the following key format
+// prefix           eth-contract-address                BatchNonce          len        Validator-address
+// [0x08][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// TODO this should be a sdk.ValAddress
+func GetBatchConfirmKey(tokenContract types.EthAddress, batchNonce uint64, validator sdk.AccAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(GetBatchConfirmNonceContractPrefix(tokenContract, batchNonce), address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetLastEventNonceByValidatorKey indexes latest event nonce by validator
+// GetLastEventNonceByValidatorKey returns the following key format
+// prefix  len        cosmos-validator
+// [0x09][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetLastEventNonceByValidatorKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(LastEventNonceByValidatorKey, address.MustLengthPrefix(validator.Bytes()))
+}
+
+func GetDenomToERC20Key(denom string) []byte {
+	return AppendBytes(DenomToERC20Key, []byte(denom))
+}
+
+func GetERC20ToDenomKey(erc20 types.EthAddress) []byte {
+	return AppendBytes(ERC20ToDenomKey, erc20.GetAddress().Bytes())
+}
+
+// GetOutgoingLogicCallKey returns the following key format
+// prefix  len   invalidation-id        nonce
+// [0x0e][32][ invalidation-id ][0 0 0 0 0 0 0 1]
+func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) []byte {
+	return AppendBytes(KeyOutgoingLogicCall, address.MustLengthPrefix(invalidationId), UInt64Bytes(invalidationNonce))
+}
+
+// GetLogicConfirmNonceInvalidationIdPrefix returns the following key format
+// prefix  len   invalidation-id        nonce
+// [0x0f][32][ invalidation-id ][0 0 0 0 0 0 0 1]
+func GetLogicConfirmNonceInvalidationIdPrefix(invalidationId []byte, invalidationNonce uint64) []byte {
+	return AppendBytes(KeyOutgoingLogicConfirm, address.MustLengthPrefix(invalidationId), UInt64Bytes(invalidationNonce))
+}
+
+// GetLogicConfirmKey returns the following key format
+// prefix  len   invalidation-id        nonce          len    validator-address
+// [0x0f][32][ invalidation-id ][0 0 0 0 0 0 0 1][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validator sdk.AccAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(GetLogicConfirmNonceInvalidationIdPrefix(invalidationId, invalidationNonce), address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetPastEthSignatureCheckpointKey returns the following key format
+// prefix    checkpoint
+// [0x19][ checkpoint bytes ]
+func GetPastEthSignatureCheckpointKey(checkpoint []byte) []byte {
+	return AppendBytes(PastEthSignatureCheckpointKey, checkpoint)
+}
+
+// GetPendingIbcAutoForwardKey returns the following key format
+// prefix		EventNonce
+// [0x1a][0 0 0 0 0 0 0 1]
+func GetPendingIbcAutoForwardKey(eventNonce uint64) []byte {
+	return AppendBytes(PendingIbcAutoForwards, UInt64Bytes(eventNonce))
+}
+
+// GetValidatorBondedHeightKey returns the following key format
+// prefix  len        cosmos-validator
+// [0x1b][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetValidatorBondedHeightKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(ValidatorBondedHeightKey, address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetDelegateKeyRegistrationHeightKey returns the following key format
+// prefix  len        cosmos-validator
+// [0x1c][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetDelegateKeyRegistrationHeightKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(DelegateKeyRegistrationHeightKey, address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetBridgeSlashingEventKey returns the following key format
+// prefix  len        cosmos-validator                                    height                slash-type
+// [0x1d][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1][valset_signature_slashing]
+func GetBridgeSlashingEventKey(validator sdk.ValAddress, height uint64, slashType string) []byte {
+	return AppendBytes(GetBridgeSlashingEventPrefix(validator), UInt64Bytes(height), []byte(slashType))
+}
+
+// GetBridgeSlashingEventPrefix returns the following key format
+// prefix  len        cosmos-validator
+// [0x1d][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetBridgeSlashingEventPrefix(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(BridgeSlashingEventKey, address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetERC20DeploymentRequestKey returns the following key format
+// prefix     denom
+// [0x1e][ugraviton]
+func GetERC20DeploymentRequestKey(denom string) []byte {
+	return AppendBytes(ERC20DeploymentRequestKey, []byte(denom))
+}
+
+// GetERC20MigrationKey returns the following key format
+// prefix     old-erc20
+// [0x1f][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetERC20MigrationKey(oldErc20 types.EthAddress) []byte {
+	return AppendBytes(ERC20MigrationKey, oldErc20.GetAddress().Bytes())
+}
+
+// GetRateLimitFlowPrefix returns the following key format
+// prefix     token-contract
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// where prefix is RateLimitInflowKey or RateLimitOutflowKey
+func GetRateLimitFlowPrefix(prefix []byte, tokenContract types.EthAddress) []byte {
+	return AppendBytes(prefix, tokenContract.GetAddress().Bytes())
+}
+
+// GetRateLimitFlowKey returns the following key format
+// prefix     token-contract                                 height
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+// where prefix is RateLimitInflowKey or RateLimitOutflowKey
+func GetRateLimitFlowKey(prefix []byte, tokenContract types.EthAddress, height uint64) []byte {
+	return AppendBytes(GetRateLimitFlowPrefix(prefix, tokenContract), UInt64Bytes(height))
+}
+
+// GetRateLimitedDepositPrefix returns the following key format
+// prefix     token-contract
+// [0x22][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetRateLimitedDepositPrefix(tokenContract types.EthAddress) []byte {
+	return AppendBytes(RateLimitedDepositKey, tokenContract.GetAddress().Bytes())
+}
+
+// GetRateLimitedDepositKey returns the following key format
+// prefix     token-contract                                 event-nonce
+// [0x22][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetRateLimitedDepositKey(tokenContract types.EthAddress, eventNonce uint64) []byte {
+	return AppendBytes(GetRateLimitedDepositPrefix(tokenContract), UInt64Bytes(eventNonce))
+}
+
+// GetPausedDepositPrefix returns the following key format
+// prefix     token-contract
+// [0x2d][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetPausedDepositPrefix(tokenContract types.EthAddress) []byte {
+	return AppendBytes(PausedDepositKey, tokenContract.GetAddress().Bytes())
+}
+
+// GetPausedDepositKey returns the following key format
+// prefix     token-contract                                 event-nonce
+// [0x2d][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetPausedDepositKey(tokenContract types.EthAddress, eventNonce uint64) []byte {
+	return AppendBytes(GetPausedDepositPrefix(tokenContract), UInt64Bytes(eventNonce))
+}
+
+// GetMerkleAirdropKey returns the following key format
+// prefix     id
+// [0x24][0 0 0 0 0 0 0 1]
+func GetMerkleAirdropKey(id uint64) []byte {
+	return AppendBytes(MerkleAirdropKey, UInt64Bytes(id))
+}
+
+// GetAirdropClaimPrefix returns the following key format
+// prefix     id
+// [0x25][0 0 0 0 0 0 0 1]
+func GetAirdropClaimPrefix(id uint64) []byte {
+	return AppendBytes(AirdropClaimKey, UInt64Bytes(id))
+}
+
+// GetAirdropClaimKey returns the following key format
+// prefix     id               len       recipient
+// [0x25][0 0 0 0 0 0 0 1][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetAirdropClaimKey(id uint64, recipient sdk.AccAddress) []byte {
+	return AppendBytes(GetAirdropClaimPrefix(id), address.MustLengthPrefix(recipient.Bytes()))
+}
+
+// GetDepositReceiptPrefix returns the following key format
+// prefix     eth-tx-hash
+// [0x27][0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1]
+func GetDepositReceiptPrefix(ethTxHash gethcommon.Hash) []byte {
+	return AppendBytes(DepositReceiptKey, ethTxHash.Bytes())
+}
+
+// GetDepositReceiptKey returns the following key format
+// prefix     eth-tx-hash                                                        event-nonce
+// [0x27][0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1][0 0 0 0 0 0 0 1]
+func GetDepositReceiptKey(ethTxHash gethcommon.Hash, eventNonce uint64) []byte {
+	return AppendBytes(GetDepositReceiptPrefix(ethTxHash), UInt64Bytes(eventNonce))
+}
+
+// GetBatchExecutionKey returns the following key format
+// prefix     eth-contract-address                     nonce
+// [0x28][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchExecutionKey(tokenContract types.EthAddress, nonce uint64) []byte {
+	return AppendBytes(BatchExecutionKey, tokenContract.GetAddress().Bytes(), UInt64Bytes(nonce))
+}
+
+// GetExecutedBatchKey returns the following key format
+// prefix     archive-id
+// [0x29][0 0 0 0 0 0 0 1]
+func GetExecutedBatchKey(archiveID uint64) []byte {
+	return AppendBytes(ExecutedBatchKey, UInt64Bytes(archiveID))
+}
+
+// GetExecutedBatchByNonceKey returns the following key format
+// prefix     batch-nonce
+// [0x2a][0 0 0 0 0 0 0 1]
+func GetExecutedBatchByNonceKey(nonce uint64) []byte {
+	return AppendBytes(ExecutedBatchByNonceKey, UInt64Bytes(nonce))
+}
+
+// GetExecutedBatchByTxIdKey returns the following key format
+// prefix     tx-id
+// [0x2b][0 0 0 0 0 0 0 1]
+func GetExecutedBatchByTxIdKey(txID uint64) []byte {
+	return AppendBytes(ExecutedBatchByTxIdKey, UInt64Bytes(txID))
+}
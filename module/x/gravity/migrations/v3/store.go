@@ -0,0 +1,205 @@
+package v3
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migration
+// includes:
+//
+// - Move every key from its MD5 hashed v2 prefix to a single byte prefix.
+// - Length prefix the Cosmos addresses and logic call invalidation ids in keys.
+// - Store past Ethereum signature checkpoints as raw bytes instead of one utf8 rune per byte.
+//
+// Every key is converted before any is written, so a new key can never be picked up again while iterating a v2
+// prefix which has not been migrated yet. Values do not change.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v3 Upgrade: Enter MigrateStore")
+	store := ctx.KVStore(storeKey)
+
+	var (
+		oldKeys  [][]byte
+		migrated []kv.Pair
+	)
+	for _, migration := range keyMigrations() {
+		iter := sdk.KVStorePrefixIterator(store, migration.oldPrefix)
+		for ; iter.Valid(); iter.Next() {
+			oldKey, value := iter.Key(), iter.Value()
+			newKey, err := migration.convert(oldKey[len(migration.oldPrefix):], value, cdc)
+			if err != nil {
+				iter.Close()
+				return sdkerrors.Wrapf(err, "unable to migrate key %X", oldKey)
+			}
+			oldKeys = append(oldKeys, oldKey)
+			migrated = append(migrated, kv.Pair{Key: newKey, Value: value})
+		}
+		iter.Close()
+	}
+
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+	for _, pair := range migrated {
+		store.Set(pair.Key, pair.Value)
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("Gravity v3 Upgrade: migrated %d keys", len(migrated)))
+	return nil
+}
+
+// MigrateParams sets every param which is missing from the store to its default value, these are the params
+// added since v2 which a chain started on an earlier version has never stored
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+}
+
+// keyMigration converts the keys stored under a v2 prefix, convert is passed the rest of the key after the prefix
+type keyMigration struct {
+	oldPrefix []byte
+	convert   func(key []byte, value []byte, cdc codec.BinaryCodec) ([]byte, error)
+}
+
+func keyMigrations() []keyMigration {
+	return []keyMigration{
+		{v2.EthAddressByValidatorKey, lengthPrefixAfter(EthAddressByValidatorKey, 0)},
+		{v2.ValidatorByEthAddressKey, movePrefix(ValidatorByEthAddressKey)},
+		{v2.ValsetRequestKey, movePrefix(ValsetRequestKey)},
+		// nonce, orchestrator
+		{v2.ValsetConfirmKey, lengthPrefixAfter(ValsetConfirmKey, 8)},
+		{v2.OracleAttestationKey, movePrefix(OracleAttestationKey)},
+		{v2.OutgoingTXPoolKey, movePrefix(OutgoingTXPoolKey)},
+		{v2.OutgoingTXBatchKey, movePrefix(OutgoingTXBatchKey)},
+		// token contract, nonce, orchestrator
+		{v2.BatchConfirmKey, lengthPrefixAfter(BatchConfirmKey, 28)},
+		{v2.LastEventNonceByValidatorKey, lengthPrefixAfter(LastEventNonceByValidatorKey, 0)},
+		{v2.LastObservedEventNonceKey, movePrefix(LastObservedEventNonceKey)},
+		{v2.KeyLastTXPoolID, movePrefix(KeyLastTXPoolID)},
+		{v2.KeyLastOutgoingBatchID, movePrefix(KeyLastOutgoingBatchID)},
+		{v2.KeyOrchestratorAddress, lengthPrefixAfter(KeyOrchestratorAddress, 0)},
+		{v2.KeyOutgoingLogicCall, convertLogicCallKey},
+		{v2.KeyOutgoingLogicConfirm, convertLogicCallConfirmKey},
+		{v2.LastObservedEthereumBlockHeightKey, movePrefix(LastObservedEthereumBlockHeightKey)},
+		{v2.DenomToERC20Key, movePrefix(DenomToERC20Key)},
+		{v2.ERC20ToDenomKey, movePrefix(ERC20ToDenomKey)},
+		{v2.LastSlashedValsetNonce, movePrefix(LastSlashedValsetNonce)},
+		{v2.LatestValsetNonce, movePrefix(LatestValsetNonce)},
+		{v2.LastSlashedBatchBlock, movePrefix(LastSlashedBatchBlock)},
+		{v2.LastSlashedLogicCallBlock, movePrefix(LastSlashedLogicCallBlock)},
+		{v2.LastUnBondingBlockHeight, movePrefix(LastUnBondingBlockHeight)},
+		{v2.LastObservedValsetKey, movePrefix(LastObservedValsetKey)},
+		{v2.PastEthSignatureCheckpointKey, convertPastEthSignatureCheckpointKey},
+		{v2.PendingIbcAutoForwards, movePrefix(PendingIbcAutoForwards)},
+		{v2.ValidatorBondedHeightKey, lengthPrefixAfter(ValidatorBondedHeightKey, 0)},
+		{v2.DelegateKeyRegistrationHeightKey, lengthPrefixAfter(DelegateKeyRegistrationHeightKey, 0)},
+		{v2.BridgeSlashingEventKey, convertBridgeSlashingEventKey},
+		{v2.ERC20DeploymentRequestKey, movePrefix(ERC20DeploymentRequestKey)},
+		{v2.ERC20MigrationKey, movePrefix(ERC20MigrationKey)},
+		{v2.RateLimitInflowKey, movePrefix(RateLimitInflowKey)},
+		{v2.RateLimitOutflowKey, movePrefix(RateLimitOutflowKey)},
+		{v2.RateLimitedDepositKey, movePrefix(RateLimitedDepositKey)},
+		{v2.CircuitBreakerTripKey, movePrefix(CircuitBreakerTripKey)},
+		{v2.MerkleAirdropKey, movePrefix(MerkleAirdropKey)},
+		// airdrop id, recipient
+		{v2.AirdropClaimKey, lengthPrefixAfter(AirdropClaimKey, 8)},
+		{v2.LastMerkleAirdropIDKey, movePrefix(LastMerkleAirdropIDKey)},
+		{v2.DepositReceiptKey, movePrefix(DepositReceiptKey)},
+		{v2.BatchExecutionKey, movePrefix(BatchExecutionKey)},
+		{v2.ExecutedBatchKey, movePrefix(ExecutedBatchKey)},
+		{v2.ExecutedBatchByNonceKey, movePrefix(ExecutedBatchByNonceKey)},
+		{v2.ExecutedBatchByTxIdKey, movePrefix(ExecutedBatchByTxIdKey)},
+		{v2.LastExecutedBatchIDKey, movePrefix(LastExecutedBatchIDKey)},
+		{v2.PausedDepositKey, movePrefix(PausedDepositKey)},
+	}
+}
+
+// movePrefix keeps the rest of the key as it is, for keys made only of fixed width components or whose only
+// variable length component comes last
+func movePrefix(newPrefix []byte) func([]byte, []byte, codec.BinaryCodec) ([]byte, error) {
+	return func(key []byte, _ []byte, _ codec.BinaryCodec) ([]byte, error) {
+		return AppendBytes(newPrefix, key), nil
+	}
+}
+
+// lengthPrefixAfter keeps the first fixedLen bytes of the key and length prefixes the address which follows them
+func lengthPrefixAfter(newPrefix []byte, fixedLen int) func([]byte, []byte, codec.BinaryCodec) ([]byte, error) {
+	return func(key []byte, _ []byte, _ codec.BinaryCodec) ([]byte, error) {
+		if len(key) <= fixedLen {
+			return nil, fmt.Errorf("key is too short to hold an address after %d bytes", fixedLen)
+		}
+		addr, err := address.LengthPrefix(key[fixedLen:])
+		if err != nil {
+			return nil, err
+		}
+		return AppendBytes(newPrefix, key[:fixedLen], addr), nil
+	}
+}
+
+// convertLogicCallKey splits the invalidation id from the nonce, which is always the last 8 bytes
+func convertLogicCallKey(key []byte, _ []byte, _ codec.BinaryCodec) ([]byte, error) {
+	if len(key) < 8 {
+		return nil, fmt.Errorf("logic call key is too short to hold a nonce")
+	}
+	invalidationID := key[:len(key)-8]
+	return GetOutgoingLogicCallKey(invalidationID, types.UInt64FromBytes(key[len(key)-8:])), nil
+}
+
+// convertLogicCallConfirmKey reads the key from the confirm, as the invalidation id and the orchestrator address
+// are both of variable length
+func convertLogicCallConfirmKey(_ []byte, value []byte, cdc codec.BinaryCodec) ([]byte, error) {
+	var confirm types.MsgConfirmLogicCall
+	if err := cdc.Unmarshal(value, &confirm); err != nil {
+		return nil, err
+	}
+	invalidationID, err := hex.DecodeString(confirm.InvalidationId)
+	if err != nil {
+		return nil, err
+	}
+	orchestrator, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+	return GetLogicConfirmKey(invalidationID, confirm.InvalidationNonce, orchestrator), nil
+}
+
+// convertPastEthSignatureCheckpointKey decodes the v2 checkpoint, which holds each byte as a utf8 encoded rune
+func convertPastEthSignatureCheckpointKey(key []byte, _ []byte, _ codec.BinaryCodec) ([]byte, error) {
+	checkpoint := make([]byte, 0, len(key))
+	for _, r := range string(key) {
+		if r > 0xff {
+			return nil, fmt.Errorf("checkpoint rune %U is not a byte", r)
+		}
+		checkpoint = append(checkpoint, byte(r))
+	}
+	return GetPastEthSignatureCheckpointKey(checkpoint), nil
+}
+
+// convertBridgeSlashingEventKey reads the key from the event, as the validator address and the slash type are
+// both of variable length
+func convertBridgeSlashingEventKey(_ []byte, value []byte, cdc codec.BinaryCodec) ([]byte, error) {
+	var event types.BridgeSlashingEvent
+	if err := cdc.Unmarshal(value, &event); err != nil {
+		return nil, err
+	}
+	validator, err := sdk.ValAddressFromBech32(event.Validator)
+	if err != nil {
+		return nil, err
+	}
+	return GetBridgeSlashingEventKey(validator, event.BlockHeight, event.SlashType), nil
+}
@@ -0,0 +1,276 @@
+package v3_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// oldKeyFunc returns the key an entry of the current store had in an older layout, rest is the key after its prefix
+type oldKeyFunc func(t *testing.T, cdc codec.BinaryCodec, rest []byte, value []byte) []byte
+
+// requireMigrates rewrites every entry of the store into an older layout, using the oldKeys of its prefix, then
+// runs migrate and requires the store to be exactly what it was beforehand. This fails for any prefix in the store
+// which has no old key, so new prefixes must be added to the layout of every migration they pass through
+func requireMigrates(
+	t *testing.T, ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec,
+	oldKeys map[byte]oldKeyFunc, migrate func(sdk.Context, storetypes.StoreKey, codec.BinaryCodec) error,
+) {
+	t.Helper()
+	store := ctx.KVStore(storeKey)
+	expected := storeEntries(store)
+	require.NotEmpty(t, expected)
+
+	for _, entry := range expected {
+		store.Delete(entry[0])
+	}
+	for _, entry := range expected {
+		oldKey, ok := oldKeys[entry[0][0]]
+		require.True(t, ok, "no old layout for the key %X", entry[0])
+		store.Set(oldKey(t, cdc, entry[0][1:], entry[1]), entry[1])
+	}
+	require.NotEqual(t, expected, storeEntries(store))
+
+	require.NoError(t, migrate(ctx, storeKey, cdc))
+
+	actual := storeEntries(store)
+	require.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		require.Equal(t, expected[i], actual[i], "store entry %d differs after the migration", i)
+	}
+}
+
+// storeEntries returns every key and value in the store, in key order
+func storeEntries(store sdk.KVStore) (out [][2][]byte) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, [2][]byte{iter.Key(), iter.Value()})
+	}
+	return out
+}
+
+// v2Layout builds the v2 key of every v3 entry with the v2 key functions, by v3 prefix
+var v2Layout = map[byte]oldKeyFunc{
+	v3.EthAddressByValidatorKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetEthAddressByValidatorKey(address(t, rest))
+	},
+	v3.ValidatorByEthAddressKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetValidatorByEthAddressKey(ethAddress(t, rest))
+	},
+	v3.ValsetRequestKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetValsetKey(types.UInt64FromBytes(rest))
+	},
+	v3.ValsetConfirmKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetValsetConfirmKey(types.UInt64FromBytes(rest[:8]), address(t, rest[8:]))
+	},
+	v3.OracleAttestationKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetAttestationKey(types.UInt64FromBytes(rest[:8]), rest[8:])
+	},
+	v3.OutgoingTXPoolKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		fee := types.InternalERC20Token{Contract: ethAddress(t, rest[:20]), Amount: sdk.NewIntFromBigInt(new(big.Int).SetBytes(rest[20:52]))}
+		return v2.GetOutgoingTxPoolKey(fee, types.UInt64FromBytes(rest[52:]))
+	},
+	v3.OutgoingTXBatchKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetOutgoingTxBatchKey(ethAddress(t, rest[:20]), types.UInt64FromBytes(rest[20:]))
+	},
+	v3.BatchConfirmKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetBatchConfirmKey(ethAddress(t, rest[:20]), types.UInt64FromBytes(rest[20:28]), address(t, rest[28:]))
+	},
+	v3.LastEventNonceByValidatorKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetLastEventNonceByValidatorKey(address(t, rest))
+	},
+	v3.LastObservedEventNonceKey[0]: single(v2.LastObservedEventNonceKey),
+	v3.KeyLastTXPoolID[0]:           single(v2.KeyLastTXPoolID),
+	v3.KeyLastOutgoingBatchID[0]:    single(v2.KeyLastOutgoingBatchID),
+	v3.KeyOrchestratorAddress[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetOrchestratorAddressKey(address(t, rest))
+	},
+	v3.KeyOutgoingLogicCall[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		invalidationID, rest := types.SplitLengthPrefixed(rest)
+		return v2.GetOutgoingLogicCallKey(invalidationID, types.UInt64FromBytes(rest))
+	},
+	v3.KeyOutgoingLogicConfirm[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		invalidationID, rest := types.SplitLengthPrefixed(rest)
+		return v2.GetLogicConfirmKey(invalidationID, types.UInt64FromBytes(rest[:8]), address(t, rest[8:]))
+	},
+	v3.LastObservedEthereumBlockHeightKey[0]: single(v2.LastObservedEthereumBlockHeightKey),
+	v3.DenomToERC20Key[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetDenomToERC20Key(string(rest))
+	},
+	v3.ERC20ToDenomKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetERC20ToDenomKey(ethAddress(t, rest))
+	},
+	v3.LastSlashedValsetNonce[0]:    single(v2.LastSlashedValsetNonce),
+	v3.LatestValsetNonce[0]:         single(v2.LatestValsetNonce),
+	v3.LastSlashedBatchBlock[0]:     single(v2.LastSlashedBatchBlock),
+	v3.LastSlashedLogicCallBlock[0]: single(v2.LastSlashedLogicCallBlock),
+	v3.LastUnBondingBlockHeight[0]:  single(v2.LastUnBondingBlockHeight),
+	v3.LastObservedValsetKey[0]:     single(v2.LastObservedValsetKey),
+	v3.PastEthSignatureCheckpointKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetPastEthSignatureCheckpointKey(rest)
+	},
+	v3.PendingIbcAutoForwards[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetPendingIbcAutoForwardKey(types.UInt64FromBytes(rest))
+	},
+	v3.ValidatorBondedHeightKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetValidatorBondedHeightKey(address(t, rest))
+	},
+	v3.DelegateKeyRegistrationHeightKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetDelegateKeyRegistrationHeightKey(address(t, rest))
+	},
+	v3.BridgeSlashingEventKey[0]: func(t *testing.T, cdc codec.BinaryCodec, _ []byte, value []byte) []byte {
+		var event types.BridgeSlashingEvent
+		cdc.MustUnmarshal(value, &event)
+		validator, err := sdk.ValAddressFromBech32(event.Validator)
+		require.NoError(t, err)
+		return v2.GetBridgeSlashingEventKey(validator, event.BlockHeight, event.SlashType)
+	},
+	v3.ERC20DeploymentRequestKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetERC20DeploymentRequestKey(string(rest))
+	},
+	v3.ERC20MigrationKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetERC20MigrationKey(ethAddress(t, rest))
+	},
+	v3.RateLimitInflowKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetRateLimitFlowKey(v2.RateLimitInflowKey, ethAddress(t, rest[:20]), types.UInt64FromBytes(rest[20:]))
+	},
+	v3.RateLimitOutflowKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetRateLimitFlowKey(v2.RateLimitOutflowKey, ethAddress(t, rest[:20]), types.UInt64FromBytes(rest[20:]))
+	},
+	v3.RateLimitedDepositKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetRateLimitedDepositKey(ethAddress(t, rest[:20]), types.UInt64FromBytes(rest[20:]))
+	},
+	v3.CircuitBreakerTripKey[0]: single(v2.CircuitBreakerTripKey),
+	v3.MerkleAirdropKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetMerkleAirdropKey(types.UInt64FromBytes(rest))
+	},
+	v3.AirdropClaimKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetAirdropClaimKey(types.UInt64FromBytes(rest[:8]), address(t, rest[8:]))
+	},
+	v3.LastMerkleAirdropIDKey[0]: single(v2.LastMerkleAirdropIDKey),
+	v3.DepositReceiptKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetDepositReceiptKey(gethcommon.BytesToHash(rest[:32]), types.UInt64FromBytes(rest[32:]))
+	},
+	v3.BatchExecutionKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetBatchExecutionKey(ethAddress(t, rest[:20]), types.UInt64FromBytes(rest[20:]))
+	},
+	v3.ExecutedBatchKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetExecutedBatchKey(types.UInt64FromBytes(rest))
+	},
+	v3.ExecutedBatchByNonceKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetExecutedBatchByNonceKey(types.UInt64FromBytes(rest))
+	},
+	v3.ExecutedBatchByTxIdKey[0]: func(_ *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetExecutedBatchByTxIdKey(types.UInt64FromBytes(rest))
+	},
+	v3.LastExecutedBatchIDKey[0]: single(v2.LastExecutedBatchIDKey),
+	v3.PausedDepositKey[0]: func(t *testing.T, _ codec.BinaryCodec, rest []byte, _ []byte) []byte {
+		return v2.GetPausedDepositKey(ethAddress(t, rest[:20]), types.UInt64FromBytes(rest[20:]))
+	},
+}
+
+func single(oldKey []byte) oldKeyFunc {
+	return func(*testing.T, codec.BinaryCodec, []byte, []byte) []byte { return oldKey }
+}
+
+// address reads a length prefixed address which must take up the rest of the key
+func address(t *testing.T, rest []byte) []byte {
+	addr, rest := types.SplitLengthPrefixed(rest)
+	require.Empty(t, rest)
+	return addr
+}
+
+func ethAddress(t *testing.T, bz []byte) types.EthAddress {
+	addr, err := types.NewEthAddressFromBytes(bz)
+	require.NoError(t, err)
+	return *addr
+}
+
+// Tests that a v2 store holding every kind of gravity state is migrated to exactly the store the keeper writes
+func TestMigrateStore(t *testing.T) {
+	input, ctx := keeper.SetupFullBridgeState(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	// make sure the state covers every prefix, so that each conversion is tested
+	found := make(map[byte]bool)
+	for _, entry := range storeEntries(ctx.KVStore(input.GravityStoreKey)) {
+		found[entry[0][0]] = true
+	}
+	for prefix := range v2Layout {
+		require.True(t, found[prefix], "no entry under the prefix %X", prefix)
+	}
+
+	requireMigrates(t, ctx, input.GravityStoreKey, input.Marshaler, v2Layout, v3.MigrateStore)
+}
+
+func TestMigrateStoreLeavesOtherKeys(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	store := input.Context.KVStore(input.GravityStoreKey)
+	unknown := []byte("NotAGravityKey")
+	store.Set(unknown, []byte{0x1})
+
+	require.NoError(t, v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler))
+	require.Equal(t, []byte{0x1}, store.Get(unknown))
+}
+
+func TestMigrateStoreInvalidKeys(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	store := input.Context.KVStore(input.GravityStoreKey)
+
+	// too short to hold a nonce
+	store.Set(v2.AppendBytes(v2.KeyOutgoingLogicCall, []byte{0x1}), []byte{0x1})
+	err := v3.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
+	require.Error(t, err)
+	// nothing is written when any key fails
+	require.True(t, store.Has(v2.AppendBytes(v2.KeyOutgoingLogicCall, []byte{0x1})))
+	require.False(t, bytes.HasPrefix(storeEntries(store)[0][0], v3.KeyOutgoingLogicCall))
+}
+
+// Tests that the params missing from a v2 store are set to their defaults and the existing params are kept
+func TestMigrateParams(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	cdc := keeper.MakeTestMarshaler()
+	paramSpace := paramtypes.NewSubspace(cdc, keeper.MakeTestCodec(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	params := types.DefaultParams()
+	params.GravityId = "v2-gravity-id"
+	params.SignedValsetsWindow = 42
+	for _, pair := range params.ParamSetPairs() {
+		if types.IsOptionalParam(pair.Key) {
+			continue
+		}
+		paramSpace.Set(ctx, pair.Key, pair.Value)
+	}
+	require.False(t, paramSpace.Has(ctx, types.ParamStoreChainFee))
+
+	v3.MigrateParams(ctx, paramSpace)
+
+	// compare with the params as read back from a subspace they were all set on, which drops the fields which
+	// are not stored and the difference between nil and empty lists
+	var expected, migrated types.Params
+	expectedSpace := paramtypes.NewSubspace(cdc, keeper.MakeTestCodec(), storeKey, tStoreKey, "expected").
+		WithKeyTable(types.ParamKeyTable())
+	expectedSpace.SetParamSet(ctx, params)
+	expectedSpace.GetParamSet(ctx, &expected)
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Equal(t, expected, migrated)
+	require.Equal(t, "v2-gravity-id", migrated.GravityId)
+}
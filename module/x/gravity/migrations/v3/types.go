@@ -0,0 +1,27 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func AppendBytes(args ...[]byte) []byte {
+	length := 0
+	for _, v := range args {
+		length += len(v)
+	}
+
+	res := make([]byte, length)
+
+	length = 0
+	for _, v := range args {
+		copy(res[length:length+len(v)], v)
+		length += len(v)
+	}
+
+	return res
+}
+
+// UInt64Bytes uses the SDK byte marshaling to encode a uint64
+func UInt64Bytes(n uint64) []byte {
+	return sdk.Uint64ToBigEndian(n)
+}
@@ -17,11 +17,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/client/cli"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/client/rest"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	gravitysim "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/simulation"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -34,7 +36,9 @@ var (
 			SlashingKeeper:     nil,
 			AttestationHandler: nil,
 		},
-		bankKeeper: nil,
+		bankKeeper:    nil,
+		accountKeeper: nil,
+		cdc:           nil,
 	}
 	_ module.AppModuleBasic = AppModuleBasic{}
 )
@@ -101,20 +105,24 @@ func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry
 // AppModule object for module implementation
 type AppModule struct {
 	AppModuleBasic
-	keeper     keeper.Keeper
-	bankKeeper bankkeeper.Keeper
+	keeper        keeper.Keeper
+	bankKeeper    bankkeeper.Keeper
+	accountKeeper simulation.AccountKeeper
+	cdc           codec.Codec
 }
 
 func (am AppModule) ConsensusVersion() uint64 {
-	return 2
+	return 3
 }
 
 // NewAppModule creates a new AppModule Object
-func NewAppModule(k keeper.Keeper, bankKeeper bankkeeper.Keeper) AppModule {
+func NewAppModule(k keeper.Keeper, bankKeeper bankkeeper.Keeper, accountKeeper simulation.AccountKeeper, cdc codec.Codec) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
 		bankKeeper:     bankKeeper,
+		accountKeeper:  accountKeeper,
+		cdc:            cdc,
 	}
 }
 
@@ -126,6 +134,12 @@ func (AppModule) Name() string {
 // RegisterInvariants implements app module
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(types.ModuleName, "module-balance", keeper.ModuleBalanceInvariant(am.keeper))
+	ir.RegisterRoute(types.ModuleName, "unbatched-tx-index", keeper.UnbatchedTxIndexInvariant(am.keeper))
+	ir.RegisterRoute(types.ModuleName, "pool-batch-tx-ids", keeper.PoolBatchTxIdsInvariant(am.keeper))
+	ir.RegisterRoute(types.ModuleName, "batch-nonces", keeper.BatchNonceInvariant(am.keeper))
+	ir.RegisterRoute(types.ModuleName, "last-observed-event-nonce", keeper.LastObservedEventNonceInvariant(am.keeper))
+	ir.RegisterRoute(types.ModuleName, "delegate-keys", keeper.DelegateKeyInvariant(am.keeper))
+	ir.RegisterRoute(types.ModuleName, "confirms", keeper.ConfirmsInvariant(am.keeper))
 }
 
 // Route implements app module
@@ -152,6 +166,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/gravity from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gravity from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis initializes the genesis state for this module and implements app module.
@@ -181,36 +198,30 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the distribution module.
+// GenerateGenesisState creates a randomized GenState of the gravity module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	// TODO: implement gravity simulation stuffs
-	// simulation.RandomizedGenState(simState)
+	gravitysim.RandomizedGenState(simState)
 }
 
-// ProposalContents returns all the distribution content functions used to
+// ProposalContents returns all the gravity content functions used to
 // simulate governance proposals.
 func (am AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
-	// TODO: implement gravity simulation stuffs
-	return nil
+	return gravitysim.ProposalContents(am.keeper)
 }
 
-// RandomizedParams creates randomized distribution param changes for the simulator.
+// RandomizedParams creates randomized gravity param changes for the simulator.
 func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
-	// TODO: implement gravity simulation stuffs
-	return nil
+	return gravitysim.ParamChanges(r)
 }
 
-// RegisterStoreDecoder registers a decoder for distribution module's types
+// RegisterStoreDecoder registers a decoder for gravity module's types
 func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
-	// TODO: implement gravity simulation stuffs
-	// sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+	sdr[types.StoreKey] = gravitysim.NewDecodeStore(am.cdc)
 }
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the gravity module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	// TODO: implement gravity simulation stuffs
-	// return simulation.WeightedOperations(
-	// simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper, am.stakingKeeper,
-	// )
-	return nil
+	return gravitysim.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper,
+	)
 }
@@ -0,0 +1,142 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// uint64Keys are the keys, or key prefixes, whose values are big endian uint64 nonces, heights or ids
+var uint64Keys = [][]byte{
+	types.LastEventNonceByValidatorKey,
+	types.LastObservedEventNonceKey,
+	types.KeyLastTXPoolID,
+	types.KeyLastOutgoingBatchID,
+	types.LastSlashedValsetNonce,
+	types.LatestValsetNonce,
+	types.LastSlashedBatchBlock,
+	types.LastSlashedLogicCallBlock,
+	types.LastUnBondingBlockHeight,
+	types.ValidatorBondedHeightKey,
+	types.DelegateKeyRegistrationHeightKey,
+	types.ExecutedBatchByNonceKey,
+	types.ExecutedBatchByTxIdKey,
+	types.LastExecutedBatchIDKey,
+	types.LastMerkleAirdropIDKey,
+}
+
+// markerKeys are the key prefixes whose values only mark the key as present
+var markerKeys = [][]byte{
+	types.PastEthSignatureCheckpointKey,
+	types.AirdropClaimKey,
+}
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gravity type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	decode := func(kvA, kvB kv.Pair, a, b codec.ProtoMarshaler) string {
+		cdc.MustUnmarshal(kvA.Value, a)
+		cdc.MustUnmarshal(kvB.Value, b)
+		return fmt.Sprintf("%v\n%v", a, b)
+	}
+	return func(kvA, kvB kv.Pair) string {
+		hasPrefix := func(prefix []byte) bool { return bytes.HasPrefix(kvA.Key, prefix) }
+		for _, prefix := range uint64Keys {
+			if hasPrefix(prefix) {
+				return fmt.Sprintf("%d\n%d", types.UInt64FromBytes(kvA.Value), types.UInt64FromBytes(kvB.Value))
+			}
+		}
+		for _, prefix := range markerKeys {
+			if hasPrefix(prefix) {
+				return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+			}
+		}
+
+		switch {
+		case hasPrefix(types.EthAddressByValidatorKey), hasPrefix(types.DenomToERC20Key):
+			return fmt.Sprintf("%s\n%s", gethcommon.BytesToAddress(kvA.Value).Hex(), gethcommon.BytesToAddress(kvB.Value).Hex())
+
+		case hasPrefix(types.ValidatorByEthAddressKey), hasPrefix(types.KeyOrchestratorAddress):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+
+		case hasPrefix(types.ERC20ToDenomKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case hasPrefix(types.ValsetRequestKey), hasPrefix(types.LastObservedValsetKey):
+			return decode(kvA, kvB, &types.Valset{}, &types.Valset{})
+
+		case hasPrefix(types.ValsetConfirmKey):
+			return decode(kvA, kvB, &types.MsgValsetConfirm{}, &types.MsgValsetConfirm{})
+
+		case hasPrefix(types.OracleAttestationKey):
+			return decode(kvA, kvB, &types.Attestation{}, &types.Attestation{})
+
+		case hasPrefix(types.OutgoingTXPoolKey):
+			return decode(kvA, kvB, &types.OutgoingTransferTx{}, &types.OutgoingTransferTx{})
+
+		case hasPrefix(types.OutgoingTXBatchKey):
+			return decode(kvA, kvB, &types.OutgoingTxBatch{}, &types.OutgoingTxBatch{})
+
+		case hasPrefix(types.BatchConfirmKey):
+			return decode(kvA, kvB, &types.MsgConfirmBatch{}, &types.MsgConfirmBatch{})
+
+		case hasPrefix(types.KeyOutgoingLogicCall):
+			return decode(kvA, kvB, &types.OutgoingLogicCall{}, &types.OutgoingLogicCall{})
+
+		case hasPrefix(types.KeyOutgoingLogicConfirm):
+			return decode(kvA, kvB, &types.MsgConfirmLogicCall{}, &types.MsgConfirmLogicCall{})
+
+		case hasPrefix(types.LastObservedEthereumBlockHeightKey):
+			return decode(kvA, kvB, &types.LastObservedEthereumBlockHeight{}, &types.LastObservedEthereumBlockHeight{})
+
+		case hasPrefix(types.PendingIbcAutoForwards):
+			return decode(kvA, kvB, &types.PendingIbcAutoForward{}, &types.PendingIbcAutoForward{})
+
+		case hasPrefix(types.BridgeSlashingEventKey):
+			return decode(kvA, kvB, &types.BridgeSlashingEvent{}, &types.BridgeSlashingEvent{})
+
+		case hasPrefix(types.ERC20DeploymentRequestKey):
+			return decode(kvA, kvB, &types.ERC20DeploymentRequest{}, &types.ERC20DeploymentRequest{})
+
+		case hasPrefix(types.ERC20MigrationKey):
+			return decode(kvA, kvB, &types.ERC20Migration{}, &types.ERC20Migration{})
+
+		case hasPrefix(types.RateLimitInflowKey), hasPrefix(types.RateLimitOutflowKey):
+			var flowA, flowB sdk.Int
+			if err := flowA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := flowB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", flowA, flowB)
+
+		case hasPrefix(types.RateLimitedDepositKey), hasPrefix(types.PausedDepositKey):
+			return decode(kvA, kvB, &types.MsgSendToCosmosClaim{}, &types.MsgSendToCosmosClaim{})
+
+		case hasPrefix(types.CircuitBreakerTripKey):
+			return decode(kvA, kvB, &types.CircuitBreakerTrip{}, &types.CircuitBreakerTrip{})
+
+		case hasPrefix(types.MerkleAirdropKey):
+			return decode(kvA, kvB, &types.MerkleAirdrop{}, &types.MerkleAirdrop{})
+
+		case hasPrefix(types.DepositReceiptKey):
+			return decode(kvA, kvB, &types.DepositReceipt{}, &types.DepositReceipt{})
+
+		case hasPrefix(types.BatchExecutionKey):
+			return decode(kvA, kvB, &types.BatchExecution{}, &types.BatchExecution{})
+
+		case hasPrefix(types.ExecutedBatchKey):
+			return decode(kvA, kvB, &types.ExecutedBatch{}, &types.ExecutedBatch{})
+
+		default:
+			panic(fmt.Sprintf("invalid gravity key prefix %X", kvA.Key))
+		}
+	}
+}
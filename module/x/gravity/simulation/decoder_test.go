@@ -0,0 +1,83 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+var (
+	valPk1      = ed25519.GenPrivKey().PubKey()
+	valAddr1    = sdk.ValAddress(valPk1.Address())
+	ethAddr1, _ = types.NewEthAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+)
+
+func TestDecodeGravityStore(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	valset := types.Valset{
+		Nonce:        3,
+		Members:      []types.BridgeValidator{{Power: 100, EthereumAddress: ethAddr1.GetAddress().Hex()}},
+		Height:       10,
+		RewardAmount: sdk.ZeroInt(),
+	}
+	transfer := types.OutgoingTransferTx{
+		Id:          7,
+		Sender:      sdk.AccAddress(valAddr1).String(),
+		DestAddress: ethAddr1.GetAddress().Hex(),
+		Erc20Token:  types.ERC20Token{Contract: ethAddr1.GetAddress().Hex(), Amount: sdk.NewInt(100)},
+		Erc20Fee:    types.ERC20Token{Contract: ethAddr1.GetAddress().Hex(), Amount: sdk.NewInt(1)},
+	}
+	fee, err := types.NewInternalERC20Token(sdk.NewInt(1), ethAddr1.GetAddress().Hex())
+	require.NoError(t, err)
+	flow := sdk.NewInt(500)
+	flowBz, err := flow.Marshal()
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetEthAddressByValidatorKey(valAddr1), Value: ethAddr1.GetAddress().Bytes()},
+			{Key: types.GetValidatorByEthAddressKey(*ethAddr1), Value: valAddr1},
+			{Key: types.GetValsetKey(valset.Nonce), Value: cdc.MustMarshal(&valset)},
+			{Key: types.GetOutgoingTxPoolKey(*fee, transfer.Id), Value: cdc.MustMarshal(&transfer)},
+			{Key: types.GetLastEventNonceByValidatorKey(valAddr1), Value: types.UInt64Bytes(42)},
+			{Key: types.GetPastEthSignatureCheckpointKey([]byte("checkpoint")), Value: []byte{0x1}},
+			{Key: types.GetRateLimitFlowKey(types.RateLimitInflowKey, *ethAddr1, 10), Value: flowBz},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"EthAddressByValidator", fmt.Sprintf("%s\n%s", ethAddr1.GetAddress().Hex(), ethAddr1.GetAddress().Hex())},
+		{"ValidatorByEthAddress", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
+		{"Valset", fmt.Sprintf("%v\n%v", &valset, &valset)},
+		{"OutgoingTXPool", fmt.Sprintf("%v\n%v", &transfer, &transfer)},
+		{"LastEventNonceByValidator", "42\n42"},
+		{"PastEthSignatureCheckpoint", "01\n01"},
+		{"RateLimitInflow", fmt.Sprintf("%v\n%v", flow, flow)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	GravityID                = "gravity_id"
+	BridgeChainID            = "bridge_chain_id"
+	SignedValsetsWindow      = "signed_valsets_window"
+	SignedBatchesWindow      = "signed_batches_window"
+	TargetBatchTimeout       = "target_batch_timeout"
+	SlashFractionValset      = "slash_fraction_valset"
+	SlashFractionBatch       = "slash_fraction_batch"
+	ExecutedBatchArchiveSize = "executed_batch_archive_size"
+	BridgeFeeShare           = "bridge_fee_share"
+	ChainFeeBasisPoints      = "chain_fee_basis_points"
+
+	BridgeSlashingEventRetention = "bridge_slashing_event_retention"
+)
+
+// EthPrivKey returns the Ethereum key of a simulated account, simulated validators use their Cosmos
+// secp256k1 key as their Ethereum key as well so that orchestrators need no extra state
+func EthPrivKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(fmt.Sprintf("simulated account %s has no valid ethereum key: %s", acc.Address, err))
+	}
+	return key
+}
+
+// EthAddress returns the Ethereum address of a simulated account, see EthPrivKey
+func EthAddress(acc simtypes.Account) types.EthAddress {
+	addr, err := types.NewEthAddress(crypto.PubkeyToAddress(EthPrivKey(acc).PublicKey).Hex())
+	if err != nil {
+		panic(err)
+	}
+	return *addr
+}
+
+// DelegateKeys returns the keys a simulated validator registers, the validator's account acts as its own
+// orchestrator
+func DelegateKeys(acc simtypes.Account) types.MsgSetOrchestratorAddress {
+	return types.MsgSetOrchestratorAddress{
+		Validator:    sdk.ValAddress(acc.Address).String(),
+		Orchestrator: acc.Address.String(),
+		EthAddress:   EthAddress(acc).GetAddress().Hex(),
+	}
+}
+
+// RandomEthAddress returns a random Ethereum address
+func RandomEthAddress(r *rand.Rand) types.EthAddress {
+	bz := make([]byte, 20)
+	r.Read(bz)
+	addr, err := types.NewEthAddressFromBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return *addr
+}
+
+func genSignedWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1000, 20000))
+}
+
+func genTargetBatchTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 60000, 43200000))
+}
+
+func genSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 50)), 3)
+}
+
+func genExecutedBatchArchiveSize(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+func genBridgeSlashingEventRetention(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000))
+}
+
+func genBridgeFeeShare(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+func genChainFeeBasisPoints(r *rand.Rand) uint64 {
+	return uint64(r.Intn(50))
+}
+
+// RandomizedGenState generates a random GenesisState for gravity, registering the delegate keys of every
+// initially bonded validator so that the simulated orchestrators can confirm and claim from the first block
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GravityID, &params.GravityId, simState.Rand,
+		func(r *rand.Rand) { params.GravityId = simtypes.RandStringOfLength(r, 10) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeChainID, &params.BridgeChainId, simState.Rand,
+		func(r *rand.Rand) { params.BridgeChainId = uint64(simtypes.RandIntBetween(r, 1, 1000)) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedValsetsWindow, &params.SignedValsetsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedValsetsWindow = genSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedBatchesWindow, &params.SignedBatchesWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedBatchesWindow = genSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBatchTimeout, &params.TargetBatchTimeout, simState.Rand,
+		func(r *rand.Rand) { params.TargetBatchTimeout = genTargetBatchTimeout(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionValset, &params.SlashFractionValset, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionValset = genSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionBatch, &params.SlashFractionBatch, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionBatch = genSlashFraction(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ExecutedBatchArchiveSize, &params.ExecutedBatchArchiveSize, simState.Rand,
+		func(r *rand.Rand) { params.ExecutedBatchArchiveSize = genExecutedBatchArchiveSize(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeSlashingEventRetention, &params.BridgeSlashingEventRetention, simState.Rand,
+		func(r *rand.Rand) { params.BridgeSlashingEventRetention = genBridgeSlashingEventRetention(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeFeeShare, &params.BridgeFeeShare, simState.Rand,
+		func(r *rand.Rand) { params.BridgeFeeShare = genBridgeFeeShare(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ChainFeeBasisPoints, &params.ChainFee.BasisPoints, simState.Rand,
+		func(r *rand.Rand) { params.ChainFee.BasisPoints = genChainFeeBasisPoints(r) },
+	)
+	params.BridgeEthereumAddress = RandomEthAddress(simState.Rand).GetAddress().Hex()
+
+	gravityGenesis := types.DefaultGenesisState()
+	gravityGenesis.Params = params
+	// the staking module bonds the first NumBonded accounts as validators
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		gravityGenesis.DelegateKeys = append(gravityGenesis.DelegateKeys, DelegateKeys(acc))
+	}
+
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", params)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gravityGenesis)
+}
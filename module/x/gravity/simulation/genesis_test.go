@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
+func TestRandomizedGenState(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    2,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var gravityGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gravityGenesis)
+
+	require.NoError(t, gravityGenesis.ValidateBasic())
+	require.NotEmpty(t, gravityGenesis.Params.GravityId)
+	require.NotZero(t, gravityGenesis.Params.BridgeChainId)
+	require.Less(t, gravityGenesis.Params.ChainFee.BasisPoints, uint64(50))
+
+	// only the bonded validators register their delegate keys, each acting as its own orchestrator
+	require.Len(t, gravityGenesis.DelegateKeys, 2)
+	for i, keys := range gravityGenesis.DelegateKeys {
+		acc := simState.Accounts[i]
+		require.Equal(t, acc.Address.String(), keys.Orchestrator)
+		require.Equal(t, simulation.EthAddress(acc).GetAddress().Hex(), keys.EthAddress)
+	}
+}
@@ -0,0 +1,460 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendToEth              = "op_weight_msg_send_to_eth"
+	OpWeightMsgCancelSendToEth        = "op_weight_msg_cancel_send_to_eth"
+	OpWeightMsgRequestBatch           = "op_weight_msg_request_batch"
+	OpWeightMsgSetOrchestratorAddress = "op_weight_msg_set_orchestrator_address"
+	OpWeightMsgValsetConfirm          = "op_weight_msg_valset_confirm"
+	OpWeightMsgConfirmBatch           = "op_weight_msg_confirm_batch"
+	OpWeightEthereumClaim             = "op_weight_ethereum_claim"
+
+	DefaultWeightMsgSendToEth              = 50
+	DefaultWeightMsgCancelSendToEth        = 10
+	DefaultWeightMsgRequestBatch           = 20
+	DefaultWeightMsgSetOrchestratorAddress = 20
+	DefaultWeightMsgValsetConfirm          = 50
+	DefaultWeightMsgConfirmBatch           = 50
+	// every event needs claims from validators holding 2/3 of the power before it is observed
+	DefaultWeightEthereumClaim = 200
+)
+
+// SimulatedERC20s are the tokens deposited to the bridge on the simulated Ethereum
+var SimulatedERC20s = []string{
+	"0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+	"0xD7600ae27C99988A6CD360234062b540F88ECA43",
+	"0x7580bFE88Dd3d07947908FAE12d95872a260F2D8",
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak simulation.AccountKeeper,
+	bk simulation.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+	eth := newSimulatedEthereum()
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSendToEth, DefaultWeightMsgSendToEth),
+			SimulateMsgSendToEth(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelSendToEth, DefaultWeightMsgCancelSendToEth),
+			SimulateMsgCancelSendToEth(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRequestBatch, DefaultWeightMsgRequestBatch),
+			SimulateMsgRequestBatch(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetOrchestratorAddress, DefaultWeightMsgSetOrchestratorAddress),
+			SimulateMsgSetOrchestratorAddress(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgValsetConfirm, DefaultWeightMsgValsetConfirm),
+			SimulateMsgValsetConfirm(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgConfirmBatch, DefaultWeightMsgConfirmBatch),
+			SimulateMsgConfirmBatch(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightEthereumClaim, DefaultWeightEthereumClaim),
+			SimulateEthereumClaim(ak, bk, k, eth),
+		),
+	}
+}
+
+// SimulateMsgSendToEth generates a MsgSendToEth of some of an account's bridged tokens
+func SimulateMsgSendToEth(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSendToEth{})
+		// only the receivers of deposits hold bridged tokens, so pick among them rather than among all accounts
+		var (
+			senders []simtypes.Account
+			bridged []sdk.Coin
+		)
+		for _, acc := range accs {
+			for _, coin := range bk.SpendableCoins(ctx, acc.Address) {
+				if _, _, err := k.DenomToERC20Lookup(ctx, coin.Denom); err == nil && coin.Amount.GT(sdk.OneInt()) {
+					senders = append(senders, acc)
+					bridged = append(bridged, coin)
+				}
+			}
+		}
+		if len(senders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no account has bridged tokens"), nil, nil
+		}
+		i := r.Intn(len(senders))
+		simAccount, balance := senders[i], bridged[i]
+
+		amount, err := simtypes.RandPositiveInt(r, balance.Amount.QuoRaw(2))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		fee := simtypes.RandomAmount(r, balance.Amount.Sub(amount).QuoRaw(2))
+		msg := &types.MsgSendToEth{
+			Sender:    simAccount.Address.String(),
+			EthDest:   RandomEthAddress(r).GetAddress().Hex(),
+			Amount:    sdk.NewCoin(balance.Denom, amount),
+			BridgeFee: sdk.NewCoin(balance.Denom, fee),
+		}
+		chainFee := k.GetParams(ctx).ChainFee.Compute(msg.Amount)
+		spent := sdk.NewCoins(msg.Amount).Add(msg.BridgeFee).Add(chainFee...)
+
+		return deliverCheckedMsg(r, app, ctx, ak, bk, k, simAccount, msg, spent, true)
+	}
+}
+
+// SimulateMsgCancelSendToEth generates a MsgCancelSendToEth for an unbatched transfer of a simulated account
+func SimulateMsgCancelSendToEth(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCancelSendToEth{})
+		unbatched := k.GetUnbatchedTransactions(ctx)
+		if len(unbatched) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched transfers"), nil, nil
+		}
+		tx := unbatched[r.Intn(len(unbatched))]
+		simAccount, found := simtypes.FindAccount(accs, tx.Sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "transfer sender is not a simulated account"), nil, nil
+		}
+		msg := &types.MsgCancelSendToEth{
+			TransactionId: tx.Id,
+			Sender:        simAccount.Address.String(),
+		}
+
+		return deliverCheckedMsg(r, app, ctx, ak, bk, k, simAccount, msg, nil, true)
+	}
+}
+
+// SimulateMsgRequestBatch generates a MsgRequestBatch for a token with unbatched transfers
+func SimulateMsgRequestBatch(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRequestBatch{})
+		unbatched := k.GetUnbatchedTransactions(ctx)
+		if len(unbatched) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched transfers"), nil, nil
+		}
+		_, denom := k.ERC20ToDenomLookup(ctx, unbatched[r.Intn(len(unbatched))].Erc20Token.Contract)
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgRequestBatch{
+			Sender: simAccount.Address.String(),
+			Denom:  denom,
+		}
+
+		return deliverCheckedMsg(r, app, ctx, ak, bk, k, simAccount, msg, nil, true)
+	}
+}
+
+// SimulateMsgSetOrchestratorAddress generates a MsgSetOrchestratorAddress registering the delegate keys of a
+// validator created during the simulation
+func SimulateMsgSetOrchestratorAddress(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetOrchestratorAddress{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		valAddr := sdk.ValAddress(simAccount.Address)
+		if _, found := k.StakingKeeper.GetValidator(ctx, valAddr); !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is not a validator"), nil, nil
+		}
+		if _, found := k.GetEthAddressByValidator(ctx, valAddr); found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator already has delegate keys"), nil, nil
+		}
+		msg := DelegateKeys(simAccount)
+
+		return deliverCheckedMsg(r, app, ctx, ak, bk, k, simAccount, &msg, nil, true)
+	}
+}
+
+// SimulateMsgValsetConfirm generates a MsgValsetConfirm signing a valset the orchestrator has not signed yet
+func SimulateMsgValsetConfirm(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgValsetConfirm{})
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated orchestrators"), nil, nil
+		}
+		var unsigned []types.Valset
+		for _, valset := range k.GetValsets(ctx) {
+			if k.GetValsetConfirm(ctx, valset.Nonce, orchestrator.Address) == nil {
+				unsigned = append(unsigned, valset)
+			}
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned valsets"), nil, nil
+		}
+		valset := unsigned[r.Intn(len(unsigned))]
+		signature, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), EthPrivKey(orchestrator))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign valset"), nil, err
+		}
+		msg := &types.MsgValsetConfirm{
+			Nonce:        valset.Nonce,
+			Orchestrator: orchestrator.Address.String(),
+			EthAddress:   EthAddress(orchestrator).GetAddress().Hex(),
+			Signature:    hex.EncodeToString(signature),
+		}
+
+		return deliverCheckedMsg(r, app, ctx, ak, bk, k, orchestrator, msg, nil, false)
+	}
+}
+
+// SimulateMsgConfirmBatch generates a MsgConfirmBatch signing a batch the orchestrator has not signed yet
+func SimulateMsgConfirmBatch(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgConfirmBatch{})
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no simulated orchestrators"), nil, nil
+		}
+		var unsigned []types.InternalOutgoingTxBatch
+		for _, batch := range k.GetOutgoingTxBatches(ctx) {
+			if k.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, orchestrator.Address) == nil {
+				unsigned = append(unsigned, batch)
+			}
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned batches"), nil, nil
+		}
+		batch := unsigned[r.Intn(len(unsigned))]
+		signature, err := types.NewEthereumSignature(batch.GetCheckpoint(k.GetGravityID(ctx)), EthPrivKey(orchestrator))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign batch"), nil, err
+		}
+		msg := &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: batch.TokenContract.GetAddress().Hex(),
+			EthSigner:     EthAddress(orchestrator).GetAddress().Hex(),
+			Orchestrator:  orchestrator.Address.String(),
+			Signature:     hex.EncodeToString(signature),
+		}
+
+		return deliverCheckedMsg(r, app, ctx, ak, bk, k, orchestrator, msg, nil, false)
+	}
+}
+
+// SimulateEthereumClaim generates the claim an orchestrator makes for the next event it has not reported
+func SimulateEthereumClaim(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper, eth *simulatedEthereum) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, "ethereum_claim", "no simulated orchestrators"), nil, nil
+		}
+		validator, _ := k.GetOrchestratorValidator(ctx, orchestrator.Address)
+		nonce := k.GetLastEventNonceByValidator(ctx, validator.GetOperator()) + 1
+		msg := eth.claim(r, ctx, k, accs, nonce, orchestrator.Address)
+
+		return deliverCheckedMsg(r, app, ctx, ak, bk, k, orchestrator, msg, nil, false)
+	}
+}
+
+// simulatedEthereum plays the part of Ethereum and of the orchestrators' view of it, the first orchestrator
+// to report an event nonce decides what happened so that every orchestrator reports the same event
+type simulatedEthereum struct {
+	blockHeight uint64
+	deposits    map[uint64]types.MsgSendToCosmosClaim
+	executions  map[uint64]types.MsgBatchSendToEthClaim
+	// the nonce of the last batch executed for each token, the Gravity contract only executes newer batches
+	lastBatchNonces map[string]uint64
+}
+
+func newSimulatedEthereum() *simulatedEthereum {
+	return &simulatedEthereum{
+		deposits:        make(map[uint64]types.MsgSendToCosmosClaim),
+		executions:      make(map[uint64]types.MsgBatchSendToEthClaim),
+		lastBatchNonces: make(map[string]uint64),
+	}
+}
+
+// claim returns the claim for the event with the given nonce, as reported by orchestrator
+func (e *simulatedEthereum) claim(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, nonce uint64, orchestrator sdk.AccAddress,
+) sdk.Msg {
+	if deposit, found := e.deposits[nonce]; found {
+		deposit.Orchestrator = orchestrator.String()
+		return &deposit
+	}
+	if execution, found := e.executions[nonce]; found {
+		execution.Orchestrator = orchestrator.String()
+		return &execution
+	}
+
+	e.blockHeight += uint64(simtypes.RandIntBetween(r, 1, 20))
+	ethTxHash := make([]byte, 32)
+	r.Read(ethTxHash)
+
+	// execute a batch the Gravity contract would accept, one which has not timed out and is newer than the
+	// last batch executed for its token
+	var executable []types.InternalOutgoingTxBatch
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		if batch.BatchTimeout > e.blockHeight && batch.BatchNonce > e.lastBatchNonces[batch.TokenContract.GetAddress().Hex()] {
+			executable = append(executable, batch)
+		}
+	}
+	if len(executable) > 0 && r.Intn(2) == 0 {
+		batch := executable[r.Intn(len(executable))]
+		tokenContract := batch.TokenContract.GetAddress().Hex()
+		e.lastBatchNonces[tokenContract] = batch.BatchNonce
+		e.executions[nonce] = types.MsgBatchSendToEthClaim{
+			EventNonce:    nonce,
+			BlockHeight:   e.blockHeight,
+			BatchNonce:    batch.BatchNonce,
+			TokenContract: tokenContract,
+			EthTxHash:     "0x" + hex.EncodeToString(ethTxHash),
+		}
+	} else {
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		e.deposits[nonce] = types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    e.blockHeight,
+			TokenContract:  SimulatedERC20s[r.Intn(len(SimulatedERC20s))],
+			Amount:         sdk.NewInt(r.Int63n(1e12) + 1),
+			EthereumSender: RandomEthAddress(r).GetAddress().Hex(),
+			CosmosReceiver: receiver.Address.String(),
+			EthTxHash:      "0x" + hex.EncodeToString(ethTxHash),
+		}
+	}
+	return e.claim(r, ctx, k, accs, nonce, orchestrator)
+}
+
+// randomOrchestrator returns the account of a random bonded validator's orchestrator, if it is a simulated account
+func randomOrchestrator(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	// only bonded validators may confirm and claim
+	var orchestrators []string
+	for _, keys := range k.GetDelegateKeys(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(keys.Validator)
+		if err != nil {
+			panic(err)
+		}
+		if validator, found := k.StakingKeeper.GetValidator(ctx, valAddr); found && validator.IsBonded() {
+			orchestrators = append(orchestrators, keys.Orchestrator)
+		}
+	}
+	if len(orchestrators) == 0 {
+		return simtypes.Account{}, false
+	}
+	orchestrator, err := sdk.AccAddressFromBech32(orchestrators[r.Intn(len(orchestrators))])
+	if err != nil {
+		panic(err)
+	}
+	return simtypes.FindAccount(accs, orchestrator)
+}
+
+// deliverCheckedMsg delivers msg from simAccount, unless it would be rejected by the gravity module. Operations
+// pick messages from the current state but cannot tell whether every check passes, so each message is first run
+// against a cached copy of the state, which also tells how much gas the tx needs on top of the ante handler's
+// share. Orchestrators' messages are sent without fees, the orchestrator of a simulated validator may have
+// bonded everything it has.
+func deliverCheckedMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak simulation.AccountKeeper, bk simulation.BankKeeper,
+	k keeper.Keeper, simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins, payFees bool,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	gasUsed, err := checkMsg(ctx, k, msg)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	account := ak.GetAccount(ctx, simAccount.Address)
+	var fees sdk.Coins
+	if payFees {
+		coins, hasNeg := bk.SpendableCoins(ctx, simAccount.Address).SafeSub(spent)
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "message doesn't leave room for fees"), nil, nil
+		}
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+		}
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas+gasUsed,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+	if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
+
+// checkMsg runs msg through the gravity msg server against a cached copy of the state, returning the gas it used
+func checkMsg(ctx sdk.Context, k keeper.Keeper, msg sdk.Msg) (uint64, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	c := sdk.WrapSDKContext(cacheCtx)
+	srv := keeper.NewMsgServerImpl(k)
+
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgSendToEth:
+		_, err = srv.SendToEth(c, msg)
+	case *types.MsgCancelSendToEth:
+		_, err = srv.CancelSendToEth(c, msg)
+	case *types.MsgRequestBatch:
+		_, err = srv.RequestBatch(c, msg)
+	case *types.MsgSetOrchestratorAddress:
+		_, err = srv.SetOrchestratorAddress(c, msg)
+	case *types.MsgValsetConfirm:
+		_, err = srv.ValsetConfirm(c, msg)
+	case *types.MsgConfirmBatch:
+		_, err = srv.ConfirmBatch(c, msg)
+	case *types.MsgSendToCosmosClaim:
+		_, err = srv.SendToCosmosClaim(c, msg)
+	case *types.MsgBatchSendToEthClaim:
+		_, err = srv.BatchSendToEthClaim(c, msg)
+	default:
+		err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unsimulated message type %T", msg)
+	}
+	return cacheCtx.GasMeter().GasConsumed(), err
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySignedValsetsWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genSignedWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySignedBatchesWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genSignedWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyTargetBatchTimeout),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genTargetBatchTimeout(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreSlashFractionValset),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genSlashFraction(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreSlashFractionBatch),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genSlashFraction(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreExecutedBatchArchiveSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genExecutedBatchArchiveSize(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreBridgeSlashingEventRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genBridgeSlashingEventRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreBridgeFeeShare),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genBridgeFeeShare(r))
+			},
+		),
+	}
+}
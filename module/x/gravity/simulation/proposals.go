@@ -0,0 +1,130 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation proposal weights constants
+const (
+	OpWeightSubmitERC20MetadataProposal          = "op_weight_submit_erc20_metadata_proposal"
+	OpWeightSubmitRequestERC20DeploymentProposal = "op_weight_submit_request_erc20_deployment_proposal"
+	OpWeightSubmitAirdropV2Proposal              = "op_weight_submit_airdrop_v2_proposal"
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitERC20MetadataProposal,
+			simappparams.DefaultWeightTextProposal,
+			checkedContent(k, SimulateERC20MetadataProposalContent),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitRequestERC20DeploymentProposal,
+			simappparams.DefaultWeightTextProposal,
+			checkedContent(k, SimulateRequestERC20DeploymentProposalContent(k)),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitAirdropV2Proposal,
+			simappparams.DefaultWeightCommunitySpendProposal,
+			checkedContent(k, SimulateAirdropV2ProposalContent(k)),
+		),
+	}
+}
+
+// checkedContent skips generated proposals which the gravity proposal handler would reject, governance runs
+// the handler when a proposal is submitted and the simulation fails on rejected submissions
+func checkedContent(k keeper.Keeper, simulate simtypes.ContentSimulatorFn) simtypes.ContentSimulatorFn {
+	handler := keeper.NewGravityProposalHandler(k)
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		content := simulate(r, ctx, accs)
+		if content == nil {
+			return nil
+		}
+		cacheCtx, _ := ctx.CacheContext()
+		if err := handler(cacheCtx, content); err != nil {
+			return nil
+		}
+		return content
+	}
+}
+
+// SimulateERC20MetadataProposalContent generates random metadata for one of the simulated ERC20s
+func SimulateERC20MetadataProposalContent(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+	tokenContract, err := types.NewEthAddress(SimulatedERC20s[r.Intn(len(SimulatedERC20s))])
+	if err != nil {
+		panic(err)
+	}
+	base := types.GravityDenom(*tokenContract)
+	symbol := strings.ToUpper(simtypes.RandStringOfLength(r, 4))
+	display := "sim" + strings.ToLower(symbol)
+	return &types.ERC20MetadataProposal{
+		Title:         simtypes.RandStringOfLength(r, 10),
+		Description:   simtypes.RandStringOfLength(r, 100),
+		TokenContract: tokenContract.GetAddress().Hex(),
+		Metadata: banktypes.Metadata{
+			Description: simtypes.RandStringOfLength(r, 20),
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: base, Exponent: 0},
+				{Denom: display, Exponent: uint32(simtypes.RandIntBetween(r, 1, 19))},
+			},
+			Base:    base,
+			Display: display,
+			Name:    simtypes.RandStringOfLength(r, 10),
+			Symbol:  symbol,
+		},
+	}
+}
+
+// SimulateRequestERC20DeploymentProposalContent generates a request to deploy an ERC20 for the staking token
+func SimulateRequestERC20DeploymentProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		return &types.RequestERC20DeploymentProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			Denom:       k.StakingKeeper.BondDenom(ctx),
+		}
+	}
+}
+
+// SimulateAirdropV2ProposalContent generates an airdrop of some of the community pool to random accounts
+func SimulateAirdropV2ProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		pool, _ := k.DistKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+		if pool.Empty() {
+			return nil
+		}
+		coin := pool[r.Intn(len(pool))]
+
+		maxRecipients := len(accs)
+		if maxRecipients > 5 {
+			maxRecipients = 5
+		}
+		var recipients []types.AirdropRecipient
+		for _, i := range r.Perm(len(accs))[:simtypes.RandIntBetween(r, 1, maxRecipients+1)] {
+			amount, err := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(10))
+			if err != nil {
+				return nil
+			}
+			recipients = append(recipients, types.AirdropRecipient{
+				Address: accs[i].Address.String(),
+				Amount:  sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)),
+			})
+		}
+		return &types.AirdropV2Proposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			Recipients:  recipients,
+		}
+	}
+}
@@ -1,5 +1,14 @@
 package types
 
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
 // The go-ethereum ABI encoder *only* encodes function calls and then it only encodes
 // function calls for which you provide an ABI json just like you would get out of the
 // solidity compiler with your compiled contract.
@@ -73,4 +82,418 @@ const (
 			{ "internalType": "uint256",   "name": "_invalidationNonce",      "type": "uint256"   }
       ]
     }]`
+
+	// GravityABIJSON is the subset of the Gravity.sol ABI used to submit valset updates, batches and logic
+	// calls, to send tokens to Cosmos, to deploy Cosmos-originated ERC20s and to decode the events Gravity.sol
+	// emits for the orchestrators. It must be kept in sync with solidity/contracts/Gravity.sol
+	GravityABIJSON = `[
+		{
+			"name": "updateValset",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "struct ValsetArgs", "name": "_newValset", "type": "tuple", "components": [
+					{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+					{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+					{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+					{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+					{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+				] },
+				{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+					{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+					{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+					{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+					{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+					{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+				] },
+				{ "internalType": "struct Signature[]", "name": "_sigs", "type": "tuple[]", "components": [
+					{ "internalType": "uint8",   "name": "v", "type": "uint8"   },
+					{ "internalType": "bytes32", "name": "r", "type": "bytes32" },
+					{ "internalType": "bytes32", "name": "s", "type": "bytes32" }
+				] }
+			],
+			"outputs": []
+		},
+		{
+			"name": "submitBatch",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+					{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+					{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+					{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+					{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+					{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+				] },
+				{ "internalType": "struct Signature[]", "name": "_sigs", "type": "tuple[]", "components": [
+					{ "internalType": "uint8",   "name": "v", "type": "uint8"   },
+					{ "internalType": "bytes32", "name": "r", "type": "bytes32" },
+					{ "internalType": "bytes32", "name": "s", "type": "bytes32" }
+				] },
+				{ "internalType": "uint256[]", "name": "_amounts",       "type": "uint256[]" },
+				{ "internalType": "address[]", "name": "_destinations",  "type": "address[]" },
+				{ "internalType": "uint256[]", "name": "_fees",          "type": "uint256[]" },
+				{ "internalType": "uint256",   "name": "_batchNonce",    "type": "uint256"   },
+				{ "internalType": "address",   "name": "_tokenContract", "type": "address"   },
+				{ "internalType": "uint256",   "name": "_batchTimeout",  "type": "uint256"   }
+			],
+			"outputs": []
+		},
+		{
+			"name": "submitLogicCall",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "struct ValsetArgs", "name": "_currentValset", "type": "tuple", "components": [
+					{ "internalType": "address[]", "name": "validators",   "type": "address[]" },
+					{ "internalType": "uint256[]", "name": "powers",       "type": "uint256[]" },
+					{ "internalType": "uint256",   "name": "valsetNonce",  "type": "uint256"   },
+					{ "internalType": "uint256",   "name": "rewardAmount", "type": "uint256"   },
+					{ "internalType": "address",   "name": "rewardToken",  "type": "address"   }
+				] },
+				{ "internalType": "struct Signature[]", "name": "_sigs", "type": "tuple[]", "components": [
+					{ "internalType": "uint8",   "name": "v", "type": "uint8"   },
+					{ "internalType": "bytes32", "name": "r", "type": "bytes32" },
+					{ "internalType": "bytes32", "name": "s", "type": "bytes32" }
+				] },
+				{ "internalType": "struct LogicCallArgs", "name": "_args", "type": "tuple", "components": [
+					{ "internalType": "uint256[]", "name": "transferAmounts",        "type": "uint256[]" },
+					{ "internalType": "address[]", "name": "transferTokenContracts", "type": "address[]" },
+					{ "internalType": "uint256[]", "name": "feeAmounts",             "type": "uint256[]" },
+					{ "internalType": "address[]", "name": "feeTokenContracts",      "type": "address[]" },
+					{ "internalType": "address",   "name": "logicContractAddress",   "type": "address"   },
+					{ "internalType": "bytes",     "name": "payload",                "type": "bytes"     },
+					{ "internalType": "uint256",   "name": "timeOut",                "type": "uint256"   },
+					{ "internalType": "bytes32",   "name": "invalidationId",         "type": "bytes32"   },
+					{ "internalType": "uint256",   "name": "invalidationNonce",      "type": "uint256"   }
+				] }
+			],
+			"outputs": []
+		},
+		{
+			"name": "sendToCosmos",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "address", "name": "_tokenContract", "type": "address" },
+				{ "internalType": "string",  "name": "_destination",   "type": "string"  },
+				{ "internalType": "uint256", "name": "_amount",        "type": "uint256" }
+			],
+			"outputs": []
+		},
+		{
+			"name": "deployERC20",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "string", "name": "_cosmosDenom", "type": "string" },
+				{ "internalType": "string", "name": "_name",        "type": "string" },
+				{ "internalType": "string", "name": "_symbol",      "type": "string" },
+				{ "internalType": "uint8",  "name": "_decimals",    "type": "uint8"  }
+			],
+			"outputs": []
+		},
+		{
+			"name": "lastBatchNonce",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "address", "name": "_erc20Address", "type": "address" }
+			],
+			"outputs": [
+				{ "internalType": "uint256", "name": "", "type": "uint256" }
+			]
+		},
+		{
+			"name": "lastLogicCallNonce",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "bytes32", "name": "_invalidation_id", "type": "bytes32" }
+			],
+			"outputs": [
+				{ "internalType": "uint256", "name": "", "type": "uint256" }
+			]
+		},
+		{
+			"name": "state_gravityId",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [
+				{ "internalType": "bytes32", "name": "", "type": "bytes32" }
+			]
+		},
+		{
+			"name": "state_lastEventNonce",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [
+				{ "internalType": "uint256", "name": "", "type": "uint256" }
+			]
+		},
+		{
+			"name": "state_lastValsetCheckpoint",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [
+				{ "internalType": "bytes32", "name": "", "type": "bytes32" }
+			]
+		},
+		{
+			"name": "state_lastValsetNonce",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [
+				{ "internalType": "uint256", "name": "", "type": "uint256" }
+			]
+		},
+		{
+			"name": "TransactionBatchExecutedEvent",
+			"type": "event",
+			"anonymous": false,
+			"inputs": [
+				{ "indexed": true,  "internalType": "uint256", "name": "_batchNonce", "type": "uint256" },
+				{ "indexed": true,  "internalType": "address", "name": "_token",      "type": "address" },
+				{ "indexed": false, "internalType": "uint256", "name": "_eventNonce", "type": "uint256" }
+			]
+		},
+		{
+			"name": "SendToCosmosEvent",
+			"type": "event",
+			"anonymous": false,
+			"inputs": [
+				{ "indexed": true,  "internalType": "address", "name": "_tokenContract", "type": "address" },
+				{ "indexed": true,  "internalType": "address", "name": "_sender",        "type": "address" },
+				{ "indexed": false, "internalType": "string",  "name": "_destination",   "type": "string"  },
+				{ "indexed": false, "internalType": "uint256", "name": "_amount",        "type": "uint256" },
+				{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",    "type": "uint256" }
+			]
+		},
+		{
+			"name": "ERC20DeployedEvent",
+			"type": "event",
+			"anonymous": false,
+			"inputs": [
+				{ "indexed": false, "internalType": "string",  "name": "_cosmosDenom",   "type": "string"  },
+				{ "indexed": true,  "internalType": "address", "name": "_tokenContract", "type": "address" },
+				{ "indexed": false, "internalType": "string",  "name": "_name",          "type": "string"  },
+				{ "indexed": false, "internalType": "string",  "name": "_symbol",        "type": "string"  },
+				{ "indexed": false, "internalType": "uint8",   "name": "_decimals",      "type": "uint8"   },
+				{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",    "type": "uint256" }
+			]
+		},
+		{
+			"name": "ValsetUpdatedEvent",
+			"type": "event",
+			"anonymous": false,
+			"inputs": [
+				{ "indexed": true,  "internalType": "uint256",   "name": "_newValsetNonce", "type": "uint256"   },
+				{ "indexed": false, "internalType": "uint256",   "name": "_eventNonce",     "type": "uint256"   },
+				{ "indexed": false, "internalType": "uint256",   "name": "_rewardAmount",   "type": "uint256"   },
+				{ "indexed": false, "internalType": "address",   "name": "_rewardToken",    "type": "address"   },
+				{ "indexed": false, "internalType": "address[]", "name": "_validators",     "type": "address[]" },
+				{ "indexed": false, "internalType": "uint256[]", "name": "_powers",         "type": "uint256[]" }
+			]
+		},
+		{
+			"name": "LogicCallEvent",
+			"type": "event",
+			"anonymous": false,
+			"inputs": [
+				{ "indexed": false, "internalType": "bytes32", "name": "_invalidationId",    "type": "bytes32" },
+				{ "indexed": false, "internalType": "uint256", "name": "_invalidationNonce", "type": "uint256" },
+				{ "indexed": false, "internalType": "bytes",   "name": "_returnData",        "type": "bytes"   },
+				{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",        "type": "uint256" }
+			]
+		}
+	]`
+
+	// ERC20ABIJSON is the subset of the ERC20 ABI needed to move tokens in and out of Gravity.sol
+	ERC20ABIJSON = `[
+		{
+			"name": "name",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [ { "internalType": "string", "name": "", "type": "string" } ]
+		},
+		{
+			"name": "symbol",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [ { "internalType": "string", "name": "", "type": "string" } ]
+		},
+		{
+			"name": "decimals",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [ { "internalType": "uint8", "name": "", "type": "uint8" } ]
+		},
+		{
+			"name": "totalSupply",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [],
+			"outputs": [ { "internalType": "uint256", "name": "", "type": "uint256" } ]
+		},
+		{
+			"name": "balanceOf",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [ { "internalType": "address", "name": "account", "type": "address" } ],
+			"outputs": [ { "internalType": "uint256", "name": "", "type": "uint256" } ]
+		},
+		{
+			"name": "allowance",
+			"stateMutability": "view",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "address", "name": "owner",   "type": "address" },
+				{ "internalType": "address", "name": "spender", "type": "address" }
+			],
+			"outputs": [ { "internalType": "uint256", "name": "", "type": "uint256" } ]
+		},
+		{
+			"name": "transfer",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "address", "name": "recipient", "type": "address" },
+				{ "internalType": "uint256", "name": "amount",    "type": "uint256" }
+			],
+			"outputs": [ { "internalType": "bool", "name": "", "type": "bool" } ]
+		},
+		{
+			"name": "approve",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "address", "name": "spender", "type": "address" },
+				{ "internalType": "uint256", "name": "amount",  "type": "uint256" }
+			],
+			"outputs": [ { "internalType": "bool", "name": "", "type": "bool" } ]
+		},
+		{
+			"name": "transferFrom",
+			"stateMutability": "nonpayable",
+			"type": "function",
+			"inputs": [
+				{ "internalType": "address", "name": "sender",    "type": "address" },
+				{ "internalType": "address", "name": "recipient", "type": "address" },
+				{ "internalType": "uint256", "name": "amount",    "type": "uint256" }
+			],
+			"outputs": [ { "internalType": "bool", "name": "", "type": "bool" } ]
+		},
+		{
+			"name": "Transfer",
+			"type": "event",
+			"anonymous": false,
+			"inputs": [
+				{ "indexed": true,  "internalType": "address", "name": "from",  "type": "address" },
+				{ "indexed": true,  "internalType": "address", "name": "to",    "type": "address" },
+				{ "indexed": false, "internalType": "uint256", "name": "value", "type": "uint256" }
+			]
+		},
+		{
+			"name": "Approval",
+			"type": "event",
+			"anonymous": false,
+			"inputs": [
+				{ "indexed": true,  "internalType": "address", "name": "owner",   "type": "address" },
+				{ "indexed": true,  "internalType": "address", "name": "spender", "type": "address" },
+				{ "indexed": false, "internalType": "uint256", "name": "value",   "type": "uint256" }
+			]
+		}
+	]`
 )
+
+// GravityPowerThreshold is constant_powerThreshold from Gravity.sol, 2/3 of 2^32 which is the total normalized
+// power of a validator set, the signatures submitted with a call must hold more than this
+const GravityPowerThreshold = 2863311530
+
+// MustParseABI parses one of the ABI json constants above, panicking if it is malformed
+func MustParseABI(abiJSON string) abi.ABI {
+	contractAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(fmt.Sprintf("Bad ABI constant! %v", err))
+	}
+	return contractAbi
+}
+
+// ValsetArgs is the ValsetArgs struct taken by Gravity.sol
+type ValsetArgs struct {
+	Validators   []gethcommon.Address
+	Powers       []*big.Int
+	ValsetNonce  *big.Int
+	RewardAmount *big.Int
+	RewardToken  gethcommon.Address
+}
+
+// Signature is the Signature struct taken by Gravity.sol, V is 27 or 28 for a present signature and 0 for
+// a validator which has not signed
+type Signature struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+// LogicCallArgs is the LogicCallArgs struct taken by Gravity.sol
+type LogicCallArgs struct {
+	TransferAmounts        []*big.Int
+	TransferTokenContracts []gethcommon.Address
+	FeeAmounts             []*big.Int
+	FeeTokenContracts      []gethcommon.Address
+	LogicContractAddress   gethcommon.Address
+	Payload                []byte
+	TimeOut                *big.Int
+	InvalidationId         [32]byte
+	InvalidationNonce      *big.Int
+}
+
+// NewValsetArgs converts a valset into the arguments Gravity.sol expects, keeping the order of the members
+// which is also the order signatures are supplied in
+func NewValsetArgs(valset Valset) ValsetArgs {
+	args := ValsetArgs{
+		Validators:   make([]gethcommon.Address, len(valset.Members)),
+		Powers:       make([]*big.Int, len(valset.Members)),
+		ValsetNonce:  new(big.Int).SetUint64(valset.Nonce),
+		RewardAmount: new(big.Int),
+		RewardToken:  gethcommon.HexToAddress(valset.RewardToken),
+	}
+	// valsets stored before rewards were added have no reward amount
+	if !valset.RewardAmount.IsNil() {
+		args.RewardAmount = valset.RewardAmount.BigInt()
+	}
+	for i, member := range valset.Members {
+		args.Validators[i] = gethcommon.HexToAddress(member.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(member.Power)
+	}
+	return args
+}
+
+// NewLogicCallArgs converts a logic call into the arguments Gravity.sol expects
+func NewLogicCallArgs(call OutgoingLogicCall) LogicCallArgs {
+	args := LogicCallArgs{
+		LogicContractAddress: gethcommon.HexToAddress(call.LogicContractAddress),
+		Payload:              call.Payload,
+		TimeOut:              new(big.Int).SetUint64(call.Timeout),
+		InvalidationNonce:    new(big.Int).SetUint64(call.InvalidationNonce),
+	}
+	copy(args.InvalidationId[:], call.InvalidationId)
+	for _, transfer := range call.Transfers {
+		args.TransferAmounts = append(args.TransferAmounts, transfer.Amount.BigInt())
+		args.TransferTokenContracts = append(args.TransferTokenContracts, gethcommon.HexToAddress(transfer.Contract))
+	}
+	for _, fee := range call.Fees {
+		args.FeeAmounts = append(args.FeeAmounts, fee.Amount.BigInt())
+		args.FeeTokenContracts = append(args.FeeTokenContracts, gethcommon.HexToAddress(fee.Contract))
+	}
+	return args
+}
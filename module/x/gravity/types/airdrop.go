@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AirdropLeafHash returns the Merkle tree leaf committing to a recipient of an AirdropV2Proposal,
+// the sha256 hash of "<bech32 address>:<coins>" where coins is the sorted sdk.Coins string
+func AirdropLeafHash(address string, amount sdk.Coins) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%s", address, amount.String())))
+	return hash[:]
+}
+
+// hashAirdropPair hashes two nodes of the airdrop Merkle tree, nodes are sorted before hashing
+// so that proofs do not need to encode whether a sibling is on the left or right
+func hashAirdropPair(a []byte, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return hash[:]
+}
+
+// AirdropMerkleTree builds the Merkle tree of the given recipients, returning the root and the proof
+// for each recipient in order. When a level has an odd number of nodes the last node is carried up
+func AirdropMerkleTree(recipients []AirdropRecipient) (root []byte, proofs [][][]byte) {
+	if len(recipients) == 0 {
+		return nil, nil
+	}
+	level := make([][]byte, len(recipients))
+	// positions tracks the index in the current level of each recipient's ancestor
+	positions := make([]int, len(recipients))
+	proofs = make([][][]byte, len(recipients))
+	for i, r := range recipients {
+		level[i] = AirdropLeafHash(r.Address, r.Amount)
+		positions[i] = i
+	}
+	for len(level) > 1 {
+		for i, pos := range positions {
+			sibling := pos ^ 1
+			if sibling < len(level) {
+				proofs[i] = append(proofs[i], level[sibling])
+			}
+			positions[i] = pos / 2
+		}
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, hashAirdropPair(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// AirdropMerkleRootHex encodes a Merkle root for use in an AirdropV2Proposal
+func AirdropMerkleRootHex(root []byte) string {
+	return hex.EncodeToString(root)
+}
+
+// VerifyAirdropProof checks that leaf is part of the Merkle tree with the given root
+func VerifyAirdropProof(root []byte, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashAirdropPair(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+// DecodeAirdropProof decodes a hex encoded Merkle proof as found in MsgClaimAirdrop
+func DecodeAirdropProof(proof []string) ([][]byte, error) {
+	decoded := make([][]byte, len(proof))
+	for i, p := range proof {
+		node, err := hex.DecodeString(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof node %d: %v", i, err)
+		}
+		if len(node) != sha256.Size {
+			return nil, fmt.Errorf("invalid proof node %d: expected %d bytes", i, sha256.Size)
+		}
+		decoded[i] = node
+	}
+	return decoded, nil
+}
+
+// ValidateBasic checks the recipient has a valid address and a non empty, valid amount
+func (r AirdropRecipient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid recipient %s: %v", r.Address, err)
+	}
+	if r.Amount.Empty() || !r.Amount.IsValid() {
+		return fmt.Errorf("invalid amount %s for recipient %s", r.Amount, r.Address)
+	}
+	return nil
+}
+
+// Remaining returns the funds of the airdrop which have not yet been claimed
+func (a MerkleAirdrop) Remaining() sdk.Coins {
+	return a.Total.Sub(a.Claimed)
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAirdropMerkleTree(t *testing.T) {
+	addrs := []string{
+		"gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+		"gravity1n38caqg63jf9hefycw3yp95fpkpk669nvekqy2",
+		"gravity1qz4zm5s0vwfuu46lg3q0vmnwsukd8e9yfmcgjj",
+	}
+	for size := 1; size <= 9; size++ {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			recipients := make([]AirdropRecipient, size)
+			for i := range recipients {
+				recipients[i] = AirdropRecipient{
+					Address: addrs[i%len(addrs)],
+					Amount:  sdk.NewCoins(sdk.NewInt64Coin("grav", int64(i+1))),
+				}
+			}
+			root, proofs := AirdropMerkleTree(recipients)
+			require.Len(t, proofs, size)
+			for i, r := range recipients {
+				require.True(t, VerifyAirdropProof(root, AirdropLeafHash(r.Address, r.Amount), proofs[i]))
+				// a different amount is not part of the tree
+				more := r.Amount.Add(sdk.NewInt64Coin("grav", 1000))
+				require.False(t, VerifyAirdropProof(root, AirdropLeafHash(r.Address, more), proofs[i]))
+			}
+		})
+	}
+}
+
+func TestAirdropV2ProposalValidateBasic(t *testing.T) {
+	recipients := []AirdropRecipient{
+		{Address: "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm", Amount: sdk.NewCoins(sdk.NewInt64Coin("grav", 10))},
+		{Address: "gravity1n38caqg63jf9hefycw3yp95fpkpk669nvekqy2", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))},
+	}
+	root, _ := AirdropMerkleTree(recipients)
+	listed := AirdropV2Proposal{Title: "title", Description: "description", Recipients: recipients}
+	merkle := AirdropV2Proposal{
+		Title:       "title",
+		Description: "description",
+		MerkleRoot:  AirdropMerkleRootHex(root),
+		Total:       listed.TotalAmount(),
+		ClaimWindow: 10,
+	}
+
+	duplicate := listed
+	duplicate.Recipients = []AirdropRecipient{recipients[0], recipients[0]}
+	badAddress := listed
+	badAddress.Recipients = []AirdropRecipient{{Address: "cosmos1", Amount: recipients[0].Amount}}
+	both := merkle
+	both.Recipients = recipients
+	noWindow := merkle
+	noWindow.ClaimWindow = 0
+	badRoot := merkle
+	badRoot.MerkleRoot = "abcd"
+	empty := listed
+	empty.Recipients = nil
+
+	specs := map[string]struct {
+		src    AirdropV2Proposal
+		expErr bool
+	}{
+		"recipients":              {src: listed},
+		"merkle root":             {src: merkle},
+		"duplicate recipient":     {src: duplicate, expErr: true},
+		"invalid address":         {src: badAddress, expErr: true},
+		"recipients and root":     {src: both, expErr: true},
+		"merkle without window":   {src: noWindow, expErr: true},
+		"invalid merkle root":     {src: badRoot, expErr: true},
+		"no recipients or a root": {src: empty, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("grav", 10), sdk.NewInt64Coin("stake", 5)), listed.TotalAmount())
+}
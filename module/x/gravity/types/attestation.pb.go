@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -195,6 +196,188 @@ func (m *ERC20Token) GetContract() string {
 	return ""
 }
 
+// DepositReceipt links the Ethereum transaction of an observed deposit to its attestation and to what
+// the deposit resulted in on Cosmos, it is only recorded for claims which report their eth_tx_hash
+// STATUS:
+// One of credited, queued, ibc_auto_forward_queued or community_pool, updated when a queued deposit is released
+type DepositReceipt struct {
+	EthTxHash      string      `protobuf:"bytes,1,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
+	EventNonce     uint64      `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ClaimHash      string      `protobuf:"bytes,3,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	CosmosReceiver string      `protobuf:"bytes,4,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	Amount         types1.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	Status         string      `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	UpdatedHeight  uint64      `protobuf:"varint,7,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+}
+
+func (m *DepositReceipt) Reset()         { *m = DepositReceipt{} }
+func (m *DepositReceipt) String() string { return proto.CompactTextString(m) }
+func (*DepositReceipt) ProtoMessage()    {}
+func (*DepositReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{2}
+}
+func (m *DepositReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositReceipt.Merge(m, src)
+}
+func (m *DepositReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositReceipt proto.InternalMessageInfo
+
+func (m *DepositReceipt) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *DepositReceipt) GetClaimHash() string {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+func (m *DepositReceipt) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DepositReceipt) GetUpdatedHeight() uint64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+// BatchExecution records the Ethereum transaction which executed a batch, as reported by the
+// observed MsgBatchSendToEthClaim, eth_tx_hash is empty if the claims did not report it
+type BatchExecution struct {
+	TokenContract     string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce        uint64 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	EthTxHash         string `protobuf:"bytes,3,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
+	EthBlockHeight    uint64 `protobuf:"varint,4,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	EventNonce        uint64 `protobuf:"varint,5,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	CosmosBlockHeight uint64 `protobuf:"varint,6,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+}
+
+func (m *BatchExecution) Reset()         { *m = BatchExecution{} }
+func (m *BatchExecution) String() string { return proto.CompactTextString(m) }
+func (*BatchExecution) ProtoMessage()    {}
+func (*BatchExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{3}
+}
+func (m *BatchExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchExecution.Merge(m, src)
+}
+func (m *BatchExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchExecution proto.InternalMessageInfo
+
+func (m *BatchExecution) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BatchExecution) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BatchExecution) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
+func (m *BatchExecution) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
+	}
+	return 0
+}
+
+func (m *BatchExecution) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *BatchExecution) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
+	}
+	return 0
+}
+
 type EventObservation struct {
 	AttestationType string `protobuf:"bytes,1,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
 	BridgeContract  string `protobuf:"bytes,2,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
@@ -207,7 +390,7 @@ func (m *EventObservation) Reset()         { *m = EventObservation{} }
 func (m *EventObservation) String() string { return proto.CompactTextString(m) }
 func (*EventObservation) ProtoMessage()    {}
 func (*EventObservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{2}
+	return fileDescriptor_e3205613bbab7525, []int{4}
 }
 func (m *EventObservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +465,7 @@ func (m *EventInvalidSendToCosmosReceiver) Reset()         { *m = EventInvalidSe
 func (m *EventInvalidSendToCosmosReceiver) String() string { return proto.CompactTextString(m) }
 func (*EventInvalidSendToCosmosReceiver) ProtoMessage()    {}
 func (*EventInvalidSendToCosmosReceiver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{3}
+	return fileDescriptor_e3205613bbab7525, []int{5}
 }
 func (m *EventInvalidSendToCosmosReceiver) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +532,7 @@ func (m *EventSendToCosmos) Reset()         { *m = EventSendToCosmos{} }
 func (m *EventSendToCosmos) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmos) ProtoMessage()    {}
 func (*EventSendToCosmos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{4}
+	return fileDescriptor_e3205613bbab7525, []int{6}
 }
 func (m *EventSendToCosmos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +593,7 @@ func (m *EventSendToCosmosLocal) Reset()         { *m = EventSendToCosmosLocal{}
 func (m *EventSendToCosmosLocal) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmosLocal) ProtoMessage()    {}
 func (*EventSendToCosmosLocal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{5}
+	return fileDescriptor_e3205613bbab7525, []int{7}
 }
 func (m *EventSendToCosmosLocal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,6 +650,178 @@ func (m *EventSendToCosmosLocal) GetAmount() string {
 	return ""
 }
 
+type EventBridgeCircuitBreakerTripped struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Token  string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *EventBridgeCircuitBreakerTripped) Reset()         { *m = EventBridgeCircuitBreakerTripped{} }
+func (m *EventBridgeCircuitBreakerTripped) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeCircuitBreakerTripped) ProtoMessage()    {}
+func (*EventBridgeCircuitBreakerTripped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{8}
+}
+func (m *EventBridgeCircuitBreakerTripped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgeCircuitBreakerTripped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeCircuitBreakerTripped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgeCircuitBreakerTripped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeCircuitBreakerTripped.Merge(m, src)
+}
+func (m *EventBridgeCircuitBreakerTripped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeCircuitBreakerTripped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeCircuitBreakerTripped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeCircuitBreakerTripped proto.InternalMessageInfo
+
+func (m *EventBridgeCircuitBreakerTripped) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventBridgeCircuitBreakerTripped) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type EventSendToCosmosPaused struct {
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Nonce  string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Token  string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *EventSendToCosmosPaused) Reset()         { *m = EventSendToCosmosPaused{} }
+func (m *EventSendToCosmosPaused) String() string { return proto.CompactTextString(m) }
+func (*EventSendToCosmosPaused) ProtoMessage()    {}
+func (*EventSendToCosmosPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{9}
+}
+func (m *EventSendToCosmosPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSendToCosmosPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSendToCosmosPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSendToCosmosPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSendToCosmosPaused.Merge(m, src)
+}
+func (m *EventSendToCosmosPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSendToCosmosPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSendToCosmosPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSendToCosmosPaused proto.InternalMessageInfo
+
+func (m *EventSendToCosmosPaused) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosPaused) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosPaused) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type EventSendToCosmosRateLimited struct {
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Nonce  string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Token  string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *EventSendToCosmosRateLimited) Reset()         { *m = EventSendToCosmosRateLimited{} }
+func (m *EventSendToCosmosRateLimited) String() string { return proto.CompactTextString(m) }
+func (*EventSendToCosmosRateLimited) ProtoMessage()    {}
+func (*EventSendToCosmosRateLimited) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{10}
+}
+func (m *EventSendToCosmosRateLimited) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSendToCosmosRateLimited) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSendToCosmosRateLimited.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSendToCosmosRateLimited) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSendToCosmosRateLimited.Merge(m, src)
+}
+func (m *EventSendToCosmosRateLimited) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSendToCosmosRateLimited) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSendToCosmosRateLimited.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSendToCosmosRateLimited proto.InternalMessageInfo
+
+func (m *EventSendToCosmosRateLimited) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosRateLimited) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosRateLimited) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 type EventSendToCosmosPendingIbcAutoForward struct {
 	Nonce    string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
@@ -481,7 +836,7 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Reset() {
 func (m *EventSendToCosmosPendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmosPendingIbcAutoForward) ProtoMessage()    {}
 func (*EventSendToCosmosPendingIbcAutoForward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{6}
+	return fileDescriptor_e3205613bbab7525, []int{11}
 }
 func (m *EventSendToCosmosPendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +916,7 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Reset() {
 func (m *EventSendToCosmosExecutedIbcAutoForward) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmosExecutedIbcAutoForward) ProtoMessage()    {}
 func (*EventSendToCosmosExecutedIbcAutoForward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{7}
+	return fileDescriptor_e3205613bbab7525, []int{12}
 }
 func (m *EventSendToCosmosExecutedIbcAutoForward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,10 +998,15 @@ func init() {
 	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
 	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*DepositReceipt)(nil), "gravity.v1.DepositReceipt")
+	proto.RegisterType((*BatchExecution)(nil), "gravity.v1.BatchExecution")
 	proto.RegisterType((*EventObservation)(nil), "gravity.v1.EventObservation")
 	proto.RegisterType((*EventInvalidSendToCosmosReceiver)(nil), "gravity.v1.EventInvalidSendToCosmosReceiver")
 	proto.RegisterType((*EventSendToCosmos)(nil), "gravity.v1.EventSendToCosmos")
 	proto.RegisterType((*EventSendToCosmosLocal)(nil), "gravity.v1.EventSendToCosmosLocal")
+	proto.RegisterType((*EventBridgeCircuitBreakerTripped)(nil), "gravity.v1.EventBridgeCircuitBreakerTripped")
+	proto.RegisterType((*EventSendToCosmosPaused)(nil), "gravity.v1.EventSendToCosmosPaused")
+	proto.RegisterType((*EventSendToCosmosRateLimited)(nil), "gravity.v1.EventSendToCosmosRateLimited")
 	proto.RegisterType((*EventSendToCosmosPendingIbcAutoForward)(nil), "gravity.v1.EventSendToCosmosPendingIbcAutoForward")
 	proto.RegisterType((*EventSendToCosmosExecutedIbcAutoForward)(nil), "gravity.v1.EventSendToCosmosExecutedIbcAutoForward")
 }
@@ -654,54 +1014,72 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x43, 0x12, 0xc8, 0xf0, 0x80, 0x3c, 0x0b, 0x21, 0x13, 0xf1, 0x4c, 0x9e, 0xa5, 0x07,
-	0x79, 0x48, 0xd8, 0x85, 0x7e, 0x40, 0xe5, 0x38, 0x06, 0x2c, 0x05, 0x12, 0x39, 0xa6, 0x2d, 0xdd,
-	0x58, 0x8e, 0x3d, 0x75, 0x2c, 0x92, 0x99, 0xc8, 0x9e, 0xb8, 0x64, 0xd3, 0x4d, 0x37, 0x5d, 0xf6,
-	0x1b, 0xda, 0x9f, 0x41, 0xea, 0x86, 0x65, 0xd5, 0x05, 0xaa, 0x60, 0xdd, 0x7f, 0xa8, 0x3c, 0x9e,
-	0x24, 0x16, 0xa8, 0xbb, 0x56, 0xea, 0xca, 0x9c, 0x73, 0xae, 0xcf, 0x3d, 0x77, 0xc6, 0xdc, 0x80,
-	0x2d, 0x3f, 0x74, 0xe2, 0x80, 0x4c, 0x94, 0xf8, 0x40, 0x71, 0x08, 0x81, 0x11, 0x71, 0x48, 0x80,
-	0x91, 0x3c, 0x0a, 0x31, 0xc1, 0x3c, 0x60, 0xaa, 0x1c, 0x1f, 0x54, 0xd7, 0x7d, 0xec, 0x63, 0x4a,
-	0x2b, 0xc9, 0x5f, 0x69, 0x45, 0x75, 0xd3, 0xc7, 0xd8, 0x1f, 0x40, 0x85, 0xa2, 0xde, 0xf8, 0xb5,
-	0xe2, 0xa0, 0x49, 0x2a, 0x49, 0xef, 0x38, 0xb0, 0xac, 0xce, 0x2d, 0xf9, 0x2a, 0x58, 0xc2, 0xbd,
-	0x08, 0x86, 0x31, 0xf4, 0x04, 0xae, 0xc6, 0xd5, 0x97, 0xcc, 0x19, 0xe6, 0xd7, 0x41, 0x31, 0xc6,
-	0x04, 0x46, 0x42, 0xbe, 0xb6, 0x50, 0x2f, 0x9b, 0x29, 0xe0, 0x37, 0x40, 0xa9, 0x0f, 0x03, 0xbf,
-	0x4f, 0x84, 0x85, 0x1a, 0x57, 0x2f, 0x98, 0x0c, 0xf1, 0x7b, 0xa0, 0xe8, 0x0e, 0x9c, 0x60, 0x28,
-	0x14, 0x6a, 0x5c, 0x7d, 0xf9, 0x70, 0x5d, 0x4e, 0x43, 0xc8, 0xd3, 0x10, 0xb2, 0x8a, 0x26, 0x66,
-	0x5a, 0x22, 0x8d, 0x00, 0xd0, 0x4d, 0xed, 0xf0, 0x89, 0x85, 0x2f, 0x21, 0xcd, 0xe0, 0x62, 0x44,
-	0x42, 0xc7, 0x25, 0x34, 0x43, 0xd9, 0x9c, 0x61, 0xfe, 0x08, 0x94, 0x9c, 0x21, 0x1e, 0x23, 0x22,
-	0xe4, 0x13, 0xa5, 0x21, 0x5f, 0xdf, 0x6e, 0xe7, 0xbe, 0xde, 0x6e, 0xef, 0xf8, 0x01, 0xe9, 0x8f,
-	0x7b, 0xb2, 0x8b, 0x87, 0x8a, 0x8b, 0xa3, 0x21, 0x8e, 0xd8, 0x63, 0x3f, 0xf2, 0x2e, 0x15, 0x32,
-	0x19, 0xc1, 0x48, 0x36, 0x10, 0x31, 0xd9, 0xdb, 0xd2, 0x67, 0x0e, 0x54, 0xf4, 0x18, 0x22, 0xd2,
-	0xa6, 0xd3, 0xa5, 0xc3, 0xff, 0x0f, 0x2a, 0x99, 0xe3, 0xb5, 0x93, 0xb7, 0x58, 0x80, 0xb5, 0x0c,
-	0x6f, 0x4d, 0x46, 0x90, 0xdf, 0x05, 0x6b, 0xbd, 0x30, 0xf0, 0x7c, 0x68, 0xcf, 0xa2, 0xd2, 0x40,
-	0xe6, 0x6a, 0x4a, 0x6b, 0xd3, 0xc0, 0x3b, 0xf3, 0xc2, 0xbe, 0x13, 0x20, 0x3b, 0xf0, 0xe8, 0x39,
-	0x95, 0xcd, 0x15, 0x56, 0x98, 0xb0, 0x86, 0xc7, 0xff, 0x07, 0x56, 0xb3, 0xbd, 0x03, 0x8f, 0x9e,
-	0x5b, 0xd9, 0x5c, 0xc9, 0xb0, 0x06, 0xbd, 0x03, 0x84, 0x91, 0x0b, 0x85, 0x22, 0x55, 0x53, 0x20,
-	0xbd, 0x05, 0x35, 0x3a, 0x8c, 0x81, 0x62, 0x67, 0x10, 0x78, 0x5d, 0x88, 0x3c, 0x0b, 0x6b, 0x74,
-	0x7e, 0x13, 0xba, 0x30, 0x88, 0x61, 0x98, 0xdc, 0x13, 0x3b, 0xb9, 0x74, 0x24, 0x86, 0xe6, 0x8e,
-	0xf9, 0x8c, 0x63, 0xc2, 0x92, 0xe4, 0x32, 0x58, 0xd8, 0x14, 0x24, 0x1e, 0x11, 0x44, 0x1e, 0x0c,
-	0x59, 0x38, 0x86, 0xa4, 0x17, 0xe0, 0x6f, 0xda, 0x3f, 0xdb, 0xf8, 0x57, 0x34, 0x94, 0xae, 0xc0,
-	0xc6, 0x23, 0xe3, 0x16, 0x76, 0x9d, 0xc1, 0xdc, 0x85, 0xcb, 0xba, 0x54, 0xc1, 0x52, 0xc8, 0x06,
-	0x66, 0xf6, 0x33, 0xfc, 0xf3, 0x91, 0x58, 0xca, 0x42, 0x36, 0xa5, 0xf4, 0x91, 0x03, 0x3b, 0x8f,
-	0x5a, 0x77, 0x20, 0xf2, 0x02, 0xe4, 0x1b, 0x3d, 0x57, 0x1d, 0x13, 0x7c, 0x84, 0xc3, 0x37, 0x4e,
-	0xe8, 0xfd, 0xee, 0x28, 0xbc, 0x00, 0x16, 0xdd, 0xbe, 0x83, 0x10, 0x1c, 0xb0, 0x5b, 0x9f, 0x42,
-	0xe9, 0x3b, 0x07, 0x76, 0x1f, 0x85, 0xd4, 0xaf, 0xa0, 0x3b, 0x26, 0xd0, 0xfb, 0x53, 0x52, 0xf2,
-	0xff, 0x82, 0xbf, 0x48, 0x30, 0x84, 0x78, 0x4c, 0xec, 0xe4, 0x29, 0x94, 0xa8, 0xbc, 0xcc, 0x38,
-	0x2b, 0x18, 0xc2, 0xe4, 0xeb, 0x9f, 0x96, 0xb0, 0x65, 0xb2, 0x98, 0x7e, 0xfd, 0x8c, 0x3d, 0xa1,
-	0xe4, 0xde, 0x0d, 0x07, 0xca, 0x5a, 0xb2, 0x31, 0xe8, 0xff, 0x60, 0x15, 0x6c, 0x68, 0x2d, 0xd5,
-	0x38, 0xb5, 0xad, 0x8b, 0x8e, 0x6e, 0x9f, 0x9f, 0x75, 0x3b, 0xba, 0x66, 0x1c, 0x19, 0x7a, 0xb3,
-	0x92, 0xe3, 0xff, 0x01, 0x9b, 0x19, 0xad, 0xab, 0x9f, 0x35, 0x6d, 0xab, 0x6d, 0x6b, 0xed, 0xee,
-	0x69, 0xbb, 0x5b, 0xe1, 0xf8, 0x1a, 0xd8, 0xca, 0xc8, 0x0d, 0xd5, 0xd2, 0x4e, 0x66, 0x45, 0xba,
-	0x75, 0x52, 0xc9, 0x3f, 0x30, 0xa0, 0xdb, 0xc9, 0x6e, 0xea, 0x9d, 0x56, 0xfb, 0x42, 0x6f, 0x56,
-	0x16, 0x78, 0x09, 0x88, 0x19, 0xb9, 0xd5, 0x3e, 0x36, 0x34, 0x5b, 0x53, 0x5b, 0x2d, 0x5b, 0x7f,
-	0xa9, 0x6b, 0xe7, 0x96, 0xde, 0xac, 0x14, 0x1e, 0x58, 0x3c, 0x57, 0x5b, 0x5d, 0xdd, 0xb2, 0xcf,
-	0x3b, 0x4d, 0x35, 0x91, 0x8b, 0xd5, 0xc2, 0xfb, 0x4f, 0x62, 0xae, 0x71, 0x71, 0x7d, 0x27, 0x72,
-	0x37, 0x77, 0x22, 0xf7, 0xed, 0x4e, 0xe4, 0x3e, 0xdc, 0x8b, 0xb9, 0x9b, 0x7b, 0x31, 0xf7, 0xe5,
-	0x5e, 0xcc, 0xbd, 0x7a, 0x96, 0x59, 0x69, 0xc7, 0xe9, 0x8a, 0xdf, 0x6f, 0xd0, 0x9d, 0xf1, 0x10,
-	0x0e, 0xb1, 0x37, 0x1e, 0x40, 0xe5, 0x4a, 0x99, 0xfe, 0x4e, 0xd0, 0x7d, 0xd7, 0x2b, 0xd1, 0x55,
-	0xfb, 0xf4, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xcc, 0xf3, 0xc0, 0x3f, 0x06, 0x00, 0x00,
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0xd3, 0xb4, 0xbb, 0x99, 0xd0, 0x6c, 0xd6, 0x54, 0x25, 0x8d, 0xba, 0x6e, 0xb0, 0xb4,
+	0xdd, 0xb2, 0xd2, 0xda, 0x74, 0x39, 0x70, 0x44, 0x89, 0xe3, 0x6e, 0x23, 0x65, 0x9b, 0xc8, 0x71,
+	0x81, 0x22, 0x21, 0x6b, 0x6c, 0x0f, 0xf1, 0xa8, 0x89, 0x27, 0xb2, 0xc7, 0xa1, 0xbd, 0x70, 0xe1,
+	0xc2, 0x11, 0xfe, 0x02, 0xfc, 0x99, 0x95, 0xb8, 0xf4, 0x88, 0x38, 0xac, 0x50, 0x7b, 0xe6, 0xc4,
+	0x1f, 0x40, 0xf3, 0x91, 0xc4, 0x4d, 0xc4, 0x89, 0x45, 0xe2, 0x94, 0xbc, 0xcf, 0x3b, 0xf3, 0x7e,
+	0x3c, 0xcf, 0x3b, 0xe3, 0x01, 0xfb, 0xa3, 0x04, 0xce, 0x30, 0xbd, 0x36, 0x67, 0xc7, 0x26, 0xa4,
+	0x14, 0xa5, 0x14, 0x52, 0x4c, 0x62, 0x63, 0x9a, 0x10, 0x4a, 0x54, 0x20, 0xbd, 0xc6, 0xec, 0xb8,
+	0xa1, 0x05, 0x24, 0x9d, 0x90, 0xd4, 0xf4, 0x61, 0x8a, 0xcc, 0xd9, 0xb1, 0x8f, 0x28, 0x3c, 0x36,
+	0x03, 0x82, 0xe5, 0xda, 0xc6, 0xce, 0x88, 0x8c, 0x08, 0xff, 0x6b, 0xb2, 0x7f, 0x12, 0xdd, 0x1b,
+	0x11, 0x32, 0x1a, 0x23, 0x93, 0x5b, 0x7e, 0xf6, 0x8d, 0x09, 0xe3, 0x6b, 0xe1, 0xd2, 0xbf, 0x57,
+	0x40, 0xa5, 0xb5, 0x4c, 0xa9, 0x36, 0xc0, 0x43, 0xe2, 0xa7, 0x28, 0x99, 0xa1, 0xb0, 0xae, 0x34,
+	0x95, 0xa3, 0x87, 0xce, 0xc2, 0x56, 0x77, 0xc0, 0xe6, 0x8c, 0x50, 0x94, 0xd6, 0x8b, 0xcd, 0x8d,
+	0xa3, 0xb2, 0x23, 0x0c, 0x75, 0x17, 0x6c, 0x45, 0x08, 0x8f, 0x22, 0x5a, 0xdf, 0x68, 0x2a, 0x47,
+	0x25, 0x47, 0x5a, 0xea, 0x73, 0xb0, 0x19, 0x8c, 0x21, 0x9e, 0xd4, 0x4b, 0x4d, 0xe5, 0xa8, 0xf2,
+	0x72, 0xc7, 0x10, 0x45, 0x18, 0xf3, 0x22, 0x8c, 0x56, 0x7c, 0xed, 0x88, 0x25, 0xfa, 0x14, 0x00,
+	0xdb, 0xb1, 0x5e, 0x7e, 0xec, 0x92, 0x4b, 0xc4, 0x6b, 0x08, 0x48, 0x4c, 0x13, 0x18, 0x50, 0x5e,
+	0x43, 0xd9, 0x59, 0xd8, 0xea, 0x09, 0xd8, 0x82, 0x13, 0x92, 0xc5, 0xb4, 0x5e, 0x64, 0x9e, 0xb6,
+	0xf1, 0xe6, 0xed, 0x41, 0xe1, 0xf7, 0xb7, 0x07, 0x87, 0x23, 0x4c, 0xa3, 0xcc, 0x37, 0x02, 0x32,
+	0x31, 0x25, 0x47, 0xe2, 0xe7, 0x45, 0x1a, 0x5e, 0x9a, 0xf4, 0x7a, 0x8a, 0x52, 0xa3, 0x1b, 0x53,
+	0x47, 0xee, 0xd6, 0x7f, 0x2a, 0x82, 0x6a, 0x07, 0x4d, 0x49, 0x8a, 0xa9, 0x83, 0x02, 0x84, 0xa7,
+	0x54, 0xd5, 0x40, 0x05, 0xd1, 0xc8, 0xa3, 0x57, 0x5e, 0x04, 0xd3, 0x48, 0x66, 0x2e, 0x23, 0x1a,
+	0xb9, 0x57, 0xa7, 0x30, 0x8d, 0xd4, 0x03, 0x50, 0x41, 0x33, 0x14, 0x53, 0x2f, 0x26, 0x71, 0x80,
+	0x78, 0xfe, 0x92, 0x03, 0x38, 0x74, 0xc6, 0x10, 0xf5, 0x09, 0x00, 0xbc, 0x1d, 0xb1, 0x7f, 0x43,
+	0xec, 0xe7, 0x08, 0xdf, 0xff, 0x0c, 0x3c, 0x12, 0x25, 0x79, 0x09, 0xcb, 0x38, 0x43, 0x09, 0xa7,
+	0xa6, 0xec, 0x54, 0x05, 0xec, 0x48, 0x54, 0xfd, 0x74, 0xd1, 0xe3, 0x26, 0xa7, 0x6e, 0xcf, 0x10,
+	0x0b, 0x0c, 0xa6, 0xba, 0x21, 0x55, 0x37, 0x2c, 0x82, 0xe3, 0x76, 0x89, 0xb5, 0x3f, 0x6f, 0x8a,
+	0x49, 0xc1, 0x74, 0xcc, 0xd2, 0xfa, 0x16, 0x0f, 0x2c, 0x2d, 0xf5, 0x29, 0xa8, 0x66, 0xd3, 0x10,
+	0x52, 0x14, 0x7a, 0x52, 0xaa, 0x07, 0xbc, 0xf8, 0x6d, 0x89, 0x9e, 0x72, 0x50, 0xff, 0x4b, 0x01,
+	0xd5, 0x36, 0xa4, 0x41, 0x64, 0x5f, 0xa1, 0x20, 0xe3, 0xe3, 0xf0, 0x14, 0x54, 0x29, 0xd3, 0xc4,
+	0x5b, 0x11, 0x64, 0x9b, 0xa3, 0xd6, 0x5c, 0x95, 0x03, 0x50, 0xf1, 0xd9, 0xc6, 0xfb, 0xd4, 0x70,
+	0x48, 0x50, 0xb3, 0xc2, 0xed, 0xc6, 0x2a, 0xb7, 0x47, 0xa0, 0xc6, 0xfc, 0xfe, 0x98, 0x04, 0x97,
+	0xf3, 0x1a, 0x4b, 0x3c, 0x4a, 0x15, 0xd1, 0xa8, 0xcd, 0x60, 0x51, 0xe4, 0xaa, 0x0a, 0x9b, 0x6b,
+	0x2a, 0x18, 0xe0, 0x7d, 0x49, 0xf3, 0xbd, 0x68, 0x5b, 0x7c, 0xe1, 0x63, 0xe1, 0xca, 0x05, 0xd4,
+	0x7f, 0x55, 0x40, 0xcd, 0x66, 0xdb, 0xfb, 0x7c, 0xce, 0xc5, 0x31, 0xf8, 0x08, 0xd4, 0x72, 0x07,
+	0xd1, 0x63, 0xf3, 0x23, 0x3b, 0x7f, 0x94, 0xc3, 0xdd, 0xeb, 0x29, 0x62, 0xb2, 0xfa, 0x09, 0x0e,
+	0x47, 0x68, 0xc9, 0x51, 0x51, 0xc8, 0x2a, 0xe0, 0x05, 0x49, 0x87, 0xcb, 0x85, 0x11, 0xc4, 0xb1,
+	0x87, 0x43, 0xc9, 0xc3, 0xb6, 0x5c, 0xc8, 0xd0, 0x6e, 0xc8, 0x38, 0xcf, 0xe7, 0xc6, 0xa1, 0x1c,
+	0x93, 0xed, 0x1c, 0xda, 0xe5, 0xa7, 0x71, 0x49, 0x41, 0xd9, 0x11, 0x86, 0xfe, 0x1d, 0x68, 0xf2,
+	0x66, 0xba, 0xf1, 0x0c, 0x8e, 0x71, 0x38, 0x44, 0x71, 0xe8, 0x12, 0xeb, 0xfe, 0x7c, 0xed, 0x2e,
+	0xe6, 0x4b, 0xb4, 0x24, 0xad, 0x65, 0xc4, 0x62, 0x2e, 0x22, 0x43, 0xb9, 0xd8, 0xb2, 0x58, 0x61,
+	0xf0, 0x51, 0x43, 0x71, 0xb8, 0x98, 0x61, 0x69, 0xe9, 0x5f, 0x80, 0xc7, 0x3c, 0x7f, 0x3e, 0xf1,
+	0xbb, 0x48, 0xa8, 0x5f, 0x81, 0xdd, 0xb5, 0xc0, 0x3d, 0x12, 0xc0, 0xf1, 0x32, 0x8a, 0x92, 0x8f,
+	0xd2, 0x00, 0x0f, 0x17, 0xc7, 0x4c, 0x84, 0x5f, 0xd8, 0xff, 0xdc, 0x92, 0xac, 0xb2, 0x94, 0xaf,
+	0x52, 0x1f, 0x48, 0x4a, 0xdb, 0x42, 0x25, 0x9c, 0x04, 0x19, 0xa6, 0xed, 0x04, 0xc1, 0x4b, 0x94,
+	0xb8, 0x09, 0x9e, 0x4e, 0x51, 0xc8, 0xf6, 0x26, 0x08, 0xa6, 0x24, 0x9e, 0x77, 0x28, 0xac, 0x65,
+	0xa6, 0x62, 0xbe, 0x97, 0xaf, 0xc1, 0x07, 0x6b, 0xbd, 0x0c, 0x60, 0x96, 0x8a, 0x40, 0xff, 0x9a,
+	0x2a, 0x1f, 0xec, 0xaf, 0x85, 0x77, 0x20, 0x45, 0x3d, 0x3c, 0xc1, 0xf4, 0x1d, 0xe5, 0xf8, 0x59,
+	0x01, 0x87, 0xeb, 0x3d, 0xa0, 0x38, 0xc4, 0xf1, 0xa8, 0xeb, 0x07, 0xad, 0x8c, 0x92, 0x13, 0x92,
+	0x7c, 0x0b, 0x93, 0xf0, 0xbf, 0xd6, 0x47, 0xad, 0x83, 0x07, 0x41, 0x04, 0xe3, 0x18, 0x8d, 0xe5,
+	0x51, 0x98, 0x9b, 0xfa, 0x9f, 0x0a, 0x78, 0xb6, 0x56, 0xa4, 0xb8, 0xdc, 0x50, 0xf8, 0x7f, 0xa9,
+	0x52, 0xfd, 0x10, 0xbc, 0x47, 0xf1, 0x04, 0x91, 0x8c, 0x7a, 0xec, 0x57, 0xde, 0xdd, 0x15, 0x89,
+	0xb9, 0x78, 0x82, 0xf8, 0x35, 0x2c, 0x97, 0xe4, 0x2e, 0x70, 0x76, 0x0d, 0x0b, 0x54, 0x5c, 0x65,
+	0xcf, 0x6f, 0x14, 0x50, 0xb6, 0xd8, 0xf7, 0x86, 0x5f, 0x4c, 0x0d, 0xb0, 0x6b, 0xf5, 0x5a, 0xdd,
+	0xd7, 0x9e, 0x7b, 0x31, 0xb0, 0xbd, 0xf3, 0xb3, 0xe1, 0xc0, 0xb6, 0xba, 0x27, 0x5d, 0xbb, 0x53,
+	0x2b, 0xa8, 0x4f, 0xc0, 0x5e, 0xce, 0x37, 0xb4, 0xcf, 0x3a, 0x9e, 0xdb, 0xf7, 0xac, 0xfe, 0xf0,
+	0x75, 0x7f, 0x58, 0x53, 0xd4, 0x26, 0xd8, 0xcf, 0xb9, 0xdb, 0x2d, 0xd7, 0x3a, 0x5d, 0x2c, 0xb2,
+	0xdd, 0xd3, 0x5a, 0x71, 0x25, 0x00, 0xff, 0x78, 0x7b, 0x1d, 0x7b, 0xd0, 0xeb, 0x5f, 0xd8, 0x9d,
+	0xda, 0x86, 0xaa, 0x03, 0x2d, 0xe7, 0xee, 0xf5, 0x5f, 0x75, 0x2d, 0xcf, 0x6a, 0xf5, 0x7a, 0x9e,
+	0xfd, 0xa5, 0x6d, 0x9d, 0xbb, 0x76, 0xa7, 0x56, 0x5a, 0x09, 0xf1, 0x79, 0xab, 0x37, 0xb4, 0x5d,
+	0xef, 0x7c, 0xd0, 0x69, 0x31, 0xf7, 0x66, 0xa3, 0xf4, 0xc3, 0x2f, 0x5a, 0xa1, 0x7d, 0xf1, 0xe6,
+	0x56, 0x53, 0x6e, 0x6e, 0x35, 0xe5, 0x8f, 0x5b, 0x4d, 0xf9, 0xf1, 0x4e, 0x2b, 0xdc, 0xdc, 0x69,
+	0x85, 0xdf, 0xee, 0xb4, 0xc2, 0x57, 0x9f, 0xe5, 0xbe, 0xf8, 0xaf, 0xc4, 0x0b, 0xe9, 0x85, 0x38,
+	0xa2, 0xab, 0xe6, 0x84, 0x84, 0xd9, 0x18, 0x99, 0x57, 0xe6, 0xfc, 0x99, 0xc5, 0x9f, 0x03, 0xfe,
+	0x16, 0x7f, 0x89, 0x7c, 0xf2, 0xf7, 0x00, 0x02, 0xc5, 0x00, 0x9b, 0x7e, 0x09, 0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -803,7 +1181,7 @@ func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventObservation) Marshal() (dAtA []byte, err error) {
+func (m *DepositReceipt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -813,36 +1191,164 @@ func (m *EventObservation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventObservation) MarshalTo(dAtA []byte) (int, error) {
+func (m *DepositReceipt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DepositReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.AttestationId) > 0 {
-		i -= len(m.AttestationId)
-		copy(dAtA[i:], m.AttestationId)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationId)))
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.UpdatedHeight))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x38
 	}
-	if len(m.BridgeChainId) > 0 {
-		i -= len(m.BridgeChainId)
-		copy(dAtA[i:], m.BridgeChainId)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.BridgeChainId)))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Status)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AttestationId) > 0 {
+		i -= len(m.AttestationId)
+		copy(dAtA[i:], m.AttestationId)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BridgeChainId) > 0 {
+		i -= len(m.BridgeChainId)
+		copy(dAtA[i:], m.BridgeChainId)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.BridgeChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.BridgeContract) > 0 {
 		i -= len(m.BridgeContract)
@@ -1007,6 +1513,131 @@ func (m *EventSendToCosmosLocal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBridgeCircuitBreakerTripped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeCircuitBreakerTripped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeCircuitBreakerTripped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosRateLimited) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosRateLimited) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosRateLimited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSendToCosmosPendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1188,6 +1819,68 @@ func (m *ERC20Token) Size() (n int) {
 	return n
 }
 
+func (m *DepositReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.UpdatedHeight))
+	}
+	return n
+}
+
+func (m *BatchExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.BatchNonce))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.EthBlockHeight))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.CosmosBlockHeight))
+	}
+	return n
+}
+
 func (m *EventObservation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1288,21 +1981,80 @@ func (m *EventSendToCosmosLocal) Size() (n int) {
 	return n
 }
 
-func (m *EventSendToCosmosPendingIbcAutoForward) Size() (n int) {
+func (m *EventBridgeCircuitBreakerTripped) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Nonce)
+	l = len(m.Reason)
 	if l > 0 {
 		n += 1 + l + sovAttestation(uint64(l))
 	}
-	l = len(m.Receiver)
+	l = len(m.Token)
 	if l > 0 {
 		n += 1 + l + sovAttestation(uint64(l))
 	}
-	l = len(m.Token)
+	return n
+}
+
+func (m *EventSendToCosmosPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosRateLimited) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosPendingIbcAutoForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
 	if l > 0 {
 		n += 1 + l + sovAttestation(uint64(l))
 	}
@@ -1439,13 +2191,947 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claim == nil {
+				m.Claim = &types.Any{}
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -1455,16 +3141,29 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -1474,27 +3173,23 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttestation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttestation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Claim == nil {
-				m.Claim = &types.Any{}
-			}
-			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1517,7 +3212,7 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1540,15 +3235,15 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1576,11 +3271,11 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1608,9 +3303,39 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1633,7 +3358,7 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObservation) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1656,15 +3381,15 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventObservation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmosLocal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmosLocal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1692,11 +3417,11 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1724,11 +3449,11 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1756,43 +3481,11 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttestation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AttestationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1820,7 +3513,7 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1843,7 +3536,7 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
+func (m *EventBridgeCircuitBreakerTripped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1866,15 +3559,15 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBridgeCircuitBreakerTripped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBridgeCircuitBreakerTripped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1902,41 +3595,9 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttestation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
@@ -1968,38 +3629,6 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttestation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
@@ -2021,7 +3650,7 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmosPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2044,10 +3673,10 @@ func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToCosmos: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmosPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToCosmos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmosPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2167,7 +3796,7 @@ func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmosRateLimited) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2190,15 +3819,15 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToCosmosLocal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmosRateLimited: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToCosmosLocal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmosRateLimited: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2226,11 +3855,11 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2258,7 +3887,7 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2292,38 +3921,6 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttestation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
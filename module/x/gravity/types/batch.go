@@ -14,7 +14,18 @@ import (
 )
 
 func (o OutgoingTransferTx) ToInternal() (*InternalOutgoingTransferTx, error) {
-	return NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, o.Erc20Token, o.Erc20Fee)
+	tx, err := NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, o.Erc20Token, o.Erc20Fee)
+	if err != nil {
+		return nil, err
+	}
+	// txs stored before the fee share was held by the module have none
+	if !o.FeeShare.IsNil() {
+		if o.FeeShare.IsNegative() {
+			return nil, sdkerrors.Wrap(ErrInvalid, "negative fee share")
+		}
+		tx.FeeShare = o.FeeShare
+	}
+	return tx, nil
 }
 
 // InternalOutgoingTransferTx is an internal duplicate of OutgoingTransferTx with validation
@@ -24,6 +35,7 @@ type InternalOutgoingTransferTx struct {
 	DestAddress *EthAddress
 	Erc20Token  *InternalERC20Token
 	Erc20Fee    *InternalERC20Token
+	FeeShare    sdk.Int
 }
 
 func NewInternalOutgoingTransferTx(
@@ -56,6 +68,7 @@ func NewInternalOutgoingTransferTx(
 		DestAddress: dest,
 		Erc20Token:  token,
 		Erc20Fee:    fee,
+		FeeShare:    sdk.ZeroInt(),
 	}, nil
 }
 
@@ -66,6 +79,7 @@ func (i InternalOutgoingTransferTx) ToExternal() OutgoingTransferTx {
 		DestAddress: i.DestAddress.GetAddress().Hex(),
 		Erc20Token:  i.Erc20Token.ToExternal(),
 		Erc20Fee:    i.Erc20Fee.ToExternal(),
+		FeeShare:    i.FeeShare,
 	}
 }
 
@@ -146,6 +160,15 @@ func (o *OutgoingTxBatch) ToInternal() (*InternalOutgoingTxBatch, error) {
 	return NewInternalOutgingTxBatchFromExternalBatch(*o)
 }
 
+// TxIds returns the ids of the transactions in the batch, in batch order
+func (i *InternalOutgoingTxBatch) TxIds() []uint64 {
+	ids := make([]uint64, len(i.Transactions))
+	for j, tx := range i.Transactions {
+		ids[j] = tx.Id
+	}
+	return ids
+}
+
 func (i *InternalOutgoingTxBatch) ToExternal() OutgoingTxBatch {
 	txs := make([]OutgoingTransferTx, len(i.Transactions))
 	for i, tx := range i.Transactions {
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -100,6 +101,77 @@ func (m *OutgoingTxBatch) GetBlock() uint64 {
 	return 0
 }
 
+// ExecutedBatch is the archived record of a batch observed executed on Ethereum, kept after the batch
+// itself is deleted so that transfers can be traced to the batch which delivered them
+type ExecutedBatch struct {
+	BatchNonce     uint64                                 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TokenContract  string                                 `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Transactions   []OutgoingTransferTx                   `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions"`
+	TotalFees      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees"`
+	ExecutedHeight uint64                                 `protobuf:"varint,5,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height,omitempty"`
+}
+
+func (m *ExecutedBatch) Reset()         { *m = ExecutedBatch{} }
+func (m *ExecutedBatch) String() string { return proto.CompactTextString(m) }
+func (*ExecutedBatch) ProtoMessage()    {}
+func (*ExecutedBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{1}
+}
+func (m *ExecutedBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutedBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutedBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutedBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutedBatch.Merge(m, src)
+}
+func (m *ExecutedBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutedBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutedBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutedBatch proto.InternalMessageInfo
+
+func (m *ExecutedBatch) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *ExecutedBatch) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *ExecutedBatch) GetTransactions() []OutgoingTransferTx {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *ExecutedBatch) GetExecutedHeight() uint64 {
+	if m != nil {
+		return m.ExecutedHeight
+	}
+	return 0
+}
+
 // OutgoingTransferTx represents an individual send from gravity to ETH
 type OutgoingTransferTx struct {
 	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -107,13 +179,16 @@ type OutgoingTransferTx struct {
 	DestAddress string     `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
 	Erc20Token  ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token"`
 	Erc20Fee    ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee"`
+	// the Params.BridgeFeeShare of the fee, held by the module until the tx is
+	// executed and then paid to stakers, a cancel refunds it with the rest
+	FeeShare github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=fee_share,json=feeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_share"`
 }
 
 func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
 func (m *OutgoingTransferTx) String() string { return proto.CompactTextString(m) }
 func (*OutgoingTransferTx) ProtoMessage()    {}
 func (*OutgoingTransferTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{1}
+	return fileDescriptor_4453b445b0660cab, []int{2}
 }
 func (m *OutgoingTransferTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,7 +268,7 @@ func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
 func (m *OutgoingLogicCall) String() string { return proto.CompactTextString(m) }
 func (*OutgoingLogicCall) ProtoMessage()    {}
 func (*OutgoingLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{2}
+	return fileDescriptor_4453b445b0660cab, []int{3}
 }
 func (m *OutgoingLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +364,7 @@ func (m *EventOutgoingBatchCanceled) Reset()         { *m = EventOutgoingBatchCa
 func (m *EventOutgoingBatchCanceled) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingBatchCanceled) ProtoMessage()    {}
 func (*EventOutgoingBatchCanceled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{3}
+	return fileDescriptor_4453b445b0660cab, []int{4}
 }
 func (m *EventOutgoingBatchCanceled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +432,7 @@ func (m *EventOutgoingBatch) Reset()         { *m = EventOutgoingBatch{} }
 func (m *EventOutgoingBatch) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingBatch) ProtoMessage()    {}
 func (*EventOutgoingBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{4}
+	return fileDescriptor_4453b445b0660cab, []int{5}
 }
 func (m *EventOutgoingBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,6 +491,7 @@ func (m *EventOutgoingBatch) GetNonce() string {
 
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
+	proto.RegisterType((*ExecutedBatch)(nil), "gravity.v1.ExecutedBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
 	proto.RegisterType((*OutgoingLogicCall)(nil), "gravity.v1.OutgoingLogicCall")
 	proto.RegisterType((*EventOutgoingBatchCanceled)(nil), "gravity.v1.EventOutgoingBatchCanceled")
@@ -425,46 +501,52 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
 
 var fileDescriptor_4453b445b0660cab = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xa6, 0xe9, 0x47, 0x26, 0x69, 0xaa, 0xae, 0xaa, 0xc8, 0x54, 0xc8, 0x2d, 0x41, 0x40,
-	0x2f, 0x8d, 0xdb, 0xc0, 0x05, 0x24, 0x84, 0x48, 0x54, 0xa0, 0x12, 0x02, 0xc9, 0xca, 0x05, 0x2e,
-	0xd1, 0xc6, 0xbb, 0x75, 0x57, 0x75, 0xbd, 0x95, 0xbd, 0x89, 0x92, 0x7f, 0xc1, 0x89, 0x23, 0xbf,
-	0xa7, 0xc7, 0x1e, 0xe9, 0xa5, 0x42, 0xc9, 0x1f, 0x41, 0x3b, 0x6b, 0x27, 0x69, 0x2b, 0x01, 0x37,
-	0x6e, 0x9e, 0x37, 0x6f, 0x76, 0xde, 0xcc, 0x3e, 0x2f, 0xd4, 0xc3, 0x84, 0x0d, 0xa5, 0x1e, 0x7b,
-	0xc3, 0x43, 0xaf, 0xcf, 0x74, 0x70, 0xda, 0xbc, 0x48, 0x94, 0x56, 0x14, 0x32, 0xbc, 0x39, 0x3c,
-	0xdc, 0xde, 0x0a, 0x55, 0xa8, 0x10, 0xf6, 0xcc, 0x97, 0x65, 0x6c, 0x3f, 0x5c, 0xa8, 0x64, 0x5a,
-	0x8b, 0x54, 0x33, 0x2d, 0x55, 0x6c, 0xb3, 0x8d, 0x1b, 0x02, 0x1b, 0x9f, 0x07, 0x3a, 0x54, 0x32,
-	0x0e, 0xbb, 0xa3, 0xb6, 0x39, 0x99, 0xee, 0x40, 0x05, 0x5b, 0xf4, 0x62, 0x15, 0x07, 0xc2, 0x21,
-	0xbb, 0x64, 0xaf, 0xe4, 0x03, 0x42, 0x9f, 0x0c, 0x42, 0x1f, 0xc3, 0xba, 0x25, 0x68, 0x79, 0x2e,
-	0xd4, 0x40, 0x3b, 0x45, 0xa4, 0x54, 0x11, 0xec, 0x5a, 0x8c, 0x7e, 0x80, 0xaa, 0x4e, 0x58, 0x9c,
-	0xb2, 0xc0, 0xb4, 0x4b, 0x9d, 0xa5, 0xdd, 0xa5, 0xbd, 0x4a, 0xcb, 0x6d, 0xce, 0x05, 0x37, 0x67,
-	0x8d, 0x0d, 0xef, 0x44, 0x24, 0xdd, 0x51, 0xbb, 0x74, 0x79, 0xb3, 0x53, 0xf0, 0x6f, 0x55, 0xd2,
-	0x27, 0x50, 0xd3, 0xea, 0x4c, 0xc4, 0xbd, 0x40, 0xc5, 0x3a, 0x61, 0x81, 0x76, 0x4a, 0xbb, 0x64,
-	0xaf, 0xec, 0xaf, 0x23, 0xda, 0xc9, 0x40, 0xba, 0x05, 0xcb, 0xfd, 0x48, 0x05, 0x67, 0xce, 0x32,
-	0xaa, 0xb1, 0x41, 0xe3, 0x9a, 0x00, 0xbd, 0xdf, 0x87, 0xd6, 0xa0, 0x28, 0x79, 0x36, 0x5a, 0x51,
-	0x72, 0x5a, 0x87, 0x95, 0x54, 0xc4, 0x5c, 0x24, 0x38, 0x4b, 0xd9, 0xcf, 0x22, 0xfa, 0x08, 0xaa,
-	0x5c, 0xa4, 0xba, 0xc7, 0x38, 0x4f, 0x44, 0x6a, 0xa6, 0x30, 0xd9, 0x8a, 0xc1, 0xde, 0x5a, 0x88,
-	0xbe, 0x86, 0x8a, 0x48, 0x82, 0xd6, 0x41, 0x0f, 0xe5, 0xa0, 0xb6, 0x4a, 0xab, 0xbe, 0x38, 0xe7,
-	0x91, 0xdf, 0x69, 0x1d, 0x74, 0x4d, 0x36, 0x9b, 0x0f, 0xb0, 0x00, 0x11, 0xfa, 0x12, 0xca, 0xb6,
-	0xfc, 0x44, 0x08, 0x94, 0xfe, 0xb7, 0xe2, 0x35, 0xa4, 0xbf, 0x13, 0xa2, 0x71, 0x5d, 0x84, 0xcd,
-	0x7c, 0xb6, 0x8f, 0x2a, 0x94, 0x41, 0x87, 0x45, 0x11, 0x7d, 0x05, 0x65, 0x9d, 0x0d, 0x9a, 0x3a,
-	0x04, 0xb7, 0xfe, 0xe7, 0x03, 0xe7, 0x74, 0x7a, 0x00, 0xa5, 0x13, 0x21, 0x52, 0xa7, 0xf8, 0x0f,
-	0x65, 0xc8, 0xa4, 0x2f, 0xa0, 0x1e, 0x99, 0xd6, 0xb3, 0xcb, 0xb9, 0xb3, 0xaa, 0x2d, 0xcc, 0xe6,
-	0x97, 0x94, 0xef, 0xcc, 0x81, 0xd5, 0x0b, 0x36, 0x8e, 0x14, 0xe3, 0xb8, 0xaf, 0xaa, 0x9f, 0x87,
-	0x26, 0x93, 0xbb, 0xca, 0xde, 0x63, 0x1e, 0xd2, 0x67, 0xb0, 0x21, 0xe3, 0x21, 0x8b, 0x24, 0x47,
-	0x03, 0xf7, 0x24, 0x77, 0x56, 0xb0, 0xb6, 0xb6, 0x08, 0x1f, 0x73, 0xba, 0x0f, 0xf4, 0x16, 0xd1,
-	0xda, 0x78, 0x15, 0x4f, 0xdb, 0x5c, 0xcc, 0x58, 0x37, 0xcf, 0x7c, 0xb3, 0xb6, 0xe8, 0x9b, 0x1f,
-	0x04, 0xb6, 0x8f, 0x86, 0x22, 0xd6, 0xf9, 0x82, 0xf1, 0xdf, 0xe8, 0xb0, 0x38, 0x10, 0x91, 0xe0,
-	0x46, 0x4c, 0x3f, 0x91, 0x3c, 0x14, 0x73, 0x53, 0x12, 0x9c, 0xb7, 0x66, 0xe1, 0x99, 0x2b, 0x9f,
-	0xce, 0x89, 0xa7, 0x4c, 0xa2, 0x6a, 0xeb, 0xb0, 0xf5, 0x8c, 0x68, 0xd0, 0x63, 0x4e, 0x1f, 0xc0,
-	0x9a, 0xfd, 0xa7, 0x24, 0xcf, 0x36, 0xb7, 0x8a, 0xf1, 0x31, 0x37, 0x02, 0xed, 0x08, 0xd6, 0xf6,
-	0x36, 0x68, 0x7c, 0x27, 0x40, 0xef, 0x0b, 0xfc, 0xff, 0xc2, 0xda, 0x5f, 0x2e, 0x27, 0x2e, 0xb9,
-	0x9a, 0xb8, 0xe4, 0xd7, 0xc4, 0x25, 0xdf, 0xa6, 0x6e, 0xe1, 0x6a, 0xea, 0x16, 0x7e, 0x4e, 0xdd,
-	0xc2, 0xd7, 0x37, 0xa1, 0xd4, 0xa7, 0x83, 0x7e, 0x33, 0x50, 0xe7, 0xde, 0x7b, 0xeb, 0xac, 0xfd,
-	0x36, 0x36, 0xbb, 0x1b, 0x9e, 0x2b, 0x3e, 0x88, 0x84, 0x37, 0xf2, 0xf2, 0xc7, 0x4b, 0x8f, 0x2f,
-	0x44, 0xda, 0x5f, 0xc1, 0x47, 0xeb, 0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xaf, 0x76,
-	0x90, 0x0e, 0x05, 0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x6f, 0x1a, 0x49,
+	0x10, 0x65, 0xc6, 0xd8, 0x86, 0x02, 0x63, 0xb9, 0x65, 0xa1, 0x59, 0x6b, 0x85, 0x59, 0x56, 0xeb,
+	0xf5, 0xc5, 0x60, 0xb3, 0x7b, 0xd9, 0x95, 0xa2, 0x28, 0x20, 0x3b, 0x46, 0xf9, 0x92, 0x08, 0x97,
+	0xe4, 0x32, 0x6a, 0xa6, 0x8b, 0xa1, 0xe5, 0x61, 0xda, 0x9a, 0x69, 0x10, 0xfe, 0x17, 0x39, 0x45,
+	0xca, 0x25, 0xc7, 0xfc, 0x16, 0x1f, 0x7d, 0x4c, 0x72, 0xb0, 0x22, 0xfb, 0x8f, 0x44, 0xdd, 0x3d,
+	0x03, 0xd8, 0x8e, 0x14, 0x2b, 0x8a, 0x94, 0x13, 0xd4, 0xeb, 0x57, 0x5d, 0x55, 0x6f, 0xea, 0xcd,
+	0x40, 0xd9, 0x8f, 0xe8, 0x84, 0xcb, 0xb3, 0xc6, 0xe4, 0xa0, 0xd1, 0xa7, 0xd2, 0x1b, 0xd6, 0x4f,
+	0x23, 0x21, 0x05, 0x81, 0x04, 0xaf, 0x4f, 0x0e, 0xb6, 0x36, 0x7d, 0xe1, 0x0b, 0x0d, 0x37, 0xd4,
+	0x3f, 0xc3, 0xd8, 0xfa, 0x7d, 0x21, 0x93, 0x4a, 0x89, 0xb1, 0xa4, 0x92, 0x8b, 0xd0, 0x9c, 0xd6,
+	0x2e, 0x2d, 0x58, 0x7f, 0x31, 0x96, 0xbe, 0xe0, 0xa1, 0xdf, 0x9b, 0xb6, 0xd4, 0xcd, 0x64, 0x1b,
+	0x0a, 0xba, 0x84, 0x1b, 0x8a, 0xd0, 0x43, 0xc7, 0xaa, 0x5a, 0xbb, 0xd9, 0x2e, 0x68, 0xe8, 0xb9,
+	0x42, 0xc8, 0x9f, 0xb0, 0x66, 0x08, 0x92, 0x8f, 0x50, 0x8c, 0xa5, 0x63, 0x6b, 0x4a, 0x51, 0x83,
+	0x3d, 0x83, 0x91, 0x63, 0x28, 0xca, 0x88, 0x86, 0x31, 0xf5, 0x54, 0xb9, 0xd8, 0x59, 0xaa, 0x2e,
+	0xed, 0x16, 0x9a, 0x95, 0xfa, 0xbc, 0xe1, 0xfa, 0xac, 0xb0, 0xe2, 0x0d, 0x30, 0xea, 0x4d, 0x5b,
+	0xd9, 0xf3, 0xcb, 0xed, 0x4c, 0xf7, 0x46, 0x26, 0xf9, 0x0b, 0x4a, 0x52, 0x9c, 0x60, 0xe8, 0x7a,
+	0x22, 0x94, 0x11, 0xf5, 0xa4, 0x93, 0xad, 0x5a, 0xbb, 0xf9, 0xee, 0x9a, 0x46, 0xdb, 0x09, 0x48,
+	0x36, 0x61, 0xb9, 0x1f, 0x08, 0xef, 0xc4, 0x59, 0xd6, 0xdd, 0x98, 0xa0, 0xf6, 0xce, 0x86, 0xb5,
+	0xc3, 0x29, 0x7a, 0x63, 0x89, 0xec, 0x9e, 0xe3, 0xdd, 0xad, 0x67, 0x7f, 0xab, 0xde, 0xcf, 0x1b,
+	0xf0, 0x19, 0x80, 0x14, 0x92, 0x06, 0xee, 0x00, 0x31, 0x36, 0xc3, 0xb5, 0xea, 0x8a, 0xf7, 0xf9,
+	0x72, 0x7b, 0xc7, 0xe7, 0x72, 0x38, 0xee, 0xd7, 0x3d, 0x31, 0x6a, 0x78, 0x22, 0x1e, 0x89, 0x38,
+	0xf9, 0xd9, 0x8b, 0xd9, 0x49, 0x43, 0x9e, 0x9d, 0x62, 0x5c, 0xef, 0x84, 0xb2, 0x9b, 0xd7, 0x37,
+	0x1c, 0x21, 0xc6, 0xe4, 0x6f, 0x58, 0xc7, 0x64, 0x62, 0x77, 0x88, 0xdc, 0x1f, 0xca, 0x44, 0x92,
+	0x52, 0x0a, 0x1f, 0x6b, 0xb4, 0xf6, 0xc1, 0x06, 0x72, 0xb7, 0x45, 0x52, 0x02, 0x9b, 0xb3, 0x44,
+	0x17, 0x9b, 0x33, 0x52, 0x86, 0x95, 0x18, 0x43, 0x86, 0x51, 0xa2, 0x43, 0x12, 0x91, 0x3f, 0xa0,
+	0xc8, 0x30, 0x96, 0x2e, 0x65, 0x2c, 0xc2, 0x58, 0x09, 0xa0, 0x4e, 0x0b, 0x0a, 0x7b, 0x64, 0x20,
+	0xf2, 0x00, 0x0a, 0x18, 0x79, 0xcd, 0x7d, 0x57, 0x4b, 0xa7, 0x47, 0x2b, 0x34, 0xcb, 0x8b, 0x12,
+	0x1d, 0x76, 0xdb, 0xcd, 0xfd, 0x9e, 0x3a, 0x4d, 0xa4, 0x01, 0x9d, 0xa0, 0x11, 0xf2, 0x1f, 0xe4,
+	0x4d, 0xfa, 0x00, 0xd1, 0x59, 0xbe, 0x47, 0x72, 0x4e, 0xd3, 0x8f, 0x10, 0xc9, 0x13, 0xc8, 0x0f,
+	0x10, 0xdd, 0x78, 0x48, 0x23, 0x74, 0x56, 0x7e, 0x48, 0xd2, 0xdc, 0x00, 0xf1, 0xa5, 0xca, 0xaf,
+	0x7d, 0xb2, 0x61, 0x23, 0x15, 0xea, 0xa9, 0xf0, 0xb9, 0xd7, 0xa6, 0x41, 0x40, 0xfe, 0x87, 0xbc,
+	0x4c, 0x54, 0x8b, 0x1d, 0xab, 0xba, 0xf4, 0xdd, 0xee, 0xe6, 0x74, 0xb2, 0x0f, 0x59, 0xfd, 0xb0,
+	0xed, 0x7b, 0xa4, 0x69, 0x26, 0xf9, 0x17, 0xca, 0x81, 0x2a, 0x3d, 0xdb, 0xca, 0x5b, 0xba, 0x6f,
+	0xea, 0xd3, 0x74, 0x3b, 0xd3, 0x07, 0xe0, 0xc0, 0xea, 0x29, 0x3d, 0x0b, 0x04, 0x65, 0x5a, 0xfc,
+	0x62, 0x37, 0x0d, 0xd5, 0x49, 0x6a, 0x5f, 0xb3, 0x1d, 0x69, 0xa8, 0xf6, 0x87, 0x87, 0x13, 0x1a,
+	0x70, 0xa6, 0xdf, 0x14, 0x2e, 0x67, 0x5a, 0xc0, 0x62, 0xb7, 0xb4, 0x08, 0x77, 0x18, 0xd9, 0x03,
+	0x72, 0x83, 0x68, 0x0c, 0xb5, 0xaa, 0x6f, 0xdb, 0x58, 0x3c, 0x31, 0xbe, 0x9a, 0x19, 0x34, 0xb7,
+	0x68, 0xd0, 0xf7, 0x16, 0x6c, 0x1d, 0x4e, 0x30, 0x94, 0xa9, 0xc0, 0xda, 0xa5, 0x6d, 0x1a, 0x7a,
+	0x18, 0x20, 0x53, 0xcd, 0xf4, 0x23, 0xce, 0x7c, 0x9c, 0xbb, 0xd1, 0xd2, 0xf3, 0x96, 0x0c, 0x3c,
+	0xb3, 0xe3, 0xce, 0x9c, 0x38, 0xa4, 0x5c, 0x77, 0x9d, 0xd8, 0x36, 0x21, 0x2a, 0xb4, 0xc3, 0xc8,
+	0x6f, 0x90, 0x33, 0xf6, 0xe7, 0x2c, 0x51, 0x6e, 0x55, 0xc7, 0x1d, 0xa6, 0x1a, 0x34, 0x23, 0x98,
+	0xf7, 0x8b, 0x09, 0x6a, 0x6f, 0x2d, 0x20, 0x77, 0x1b, 0xfc, 0xf5, 0x8d, 0xb5, 0x5e, 0x9d, 0x5f,
+	0x55, 0xac, 0x8b, 0xab, 0x8a, 0xf5, 0xe5, 0xaa, 0x62, 0xbd, 0xb9, 0xae, 0x64, 0x2e, 0xae, 0x2b,
+	0x99, 0x8f, 0xd7, 0x95, 0xcc, 0xeb, 0x87, 0x0b, 0x1b, 0xfe, 0xd8, 0x6c, 0xd6, 0x5e, 0x4b, 0x17,
+	0xbb, 0x1d, 0x8e, 0x04, 0x1b, 0x07, 0xd8, 0x98, 0x36, 0xd2, 0xaf, 0x84, 0x5e, 0xff, 0xfe, 0x8a,
+	0xfe, 0x3a, 0xfc, 0xf3, 0x75, 0x00, 0x13, 0xfa, 0xe2, 0x99, 0x77, 0x06, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -526,6 +608,70 @@ func (m *OutgoingTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutedBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutedBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutedBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.ExecutedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OutgoingTransferTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -546,6 +692,16 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeShare.Size()
+		i -= size
+		if _, err := m.FeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -816,6 +972,33 @@ func (m *OutgoingTxBatch) Size() (n int) {
 	return n
 }
 
+func (m *ExecutedBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	l = m.TotalFees.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	if m.ExecutedHeight != 0 {
+		n += 1 + sovBatch(uint64(m.ExecutedHeight))
+	}
+	return n
+}
+
 func (m *OutgoingTransferTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -837,6 +1020,8 @@ func (m *OutgoingTransferTx) Size() (n int) {
 	n += 1 + l + sovBatch(uint64(l))
 	l = m.Erc20Fee.Size()
 	n += 1 + l + sovBatch(uint64(l))
+	l = m.FeeShare.Size()
+	n += 1 + l + sovBatch(uint64(l))
 	return n
 }
 
@@ -1111,6 +1296,194 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecutedBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutedBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutedBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, OutgoingTransferTx{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedHeight", wireType)
+			}
+			m.ExecutedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1289,6 +1662,40 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
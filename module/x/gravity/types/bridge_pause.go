@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ValidateBasic checks that the pause has a valid (or empty) token contract and pauses at least one direction
+func (p BridgePause) ValidateBasic() error {
+	if p.TokenContract != "" {
+		if err := ValidateEthAddress(p.TokenContract); err != nil {
+			return fmt.Errorf("invalid bridge pause token contract %s: %v", p.TokenContract, err)
+		}
+	}
+	if !p.Deposits && !p.Withdrawals && !p.Batches {
+		return fmt.Errorf("bridge pause for %q does not pause anything", p.TokenContract)
+	}
+	return nil
+}
+
+// appliesTo returns true if the pause covers tokenContract
+func (p BridgePause) appliesTo(tokenContract EthAddress) bool {
+	if p.TokenContract == "" {
+		return true
+	}
+	contract, err := NewEthAddress(p.TokenContract)
+	return err == nil && *contract == tokenContract
+}
+
+// DepositsPaused returns true if governance has paused deposits of tokenContract
+func (p Params) DepositsPaused(tokenContract EthAddress) bool {
+	for _, pause := range p.BridgePauses {
+		if pause.Deposits && pause.appliesTo(tokenContract) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithdrawalsPaused returns true if governance has paused sending tokenContract to Ethereum
+func (p Params) WithdrawalsPaused(tokenContract EthAddress) bool {
+	for _, pause := range p.BridgePauses {
+		if pause.Withdrawals && pause.appliesTo(tokenContract) {
+			return true
+		}
+	}
+	return false
+}
+
+// BatchesPaused returns true if governance has paused creating batches of tokenContract
+func (p Params) BatchesPaused(tokenContract EthAddress) bool {
+	for _, pause := range p.BridgePauses {
+		if pause.Batches && pause.appliesTo(tokenContract) {
+			return true
+		}
+	}
+	return false
+}
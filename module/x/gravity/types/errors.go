@@ -22,4 +22,8 @@ var (
 	ErrInvalidValset            = sdkerrors.Register(ModuleName, 15, "generated invalid valset")
 	ErrDuplicateEthereumKey     = sdkerrors.Register(ModuleName, 16, "duplicate ethereum key")
 	ErrDuplicateOrchestratorKey = sdkerrors.Register(ModuleName, 17, "duplicate orchestrator key")
+	ErrRateLimited              = sdkerrors.Register(ModuleName, 18, "rate limit exceeded")
+	ErrBridgePaused             = sdkerrors.Register(ModuleName, 19, "bridge paused")
+	ErrTransferTooSmall         = sdkerrors.Register(ModuleName, 20, "transfer amount below minimum")
+	ErrClaimDataRequired        = sdkerrors.Register(ModuleName, 21, "claim data required")
 )
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
@@ -177,6 +178,38 @@ func GravityDenom(tokenContract EthAddress) string {
 	return fmt.Sprintf("%s%s%s", GravityDenomPrefix, GravityDenomSeparator, tokenContract.GetAddress().Hex())
 }
 
+// GravityDenomMetadata builds the bank metadata for the voucher of an Ethereum originated ERC20 from the
+// token details reported by the contract. The gravity denom is the base unit, a token with decimals gets a
+// display unit named after its symbol with the decimals as exponent, so wallets show amounts as they are shown
+// on Ethereum. A symbol which is not a valid denom, or is the gravity denom, leaves the base unit as the display
+// unit. Missing details fall back to the gravity denom so that the metadata is always valid
+func GravityDenomMetadata(tokenContract EthAddress, name string, symbol string, decimals uint32) banktypes.Metadata {
+	denom := GravityDenom(tokenContract)
+	description := fmt.Sprintf("Gravity Bridge voucher for ERC20 %s", tokenContract.GetAddress().Hex())
+	if decimals > 0 {
+		description = fmt.Sprintf("%s with %d decimals", description, decimals)
+	}
+	if strings.TrimSpace(name) == "" {
+		name = denom
+	}
+	if strings.TrimSpace(symbol) == "" {
+		symbol = denom
+	}
+	metadata := banktypes.Metadata{
+		Description: description,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        name,
+		Symbol:      symbol,
+	}
+	if decimals > 0 && symbol != denom && sdk.ValidateDenom(symbol) == nil {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: symbol, Exponent: decimals})
+		metadata.Display = symbol
+	}
+	return metadata
+}
+
 // ValidateBasic performs stateless validation
 func (e *ERC20Token) ValidateBasic() error {
 	if err := ValidateEthAddress(e.Contract); err != nil {
@@ -214,6 +247,24 @@ func GravityDenomToERC20(denom string) (*EthAddress, error) {
 	}
 }
 
+// ValidateEthTxHash validates the input string as an Ethereum transaction hash, hashes must be in the
+// canonical 0x prefixed lowercase form so that every orchestrator reporting a transaction produces the same claim
+func ValidateEthTxHash(hash string) error {
+	if !strings.HasPrefix(hash, "0x") {
+		return fmt.Errorf("tx hash(%s) must start with 0x", hash)
+	}
+	if len(hash) != 2+2*gethcommon.HashLength {
+		return fmt.Errorf("tx hash(%s) must be %d bytes long", hash, gethcommon.HashLength)
+	}
+	if _, err := hex.DecodeString(hash[2:]); err != nil {
+		return fmt.Errorf("invalid hex with error: %s", err)
+	}
+	if strings.ToLower(hash) != hash {
+		return fmt.Errorf("tx hash(%s) must be lowercase", hash)
+	}
+	return nil
+}
+
 func has0xPrefix(str string) bool {
 	return len(str) >= 2 && str[0] == '0' && (str[1] == 'x' || str[1] == 'X')
 }
@@ -38,3 +38,23 @@ const (
 	AttributeKeyBatchSignatureSlashing     = "batch_signature_slashing"
 	AttributeKeyLogicCallSignatureSlashing = "logic_call_signature_slashing"
 )
+
+// TransferEventVersion is the schema version carried by the transfer lifecycle events in events.proto
+const TransferEventVersion = "1"
+
+// Reasons reported by EventTransfersUnbatched and EventDepositQueued
+const (
+	UnbatchReasonTimeout    = "timeout"
+	UnbatchReasonSuperseded = "superseded"
+
+	DepositQueuedReasonRateLimited = "rate_limited"
+	DepositQueuedReasonPaused      = "paused"
+)
+
+// Statuses of a DepositReceipt
+const (
+	DepositReceiptCredited             = "credited"
+	DepositReceiptQueued               = "queued"
+	DepositReceiptIbcAutoForwardQueued = "ibc_auto_forward_queued"
+	DepositReceiptCommunityPool        = "community_pool"
+)
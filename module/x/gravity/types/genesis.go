@@ -82,6 +82,33 @@ var (
 	// this could be for technical reasons (zero address) or non-technical reasons, these apply across all ERC20 tokens
 	ParamStoreEthereumBlacklist = []byte("EthereumBlacklist")
 
+	// ParamStoreRateLimits stores the per token limits on bridge inflow and outflow within a rolling window of blocks
+	ParamStoreRateLimits = []byte("RateLimits")
+
+	// ParamStoreBridgePauses stores the tokens and directions of the bridge which governance has paused
+	ParamStoreBridgePauses = []byte("BridgePauses")
+
+	// ParamStoreExecutedBatchArchiveSize stores the number of most recently executed batches kept in the archive
+	ParamStoreExecutedBatchArchiveSize = []byte("ExecutedBatchArchiveSize")
+
+	// ParamStoreBridgeFeeShare stores the fraction of each bridge fee which is paid to Cosmos side stakers
+	ParamStoreBridgeFeeShare = []byte("BridgeFeeShare")
+
+	// ParamStoreBridgeFeeShareToCommunityPool sends the bridge fee share to the community pool instead of stakers
+	ParamStoreBridgeFeeShareToCommunityPool = []byte("BridgeFeeShareToCommunityPool")
+
+	// ParamStoreChainFee stores the fee charged by every MsgSendToEth and paid to Cosmos side stakers
+	ParamStoreChainFee = []byte("ChainFee")
+
+	// ParamStoreMinTransferAmounts stores the per token minimum amounts which may be sent to Ethereum
+	ParamStoreMinTransferAmounts = []byte("MinTransferAmounts")
+
+	// ParamStoreBridgeSlashingEventRetention stores the number of blocks gravity slashing events are kept for
+	ParamStoreBridgeSlashingEventRetention = []byte("BridgeSlashingEventRetention")
+
+	// ParamStoreClaimDataHeight stores the Ethereum block height from which claims carry their optional data
+	ParamStoreClaimDataHeight = []byte("ClaimDataHeight")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{
 		GravityId:                    "",
@@ -103,8 +130,20 @@ var (
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
-		BridgeActive:      true,
-		EthereumBlacklist: []string{},
+		BridgeActive:                  true,
+		EthereumBlacklist:             []string{},
+		RateLimits:                    []TokenRateLimit{},
+		BridgePauses:                  []BridgePause{},
+		ExecutedBatchArchiveSize:      0,
+		BridgeFeeShare:                sdk.Dec{},
+		BridgeFeeShareToCommunityPool: false,
+		ChainFee: ChainFee{
+			BasisPoints: 0,
+			FlatFee:     sdk.Coin{Denom: "", Amount: sdk.Int{}},
+		},
+		MinTransferAmounts:           []MinTransferAmount{},
+		BridgeSlashingEventRetention: 0,
+		ClaimDataHeight:              0,
 	}
 )
 
@@ -120,18 +159,35 @@ func (s GenesisState) ValidateBasic() error {
 // DefaultGenesisState returns empty genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:             DefaultParams(),
-		GravityNonces:      GravityNonces{},
-		Valsets:            []Valset{},
-		ValsetConfirms:     []MsgValsetConfirm{},
-		Batches:            []OutgoingTxBatch{},
-		BatchConfirms:      []MsgConfirmBatch{},
-		LogicCalls:         []OutgoingLogicCall{},
-		LogicCallConfirms:  []MsgConfirmLogicCall{},
-		Attestations:       []Attestation{},
-		DelegateKeys:       []MsgSetOrchestratorAddress{},
-		Erc20ToDenoms:      []ERC20ToDenom{},
-		UnbatchedTransfers: []OutgoingTransferTx{},
+		Params:                         DefaultParams(),
+		GravityNonces:                  GravityNonces{},
+		Valsets:                        []Valset{},
+		ValsetConfirms:                 []MsgValsetConfirm{},
+		Batches:                        []OutgoingTxBatch{},
+		BatchConfirms:                  []MsgConfirmBatch{},
+		LogicCalls:                     []OutgoingLogicCall{},
+		LogicCallConfirms:              []MsgConfirmLogicCall{},
+		Attestations:                   []Attestation{},
+		DelegateKeys:                   []MsgSetOrchestratorAddress{},
+		Erc20ToDenoms:                  []ERC20ToDenom{},
+		UnbatchedTransfers:             []OutgoingTransferTx{},
+		PendingIbcAutoForwards:         []PendingIbcAutoForward{},
+		PastEthSignatureCheckpoints:    [][]byte{},
+		LastEventNoncesByValidator:     []ValidatorNonce{},
+		ValidatorBondedHeights:         []ValidatorHeight{},
+		DelegateKeyRegistrationHeights: []ValidatorHeight{},
+		BridgeSlashingEvents:           []BridgeSlashingEvent{},
+		Erc20DeploymentRequests:        []ERC20DeploymentRequest{},
+		Erc20Migrations:                []ERC20Migration{},
+		RateLimitInflows:               []RateLimitFlow{},
+		RateLimitOutflows:              []RateLimitFlow{},
+		RateLimitedDeposits:            []MsgSendToCosmosClaim{},
+		MerkleAirdrops:                 []MerkleAirdrop{},
+		AirdropClaims:                  []AirdropClaimRecord{},
+		DepositReceipts:                []DepositReceipt{},
+		BatchExecutions:                []BatchExecution{},
+		ExecutedBatches:                []ArchivedBatch{},
+		PausedDeposits:                 []MsgSendToCosmosClaim{},
 	}
 }
 
@@ -156,6 +212,14 @@ func DefaultParams() *Params {
 		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
 		BridgeActive:                 true,
 		EthereumBlacklist:            []string{},
+		RateLimits:                   []TokenRateLimit{},
+		BridgePauses:                 []BridgePause{},
+		ExecutedBatchArchiveSize:     10000,
+		BridgeFeeShare:               sdk.ZeroDec(),
+		ChainFee:                     ChainFee{BasisPoints: 0, FlatFee: sdk.Coin{Denom: "", Amount: sdk.ZeroInt()}},
+		MinTransferAmounts:           []MinTransferAmount{},
+		BridgeSlashingEventRetention: 1000000,
+		ClaimDataHeight:              0,
 	}
 }
 
@@ -209,6 +273,30 @@ func (p Params) ValidateBasic() error {
 	if err := validateValsetRewardAmount(p.ValsetReward); err != nil {
 		return sdkerrors.Wrap(err, "ValsetReward amount")
 	}
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return sdkerrors.Wrap(err, "rate limits")
+	}
+	if err := validateBridgePauses(p.BridgePauses); err != nil {
+		return sdkerrors.Wrap(err, "bridge pauses")
+	}
+	if err := validateExecutedBatchArchiveSize(p.ExecutedBatchArchiveSize); err != nil {
+		return sdkerrors.Wrap(err, "executed batch archive size")
+	}
+	if err := validateBridgeFeeShare(p.BridgeFeeShare); err != nil {
+		return sdkerrors.Wrap(err, "bridge fee share")
+	}
+	if err := validateChainFee(p.ChainFee); err != nil {
+		return sdkerrors.Wrap(err, "chain fee")
+	}
+	if err := validateMinTransferAmounts(p.MinTransferAmounts); err != nil {
+		return sdkerrors.Wrap(err, "min transfer amounts")
+	}
+	if err := validateBridgeSlashingEventRetention(p.BridgeSlashingEventRetention); err != nil {
+		return sdkerrors.Wrap(err, "bridge slashing event retention")
+	}
+	if err := validateClaimDataHeight(p.ClaimDataHeight); err != nil {
+		return sdkerrors.Wrap(err, "claim data height")
+	}
 	return nil
 }
 
@@ -258,7 +346,40 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreValsetRewardAmount, &p.ValsetReward, validateValsetRewardAmount),
 		paramtypes.NewParamSetPair(ParamStoreBridgeActive, &p.BridgeActive, validateBridgeActive),
 		paramtypes.NewParamSetPair(ParamStoreEthereumBlacklist, &p.EthereumBlacklist, validateEthereumBlacklistAddresses),
+		paramtypes.NewParamSetPair(ParamStoreRateLimits, &p.RateLimits, validateRateLimits),
+		paramtypes.NewParamSetPair(ParamStoreBridgePauses, &p.BridgePauses, validateBridgePauses),
+		paramtypes.NewParamSetPair(ParamStoreExecutedBatchArchiveSize, &p.ExecutedBatchArchiveSize, validateExecutedBatchArchiveSize),
+		paramtypes.NewParamSetPair(ParamStoreBridgeFeeShare, &p.BridgeFeeShare, validateBridgeFeeShare),
+		paramtypes.NewParamSetPair(ParamStoreBridgeFeeShareToCommunityPool, &p.BridgeFeeShareToCommunityPool, validateBridgeFeeShareToCommunityPool),
+		paramtypes.NewParamSetPair(ParamStoreChainFee, &p.ChainFee, validateChainFee),
+		paramtypes.NewParamSetPair(ParamStoreMinTransferAmounts, &p.MinTransferAmounts, validateMinTransferAmounts),
+		paramtypes.NewParamSetPair(ParamStoreBridgeSlashingEventRetention, &p.BridgeSlashingEventRetention, validateBridgeSlashingEventRetention),
+		paramtypes.NewParamSetPair(ParamStoreClaimDataHeight, &p.ClaimDataHeight, validateClaimDataHeight),
+	}
+}
+
+// optionalParamKeys are the params added since v2, a chain upgraded from v2 may not have stored them yet
+var optionalParamKeys = [][]byte{
+	ParamStoreRateLimits,
+	ParamStoreBridgePauses,
+	ParamStoreExecutedBatchArchiveSize,
+	ParamStoreBridgeFeeShare,
+	ParamStoreBridgeFeeShareToCommunityPool,
+	ParamStoreChainFee,
+	ParamStoreMinTransferAmounts,
+	ParamStoreBridgeSlashingEventRetention,
+	ParamStoreClaimDataHeight,
+}
+
+// IsOptionalParam returns true if the param with the given key may be missing from the store, in which case it
+// takes its value from DefaultParams
+func IsOptionalParam(key []byte) bool {
+	for _, optional := range optionalParamKeys {
+		if bytes.Equal(optional, key) {
+			return true
+		}
 	}
+	return false
 }
 
 // Equal returns a boolean determining if two Params types are identical.
@@ -433,6 +554,118 @@ func validateEthereumBlacklistAddresses(i interface{}) error {
 	return nil
 }
 
+func validateRateLimits(i interface{}) error {
+	limits, ok := i.([]TokenRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		if err := limit.ValidateBasic(); err != nil {
+			return err
+		}
+		contract, _ := NewEthAddress(limit.TokenContract)
+		if seen[contract.GetAddress().Hex()] {
+			return fmt.Errorf("duplicate rate limit for %s", limit.TokenContract)
+		}
+		seen[contract.GetAddress().Hex()] = true
+	}
+	return nil
+}
+
+func validateBridgePauses(i interface{}) error {
+	pauses, ok := i.([]BridgePause)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(pauses))
+	for _, pause := range pauses {
+		if err := pause.ValidateBasic(); err != nil {
+			return err
+		}
+		token := ""
+		if pause.TokenContract != "" {
+			contract, _ := NewEthAddress(pause.TokenContract)
+			token = contract.GetAddress().Hex()
+		}
+		if seen[token] {
+			return fmt.Errorf("duplicate bridge pause for %s", pause.TokenContract)
+		}
+		seen[token] = true
+	}
+	return nil
+}
+
+func validateExecutedBatchArchiveSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBridgeSlashingEventRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateClaimDataHeight(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBridgeFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// an unset share is treated as zero
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("bridge fee share %s must be between 0 and 1", v)
+	}
+	return nil
+}
+
+func validateBridgeFeeShareToCommunityPool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateChainFee(i interface{}) error {
+	fee, ok := i.(ChainFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return fee.ValidateBasic()
+}
+
+func validateMinTransferAmounts(i interface{}) error {
+	mins, ok := i.([]MinTransferAmount)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(mins))
+	for _, min := range mins {
+		if err := min.ValidateBasic(); err != nil {
+			return err
+		}
+		contract, _ := NewEthAddress(min.TokenContract)
+		if seen[contract.GetAddress().Hex()] {
+			return fmt.Errorf("duplicate min transfer amount for %s", min.TokenContract)
+		}
+		seen[contract.GetAddress().Hex()] = true
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -48,7 +48,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 //
 // bridge_active
 //
-// This boolean flag can be used by governance to temporarily halt the bridge due to a vulnerability or other issue
+// This boolean flag can be used by governance to temporarily halt the bridge due to a vulnerability or other issue,
+// it is also set to false by the circuit breaker in the EndBlocker
 // In this context halting the bridge means prevent the execution of any oracle events from Ethereum and preventing
 // the creation of new batches that may be relayed to Ethereum.
 // This does not prevent the creation of validator sets
@@ -56,6 +57,52 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // set and steal funds on Ethereum without consequence.
 // The practical outcome of this flag being set to 'false' is that deposits from Ethereum will not show up and withdraws from
 // Cosmos will not execute on Ethereum.
+//
+// rate_limits
+//
+// Per token limits on how much may flow through the bridge within a rolling window of Cosmos blocks. Deposits
+// over the inflow limit are queued until capacity frees up, they are never rejected, while MsgSendToEth over the
+// outflow limit is rejected. Tokens without an entry are not limited.
+//
+// bridge_pauses
+//
+// Allows governance to pause deposits, withdrawals or batch creation for a single token, or for every token,
+// while the rest of the bridge keeps running. Paused deposits are queued and credited once the pause is lifted,
+// paused MsgSendToEth and MsgRequestBatch are rejected.
+//
+// executed_batch_archive_size
+//
+// The number of most recently executed batches kept in the executed batch archive, which records which transfers
+// were delivered in which batch after the batch itself is deleted. Zero disables the archive.
+//
+// bridge_fee_share
+//
+// The fraction of each MsgSendToEth bridge fee which is taken when the transfer enters the pool and paid to
+// Cosmos side stakers through the fee collector, or to the community pool if bridge_fee_share_to_community_pool
+// is set. The relayer which executes the batch receives the rest. The share is not refunded if the transfer
+// is cancelled. Zero disables the share.
+//
+// chain_fee
+//
+// A fee charged by every MsgSendToEth on top of its bridge fee and paid to Cosmos side stakers, it makes filling
+// the pool with dust transfers expensive. It is not refunded if the transfer is cancelled.
+//
+// min_transfer_amounts
+//
+// Per token minimum amounts, excluding the bridge fee, below which MsgSendToEth is rejected. Tokens without an
+// entry have no minimum.
+//
+// bridge_slashing_event_retention
+//
+// The number of blocks the record of a validator being slashed by the gravity module is kept for, older records
+// are pruned by the EndBlocker. Zero keeps them forever.
+//
+// claim_data_height
+//
+// The Ethereum block height from which deposit and batch claims must carry the Ethereum tx hash, and deposit
+// claims the ERC20 metadata of the token when it has any, as part of the claim hash, so that it is voted on like
+// the rest of the claim. The data of claims for earlier events is dropped, so that orchestrators which do not
+// report it vote for the same claim. Zero drops it from every claim.
 type Params struct {
 	GravityId                    string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
 	ContractSourceHash           string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
@@ -76,7 +123,16 @@ type Params struct {
 	BridgeActive                 bool                                   `protobuf:"varint,18,opt,name=bridge_active,json=bridgeActive,proto3" json:"bridge_active,omitempty"`
 	// addresses on this blacklist are forbidden from depositing or withdrawing
 	// from Ethereum to the bridge
-	EthereumBlacklist []string `protobuf:"bytes,19,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
+	EthereumBlacklist             []string                               `protobuf:"bytes,19,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
+	RateLimits                    []TokenRateLimit                       `protobuf:"bytes,20,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	BridgePauses                  []BridgePause                          `protobuf:"bytes,21,rep,name=bridge_pauses,json=bridgePauses,proto3" json:"bridge_pauses"`
+	ExecutedBatchArchiveSize      uint64                                 `protobuf:"varint,22,opt,name=executed_batch_archive_size,json=executedBatchArchiveSize,proto3" json:"executed_batch_archive_size,omitempty"`
+	BridgeFeeShare                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=bridge_fee_share,json=bridgeFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bridge_fee_share"`
+	BridgeFeeShareToCommunityPool bool                                   `protobuf:"varint,24,opt,name=bridge_fee_share_to_community_pool,json=bridgeFeeShareToCommunityPool,proto3" json:"bridge_fee_share_to_community_pool,omitempty"`
+	ChainFee                      ChainFee                               `protobuf:"bytes,25,opt,name=chain_fee,json=chainFee,proto3" json:"chain_fee"`
+	MinTransferAmounts            []MinTransferAmount                    `protobuf:"bytes,26,rep,name=min_transfer_amounts,json=minTransferAmounts,proto3" json:"min_transfer_amounts"`
+	BridgeSlashingEventRetention  uint64                                 `protobuf:"varint,27,opt,name=bridge_slashing_event_retention,json=bridgeSlashingEventRetention,proto3" json:"bridge_slashing_event_retention,omitempty"`
+	ClaimDataHeight               uint64                                 `protobuf:"varint,28,opt,name=claim_data_height,json=claimDataHeight,proto3" json:"claim_data_height,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -210,27 +266,333 @@ func (m *Params) GetEthereumBlacklist() []string {
 	return nil
 }
 
+func (m *Params) GetRateLimits() []TokenRateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *Params) GetBridgePauses() []BridgePause {
+	if m != nil {
+		return m.BridgePauses
+	}
+	return nil
+}
+
+func (m *Params) GetExecutedBatchArchiveSize() uint64 {
+	if m != nil {
+		return m.ExecutedBatchArchiveSize
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeFeeShareToCommunityPool() bool {
+	if m != nil {
+		return m.BridgeFeeShareToCommunityPool
+	}
+	return false
+}
+
+func (m *Params) GetChainFee() ChainFee {
+	if m != nil {
+		return m.ChainFee
+	}
+	return ChainFee{}
+}
+
+func (m *Params) GetMinTransferAmounts() []MinTransferAmount {
+	if m != nil {
+		return m.MinTransferAmounts
+	}
+	return nil
+}
+
+func (m *Params) GetBridgeSlashingEventRetention() uint64 {
+	if m != nil {
+		return m.BridgeSlashingEventRetention
+	}
+	return 0
+}
+
+func (m *Params) GetClaimDataHeight() uint64 {
+	if m != nil {
+		return m.ClaimDataHeight
+	}
+	return 0
+}
+
+// ChainFee is charged by every MsgSendToEth, both parts may be set and are charged together
+type ChainFee struct {
+	// charged in the bridged denom, as basis points of the transfer amount rounded down
+	BasisPoints uint64 `protobuf:"varint,1,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	// charged as is, in its own denom, for example the staking denom
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+}
+
+func (m *ChainFee) Reset()         { *m = ChainFee{} }
+func (m *ChainFee) String() string { return proto.CompactTextString(m) }
+func (*ChainFee) ProtoMessage()    {}
+func (*ChainFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *ChainFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainFee.Merge(m, src)
+}
+func (m *ChainFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainFee proto.InternalMessageInfo
+
+func (m *ChainFee) GetBasisPoints() uint64 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *ChainFee) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
+// MinTransferAmount is the smallest amount of a token, excluding the bridge fee, which may be sent to Ethereum
+type MinTransferAmount struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MinTransferAmount) Reset()         { *m = MinTransferAmount{} }
+func (m *MinTransferAmount) String() string { return proto.CompactTextString(m) }
+func (*MinTransferAmount) ProtoMessage()    {}
+func (*MinTransferAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *MinTransferAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinTransferAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinTransferAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinTransferAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinTransferAmount.Merge(m, src)
+}
+func (m *MinTransferAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinTransferAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinTransferAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinTransferAmount proto.InternalMessageInfo
+
+func (m *MinTransferAmount) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+// BridgePause pauses some directions of the bridge for a single token, or for every token if token_contract is empty
+type BridgePause struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Deposits      bool   `protobuf:"varint,2,opt,name=deposits,proto3" json:"deposits,omitempty"`
+	Withdrawals   bool   `protobuf:"varint,3,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	Batches       bool   `protobuf:"varint,4,opt,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (m *BridgePause) Reset()         { *m = BridgePause{} }
+func (m *BridgePause) String() string { return proto.CompactTextString(m) }
+func (*BridgePause) ProtoMessage()    {}
+func (*BridgePause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *BridgePause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgePause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgePause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgePause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgePause.Merge(m, src)
+}
+func (m *BridgePause) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgePause) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgePause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgePause proto.InternalMessageInfo
+
+func (m *BridgePause) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BridgePause) GetDeposits() bool {
+	if m != nil {
+		return m.Deposits
+	}
+	return false
+}
+
+func (m *BridgePause) GetWithdrawals() bool {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return false
+}
+
+func (m *BridgePause) GetBatches() bool {
+	if m != nil {
+		return m.Batches
+	}
+	return false
+}
+
+// TokenRateLimit limits the amount of a token which may enter or leave the bridge within window blocks,
+// a zero limit disables limiting in that direction
+type TokenRateLimit struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Window        uint64                                 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	InflowLimit   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inflow_limit,json=inflowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow_limit"`
+	OutflowLimit  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outflow_limit,json=outflowLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow_limit"`
+	// if the amount deposited within window blocks, including deposits queued by the inflow limit, exceeds
+	// this threshold the circuit breaker halts the bridge, zero disables the circuit breaker for this token
+	CircuitBreakerThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=circuit_breaker_threshold,json=circuitBreakerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"circuit_breaker_threshold"`
+}
+
+func (m *TokenRateLimit) Reset()         { *m = TokenRateLimit{} }
+func (m *TokenRateLimit) String() string { return proto.CompactTextString(m) }
+func (*TokenRateLimit) ProtoMessage()    {}
+func (*TokenRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *TokenRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRateLimit.Merge(m, src)
+}
+func (m *TokenRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenRateLimit proto.InternalMessageInfo
+
+func (m *TokenRateLimit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TokenRateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 // GenesisState struct, containing all persistant data required by the Gravity module
 type GenesisState struct {
-	Params             *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	GravityNonces      GravityNonces               `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
-	Valsets            []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
-	ValsetConfirms     []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
-	Batches            []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
-	BatchConfirms      []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls         []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
-	LogicCallConfirms  []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations       []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys       []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
-	Erc20ToDenoms      []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
-	UnbatchedTransfers []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	Params                 *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	GravityNonces          GravityNonces               `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
+	Valsets                []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
+	ValsetConfirms         []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
+	Batches                []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
+	BatchConfirms          []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls             []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	LogicCallConfirms      []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations           []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys           []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
+	Erc20ToDenoms          []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
+	UnbatchedTransfers     []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	PendingIbcAutoForwards []PendingIbcAutoForward     `protobuf:"bytes,13,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	// the checkpoints of every valset, batch and logic call ever created, used to judge bad signature evidence
+	PastEthSignatureCheckpoints     [][]byte                        `protobuf:"bytes,14,rep,name=past_eth_signature_checkpoints,json=pastEthSignatureCheckpoints,proto3" json:"past_eth_signature_checkpoints,omitempty"`
+	LastEventNoncesByValidator      []ValidatorNonce                `protobuf:"bytes,15,rep,name=last_event_nonces_by_validator,json=lastEventNoncesByValidator,proto3" json:"last_event_nonces_by_validator"`
+	LastObservedEthereumBlockHeight LastObservedEthereumBlockHeight `protobuf:"bytes,16,opt,name=last_observed_ethereum_block_height,json=lastObservedEthereumBlockHeight,proto3" json:"last_observed_ethereum_block_height"`
+	LastObservedValset              *Valset                         `protobuf:"bytes,17,opt,name=last_observed_valset,json=lastObservedValset,proto3" json:"last_observed_valset,omitempty"`
+	ValidatorBondedHeights          []ValidatorHeight               `protobuf:"bytes,18,rep,name=validator_bonded_heights,json=validatorBondedHeights,proto3" json:"validator_bonded_heights"`
+	DelegateKeyRegistrationHeights  []ValidatorHeight               `protobuf:"bytes,19,rep,name=delegate_key_registration_heights,json=delegateKeyRegistrationHeights,proto3" json:"delegate_key_registration_heights"`
+	BridgeSlashingEvents            []BridgeSlashingEvent           `protobuf:"bytes,20,rep,name=bridge_slashing_events,json=bridgeSlashingEvents,proto3" json:"bridge_slashing_events"`
+	Erc20DeploymentRequests         []ERC20DeploymentRequest        `protobuf:"bytes,21,rep,name=erc20_deployment_requests,json=erc20DeploymentRequests,proto3" json:"erc20_deployment_requests"`
+	Erc20Migrations                 []ERC20Migration                `protobuf:"bytes,22,rep,name=erc20_migrations,json=erc20Migrations,proto3" json:"erc20_migrations"`
+	RateLimitInflows                []RateLimitFlow                 `protobuf:"bytes,23,rep,name=rate_limit_inflows,json=rateLimitInflows,proto3" json:"rate_limit_inflows"`
+	RateLimitOutflows               []RateLimitFlow                 `protobuf:"bytes,24,rep,name=rate_limit_outflows,json=rateLimitOutflows,proto3" json:"rate_limit_outflows"`
+	RateLimitedDeposits             []MsgSendToCosmosClaim          `protobuf:"bytes,25,rep,name=rate_limited_deposits,json=rateLimitedDeposits,proto3" json:"rate_limited_deposits"`
+	CircuitBreakerTrip              *CircuitBreakerTrip             `protobuf:"bytes,26,opt,name=circuit_breaker_trip,json=circuitBreakerTrip,proto3" json:"circuit_breaker_trip,omitempty"`
+	MerkleAirdrops                  []MerkleAirdrop                 `protobuf:"bytes,27,rep,name=merkle_airdrops,json=merkleAirdrops,proto3" json:"merkle_airdrops"`
+	AirdropClaims                   []AirdropClaimRecord            `protobuf:"bytes,28,rep,name=airdrop_claims,json=airdropClaims,proto3" json:"airdrop_claims"`
+	DepositReceipts                 []DepositReceipt                `protobuf:"bytes,29,rep,name=deposit_receipts,json=depositReceipts,proto3" json:"deposit_receipts"`
+	BatchExecutions                 []BatchExecution                `protobuf:"bytes,30,rep,name=batch_executions,json=batchExecutions,proto3" json:"batch_executions"`
+	ExecutedBatches                 []ArchivedBatch                 `protobuf:"bytes,31,rep,name=executed_batches,json=executedBatches,proto3" json:"executed_batches"`
+	PausedDeposits                  []MsgSendToCosmosClaim          `protobuf:"bytes,32,rep,name=paused_deposits,json=pausedDeposits,proto3" json:"paused_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
+	return fileDescriptor_387b0aba880adb60, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,85 +705,231 @@ func (m *GenesisState) GetUnbatchedTransfers() []OutgoingTransferTx {
 	return nil
 }
 
-// GravityCounters contains the many noces and counters required to maintain the bridge state in the genesis
-type GravityNonces struct {
-	// the nonce of the last generated validator set
-	LatestValsetNonce uint64 `protobuf:"varint,1,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
-	// the last observed Gravity.sol contract event nonce
-	LastObservedNonce uint64 `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	// the last valset nonce we have slashed, to prevent double slashing
-	LastSlashedValsetNonce uint64 `protobuf:"varint,3,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
-	// the last batch Cosmos chain block that batch slashing has completed for
-	// there is an individual batch nonce for each token type so this removes
-	// the need to store them all
-	LastSlashedBatchBlock uint64 `protobuf:"varint,4,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
-	// the last cosmos block that logic call slashing has completed for
-	LastSlashedLogicCallBlock uint64 `protobuf:"varint,5,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
-	// the last transaction id from the Gravity TX pool, this prevents ID
-	// duplication during chain upgrades
-	LastTxPoolId uint64 `protobuf:"varint,6,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
-	// the last batch id from the Gravity batch pool, this prevents ID duplication
-	// during chain upgrades
-	LastBatchId uint64 `protobuf:"varint,7,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
+func (m *GenesisState) GetPendingIbcAutoForwards() []PendingIbcAutoForward {
+	if m != nil {
+		return m.PendingIbcAutoForwards
+	}
+	return nil
 }
 
-func (m *GravityNonces) Reset()         { *m = GravityNonces{} }
-func (m *GravityNonces) String() string { return proto.CompactTextString(m) }
-func (*GravityNonces) ProtoMessage()    {}
-func (*GravityNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
-}
-func (m *GravityNonces) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GravityNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GravityNonces.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GenesisState) GetPastEthSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.PastEthSignatureCheckpoints
 	}
+	return nil
 }
-func (m *GravityNonces) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GravityNonces.Merge(m, src)
-}
-func (m *GravityNonces) XXX_Size() int {
-	return m.Size()
-}
-func (m *GravityNonces) XXX_DiscardUnknown() {
-	xxx_messageInfo_GravityNonces.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GravityNonces proto.InternalMessageInfo
 
-func (m *GravityNonces) GetLatestValsetNonce() uint64 {
+func (m *GenesisState) GetLastEventNoncesByValidator() []ValidatorNonce {
 	if m != nil {
-		return m.LatestValsetNonce
+		return m.LastEventNoncesByValidator
 	}
-	return 0
+	return nil
 }
 
-func (m *GravityNonces) GetLastObservedNonce() uint64 {
+func (m *GenesisState) GetLastObservedEthereumBlockHeight() LastObservedEthereumBlockHeight {
 	if m != nil {
-		return m.LastObservedNonce
+		return m.LastObservedEthereumBlockHeight
 	}
-	return 0
+	return LastObservedEthereumBlockHeight{}
 }
 
-func (m *GravityNonces) GetLastSlashedValsetNonce() uint64 {
+func (m *GenesisState) GetLastObservedValset() *Valset {
 	if m != nil {
-		return m.LastSlashedValsetNonce
+		return m.LastObservedValset
 	}
-	return 0
+	return nil
 }
 
-func (m *GravityNonces) GetLastSlashedBatchBlock() uint64 {
+func (m *GenesisState) GetValidatorBondedHeights() []ValidatorHeight {
 	if m != nil {
-		return m.LastSlashedBatchBlock
+		return m.ValidatorBondedHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegateKeyRegistrationHeights() []ValidatorHeight {
+	if m != nil {
+		return m.DelegateKeyRegistrationHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeSlashingEvents() []BridgeSlashingEvent {
+	if m != nil {
+		return m.BridgeSlashingEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20DeploymentRequests() []ERC20DeploymentRequest {
+	if m != nil {
+		return m.Erc20DeploymentRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Migrations() []ERC20Migration {
+	if m != nil {
+		return m.Erc20Migrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitInflows() []RateLimitFlow {
+	if m != nil {
+		return m.RateLimitInflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitOutflows() []RateLimitFlow {
+	if m != nil {
+		return m.RateLimitOutflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitedDeposits() []MsgSendToCosmosClaim {
+	if m != nil {
+		return m.RateLimitedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerTrip() *CircuitBreakerTrip {
+	if m != nil {
+		return m.CircuitBreakerTrip
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleAirdrops() []MerkleAirdrop {
+	if m != nil {
+		return m.MerkleAirdrops
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAirdropClaims() []AirdropClaimRecord {
+	if m != nil {
+		return m.AirdropClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDepositReceipts() []DepositReceipt {
+	if m != nil {
+		return m.DepositReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchExecutions() []BatchExecution {
+	if m != nil {
+		return m.BatchExecutions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedBatches() []ArchivedBatch {
+	if m != nil {
+		return m.ExecutedBatches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedDeposits() []MsgSendToCosmosClaim {
+	if m != nil {
+		return m.PausedDeposits
+	}
+	return nil
+}
+
+// GravityCounters contains the many noces and counters required to maintain the bridge state in the genesis
+type GravityNonces struct {
+	// the nonce of the last generated validator set
+	LatestValsetNonce uint64 `protobuf:"varint,1,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
+	// the last observed Gravity.sol contract event nonce
+	LastObservedNonce uint64 `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	// the last valset nonce we have slashed, to prevent double slashing
+	LastSlashedValsetNonce uint64 `protobuf:"varint,3,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
+	// the last batch Cosmos chain block that batch slashing has completed for
+	// there is an individual batch nonce for each token type so this removes
+	// the need to store them all
+	LastSlashedBatchBlock uint64 `protobuf:"varint,4,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
+	// the last cosmos block that logic call slashing has completed for
+	LastSlashedLogicCallBlock uint64 `protobuf:"varint,5,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
+	// the last transaction id from the Gravity TX pool, this prevents ID
+	// duplication during chain upgrades
+	LastTxPoolId uint64 `protobuf:"varint,6,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
+	// the last batch id from the Gravity batch pool, this prevents ID duplication
+	// during chain upgrades
+	LastBatchId uint64 `protobuf:"varint,7,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
+	// the last cosmos block height at which a validator started unbonding
+	LastUnbondingBlockHeight uint64 `protobuf:"varint,8,opt,name=last_unbonding_block_height,json=lastUnbondingBlockHeight,proto3" json:"last_unbonding_block_height,omitempty"`
+	// the last Merkle airdrop id, this prevents ID duplication during chain upgrades
+	LastMerkleAirdropId uint64 `protobuf:"varint,9,opt,name=last_merkle_airdrop_id,json=lastMerkleAirdropId,proto3" json:"last_merkle_airdrop_id,omitempty"`
+	// the last executed batch archive id, this prevents ID duplication during chain upgrades
+	LastExecutedBatchId uint64 `protobuf:"varint,10,opt,name=last_executed_batch_id,json=lastExecutedBatchId,proto3" json:"last_executed_batch_id,omitempty"`
+}
+
+func (m *GravityNonces) Reset()         { *m = GravityNonces{} }
+func (m *GravityNonces) String() string { return proto.CompactTextString(m) }
+func (*GravityNonces) ProtoMessage()    {}
+func (*GravityNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{6}
+}
+func (m *GravityNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GravityNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GravityNonces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GravityNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GravityNonces.Merge(m, src)
+}
+func (m *GravityNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *GravityNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_GravityNonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GravityNonces proto.InternalMessageInfo
+
+func (m *GravityNonces) GetLatestValsetNonce() uint64 {
+	if m != nil {
+		return m.LatestValsetNonce
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastObservedNonce() uint64 {
+	if m != nil {
+		return m.LastObservedNonce
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedValsetNonce() uint64 {
+	if m != nil {
+		return m.LastSlashedValsetNonce
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedBatchBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedBatchBlock
 	}
 	return 0
 }
@@ -447,254 +955,463 @@ func (m *GravityNonces) GetLastBatchId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
-	proto.RegisterType((*GravityNonces)(nil), "gravity.v1.GravityNonces")
+func (m *GravityNonces) GetLastUnbondingBlockHeight() uint64 {
+	if m != nil {
+		return m.LastUnbondingBlockHeight
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
-
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1169 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0xc7, 0xe0, 0x18, 0x3c, 0xb6, 0x21, 0x0c, 0x7f, 0x32, 0xfc, 0x33, 0x16, 0x55, 0x22, 0xab,
-	0x2a, 0x36, 0xb8, 0x52, 0xab, 0xb4, 0xaa, 0x5a, 0x6c, 0x48, 0x82, 0xd2, 0x14, 0x64, 0xd3, 0x56,
-	0xed, 0x65, 0x3b, 0xde, 0x1d, 0xd6, 0x2b, 0xd6, 0x3b, 0x68, 0x67, 0x6c, 0xe0, 0xd6, 0x6b, 0x6f,
-	0xfd, 0x58, 0x39, 0xe6, 0x58, 0xb5, 0x55, 0x54, 0xc1, 0x17, 0xe8, 0x47, 0x88, 0xe6, 0xcd, 0xec,
-	0x7a, 0xec, 0x70, 0xe2, 0x84, 0x79, 0xbf, 0x3f, 0xef, 0xed, 0x9b, 0xb7, 0x6f, 0x07, 0x11, 0x3f,
-	0xa6, 0xc3, 0x40, 0xde, 0xd4, 0x87, 0xfb, 0x75, 0x9f, 0x45, 0x4c, 0x04, 0xa2, 0x76, 0x19, 0x73,
-	0xc9, 0x31, 0x32, 0x48, 0x6d, 0xb8, 0xbf, 0xbe, 0xec, 0x73, 0x9f, 0x43, 0xb8, 0xae, 0x7e, 0x69,
-	0xc6, 0xfa, 0xaa, 0xa5, 0x95, 0x37, 0x97, 0xcc, 0x28, 0xd7, 0x57, 0xac, 0x78, 0x5f, 0xf8, 0xe2,
-	0x1e, 0x7a, 0x97, 0x4a, 0xb7, 0x67, 0xe2, 0x9b, 0x56, 0x9c, 0x4a, 0xc9, 0x84, 0xa4, 0x32, 0xe0,
-	0x91, 0x41, 0xcb, 0x2e, 0x17, 0x7d, 0x2e, 0xea, 0x5d, 0x2a, 0x58, 0x7d, 0xb8, 0xdf, 0x65, 0x92,
-	0xee, 0xd7, 0x5d, 0x1e, 0x18, 0x7c, 0xe7, 0x8f, 0x3c, 0xca, 0x9d, 0xd2, 0x98, 0xf6, 0x05, 0xde,
-	0x42, 0x49, 0xcd, 0x4e, 0xe0, 0x91, 0x4c, 0x25, 0x53, 0xcd, 0xb7, 0xf3, 0x26, 0x72, 0xec, 0xe1,
-	0x3d, 0xb4, 0xec, 0xf2, 0x48, 0xc6, 0xd4, 0x95, 0x8e, 0xe0, 0x83, 0xd8, 0x65, 0x4e, 0x8f, 0x8a,
-	0x1e, 0x99, 0x06, 0x22, 0x4e, 0xb0, 0x0e, 0x40, 0xaf, 0xa8, 0xe8, 0xe1, 0x2f, 0xd0, 0x93, 0x6e,
-	0x1c, 0x78, 0x3e, 0x73, 0x98, 0xec, 0xb1, 0x98, 0x0d, 0xfa, 0x0e, 0xf5, 0xbc, 0x98, 0x09, 0x41,
-	0xb2, 0x20, 0x5a, 0xd1, 0xf0, 0x91, 0x41, 0x0f, 0x34, 0x88, 0x9f, 0xa1, 0x05, 0xa3, 0x73, 0x7b,
-	0x34, 0x88, 0x54, 0x35, 0x8f, 0x2a, 0x99, 0x6a, 0xb6, 0x5d, 0xd2, 0xe1, 0x96, 0x8a, 0x1e, 0x7b,
-	0xb8, 0x81, 0x56, 0x44, 0xe0, 0x47, 0xcc, 0x73, 0x86, 0x34, 0x14, 0x4c, 0x0a, 0xe7, 0x2a, 0x88,
-	0x3c, 0x7e, 0x45, 0x72, 0xc0, 0x5e, 0xd2, 0xe0, 0x4f, 0x1a, 0xfb, 0x19, 0x20, 0x4b, 0x03, 0x3d,
-	0x64, 0xa9, 0x66, 0xd6, 0xd6, 0x34, 0x35, 0x66, 0x34, 0xcf, 0xd1, 0x9a, 0xd1, 0x84, 0xdc, 0x0f,
-	0x5c, 0xc7, 0xa5, 0x61, 0x98, 0xea, 0xe6, 0x40, 0xb7, 0xaa, 0x09, 0xdf, 0x2b, 0xbc, 0xa5, 0x60,
-	0x23, 0xdd, 0x43, 0xcb, 0x92, 0xc6, 0x3e, 0x93, 0x3a, 0x9d, 0x23, 0x83, 0x3e, 0xe3, 0x03, 0x49,
-	0xf2, 0xa0, 0xc2, 0x1a, 0x83, 0x6c, 0x67, 0x1a, 0xc1, 0x9f, 0x21, 0x4c, 0x87, 0x2c, 0xa6, 0x3e,
-	0x73, 0xba, 0x21, 0x77, 0x2f, 0x40, 0x42, 0x10, 0xf0, 0x1f, 0x1b, 0xa4, 0xa9, 0x00, 0x25, 0xc0,
-	0xdf, 0xa0, 0x8d, 0x84, 0x9d, 0xf6, 0xd8, 0x92, 0x15, 0x40, 0x46, 0x0c, 0x25, 0xe9, 0xf3, 0x48,
-	0xde, 0x45, 0x2b, 0x22, 0xa4, 0xa2, 0xe7, 0x9c, 0xab, 0xa3, 0x0b, 0x78, 0x64, 0x3a, 0x49, 0x8a,
-	0x95, 0x4c, 0xb5, 0xd8, 0xac, 0xbd, 0x7d, 0xbf, 0x3d, 0xf5, 0xf7, 0xfb, 0xed, 0x67, 0x7e, 0x20,
-	0x7b, 0x83, 0x6e, 0xcd, 0xe5, 0xfd, 0xba, 0x99, 0x27, 0xfd, 0x67, 0x57, 0x78, 0x17, 0x66, 0x76,
-	0x0f, 0x99, 0xdb, 0x5e, 0x02, 0xb3, 0x17, 0xc6, 0x4b, 0x37, 0x1e, 0xff, 0x86, 0x96, 0x27, 0x72,
-	0x40, 0x2b, 0x48, 0xe9, 0x41, 0x29, 0xf0, 0x58, 0x0a, 0xe8, 0x1c, 0x0e, 0xd0, 0xda, 0x44, 0x86,
-	0xd1, 0x39, 0x91, 0xf9, 0x07, 0xa5, 0x59, 0x1d, 0x4b, 0x93, 0x1e, 0x2b, 0x6e, 0xa1, 0xf2, 0x20,
-	0xea, 0xf2, 0xc8, 0x73, 0x80, 0x10, 0x44, 0xfe, 0xe4, 0xec, 0x2d, 0x40, 0xcb, 0x37, 0x34, 0xab,
-	0x63, 0x48, 0xe3, 0x33, 0x38, 0x44, 0x95, 0x8f, 0x3a, 0xe2, 0xa9, 0xf3, 0x73, 0xd4, 0x14, 0x51,
-	0x39, 0x88, 0x19, 0x79, 0xfc, 0xa0, 0xb2, 0x37, 0x27, 0xba, 0xe3, 0x1d, 0xc9, 0x5e, 0x27, 0xf1,
-	0xc4, 0x87, 0xa8, 0xa4, 0x8b, 0x75, 0x62, 0x76, 0x45, 0x63, 0x8f, 0x2c, 0x56, 0x32, 0xd5, 0x42,
-	0x63, 0xad, 0xa6, 0xbd, 0x6a, 0x6a, 0x47, 0xd4, 0xcc, 0x8e, 0xa8, 0xb5, 0x78, 0x10, 0x35, 0xb3,
-	0x2a, 0x7f, 0xbb, 0xa8, 0x55, 0x6d, 0x10, 0xe1, 0x4f, 0x90, 0x79, 0x0d, 0x1d, 0x95, 0x65, 0xc8,
-	0x08, 0xae, 0x64, 0xaa, 0x73, 0xed, 0xa2, 0x0e, 0x1e, 0x40, 0x0c, 0xef, 0x22, 0x6c, 0xcd, 0x23,
-	0x75, 0x2f, 0xc2, 0x40, 0x48, 0xb2, 0x54, 0x99, 0xa9, 0xe6, 0xdb, 0x8b, 0x2c, 0x9d, 0x43, 0x03,
-	0x7c, 0x95, 0xfd, 0xfd, 0xdf, 0xca, 0xd4, 0xce, 0x3f, 0x39, 0x54, 0x7c, 0xa9, 0x97, 0x68, 0x47,
-	0x52, 0xc9, 0xf0, 0xa7, 0x28, 0x77, 0x09, 0xbb, 0x09, 0xb6, 0x51, 0xa1, 0x81, 0x6b, 0xa3, 0xa5,
-	0x5a, 0xd3, 0x5b, 0xab, 0x6d, 0x18, 0xf8, 0x05, 0x9a, 0x4f, 0xb6, 0x57, 0xc4, 0x23, 0x97, 0x09,
-	0x58, 0x4c, 0xea, 0xe9, 0x2c, 0xcd, 0x4b, 0xfd, 0xf3, 0x07, 0x20, 0x98, 0xa7, 0x2b, 0xf9, 0x76,
-	0x10, 0x37, 0xd0, 0xac, 0x39, 0x51, 0x32, 0x53, 0x99, 0x99, 0x4c, 0xaa, 0x0f, 0xd2, 0x28, 0x13,
-	0x22, 0x7e, 0x8d, 0x16, 0x4c, 0x63, 0x5d, 0x1e, 0x9d, 0x07, 0x71, 0x5f, 0x2d, 0x38, 0xa5, 0xdd,
-	0xb4, 0xb5, 0x6f, 0x84, 0x99, 0x83, 0x96, 0x26, 0x19, 0x97, 0xf9, 0xa1, 0x1d, 0x14, 0xf8, 0x6b,
-	0x34, 0x6b, 0x56, 0x13, 0x79, 0x04, 0x26, 0x1b, 0xb6, 0xc9, 0xc9, 0x40, 0xfa, 0x3c, 0x88, 0xfc,
-	0xb3, 0x6b, 0x98, 0xfd, 0xa4, 0x12, 0xa3, 0xc0, 0xaf, 0xd0, 0xbc, 0x5e, 0x34, 0x69, 0x21, 0xb9,
-	0x8f, 0x3d, 0xde, 0x08, 0x3f, 0x29, 0xc1, 0xf2, 0x28, 0x81, 0x30, 0x2d, 0xe3, 0x10, 0x15, 0xac,
-	0x6d, 0x47, 0x66, 0xc1, 0x66, 0xeb, 0xbe, 0x52, 0xd2, 0xb7, 0xc3, 0x18, 0xa1, 0x30, 0xdd, 0x82,
-	0xf8, 0x47, 0xb4, 0x34, 0x72, 0x19, 0x15, 0x35, 0x07, 0x6e, 0xdb, 0xf7, 0x17, 0x35, 0xe9, 0xb7,
-	0x98, 0xfa, 0xa5, 0xc5, 0x1d, 0xa0, 0xa2, 0xf5, 0xa9, 0x13, 0x24, 0x0f, 0x7e, 0x4f, 0x6c, 0xbf,
-	0x83, 0x11, 0x9e, 0x8c, 0xb1, 0x2d, 0xc1, 0xa7, 0xa8, 0xe4, 0xb1, 0x90, 0xf9, 0x54, 0x32, 0xe7,
-	0x82, 0xdd, 0x08, 0x82, 0xc0, 0xe3, 0xe9, 0x44, 0x4d, 0x1d, 0x26, 0x4f, 0x62, 0xd5, 0x5a, 0x19,
-	0x53, 0xc9, 0x63, 0xf3, 0x89, 0x4a, 0x1c, 0x13, 0x87, 0xd7, 0xec, 0x46, 0x4d, 0xe0, 0x02, 0x8b,
-	0xdd, 0xc6, 0x9e, 0x23, 0xb9, 0xe3, 0xb1, 0x88, 0xf7, 0x05, 0x29, 0x80, 0x27, 0xb1, 0x3d, 0x8f,
-	0xda, 0xad, 0xc6, 0xde, 0x19, 0x3f, 0x54, 0x84, 0xa4, 0xf3, 0x20, 0x33, 0x31, 0xe8, 0xd9, 0x20,
-	0xd2, 0x07, 0xea, 0x39, 0x32, 0xa6, 0x91, 0x38, 0x67, 0xb1, 0x20, 0x45, 0xf0, 0x2a, 0xdf, 0x3b,
-	0x0c, 0x86, 0x74, 0x76, 0x6d, 0x1c, 0x71, 0x6a, 0x90, 0x40, 0x62, 0xe7, 0xff, 0x69, 0x54, 0x1a,
-	0x9b, 0x7f, 0x5c, 0x43, 0x4b, 0x21, 0x55, 0x2d, 0x31, 0x3b, 0x4c, 0xbf, 0x38, 0xf0, 0xae, 0x65,
-	0xdb, 0x8b, 0x1a, 0xd2, 0x13, 0x0b, 0x02, 0xcd, 0x17, 0xd2, 0xe1, 0x5d, 0xc1, 0xe2, 0x21, 0xf3,
-	0x0c, 0x7f, 0x3a, 0xe1, 0x0b, 0x79, 0x62, 0x10, 0xcd, 0x7f, 0x8e, 0xd6, 0x80, 0x0f, 0x4b, 0x29,
-	0xfd, 0x4a, 0x1b, 0xd5, 0x8c, 0xfe, 0x6e, 0x2a, 0x42, 0x47, 0xe3, 0x76, 0xaa, 0x2f, 0x11, 0x19,
-	0x93, 0xea, 0xa1, 0x86, 0x2f, 0x1b, 0xdc, 0x1d, 0xb2, 0xed, 0x15, 0x4b, 0xa9, 0xc7, 0x58, 0x81,
-	0xf8, 0x3b, 0xb4, 0x35, 0x26, 0xb4, 0xa6, 0x4f, 0xab, 0xf5, 0x4d, 0x62, 0xcd, 0x52, 0x8f, 0xe6,
-	0x0d, 0x1c, 0x9e, 0xa2, 0x05, 0x70, 0x90, 0xd7, 0xce, 0x25, 0xe7, 0xa1, 0xba, 0x7d, 0xe8, 0xfb,
-	0x44, 0x51, 0x85, 0xcf, 0xae, 0x4f, 0x39, 0x0f, 0x8f, 0x3d, 0xbc, 0x83, 0x4a, 0x40, 0xd3, 0x95,
-	0x05, 0x9e, 0xb9, 0x40, 0x14, 0x54, 0x10, 0xea, 0x39, 0xf6, 0x9a, 0xbf, 0xbc, 0xbd, 0x2d, 0x67,
-	0xde, 0xdd, 0x96, 0x33, 0xff, 0xdd, 0x96, 0x33, 0x7f, 0xde, 0x95, 0xa7, 0xde, 0xdd, 0x95, 0xa7,
-	0xfe, 0xba, 0x2b, 0x4f, 0xfd, 0xfa, 0xad, 0xb5, 0xd0, 0xcd, 0xa1, 0xec, 0x36, 0x61, 0x81, 0x4e,
-	0xfe, 0xdb, 0xe7, 0xde, 0x20, 0x64, 0xf5, 0xeb, 0x7a, 0x72, 0xcd, 0x83, 0x6d, 0xdf, 0xcd, 0xc1,
-	0xf5, 0xed, 0xf3, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x66, 0xf3, 0xce, 0x10, 0x81, 0x0a, 0x00,
-	0x00,
+func (m *GravityNonces) GetLastMerkleAirdropId() uint64 {
+	if m != nil {
+		return m.LastMerkleAirdropId
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GravityNonces) GetLastExecutedBatchId() uint64 {
+	if m != nil {
+		return m.LastExecutedBatchId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorNonce records the last event nonce a validator submitted a claim for
+type ValidatorNonce struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Nonce     uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumBlacklist) > 0 {
-		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EthereumBlacklist[iNdEx])
-			copy(dAtA[i:], m.EthereumBlacklist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlacklist[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
+func (m *ValidatorNonce) Reset()         { *m = ValidatorNonce{} }
+func (m *ValidatorNonce) String() string { return proto.CompactTextString(m) }
+func (*ValidatorNonce) ProtoMessage()    {}
+func (*ValidatorNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{7}
+}
+func (m *ValidatorNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BridgeActive {
-		i--
-		if m.BridgeActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+}
+func (m *ValidatorNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorNonce.Merge(m, src)
+}
+func (m *ValidatorNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorNonce proto.InternalMessageInfo
+
+func (m *ValidatorNonce) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	{
-		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ValidatorNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size := m.SlashFractionBadEthSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// ValidatorHeight records a per validator block height, such as the height it was last bonded at
+type ValidatorHeight struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ValidatorHeight) Reset()         { *m = ValidatorHeight{} }
+func (m *ValidatorHeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorHeight) ProtoMessage()    {}
+func (*ValidatorHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{8}
+}
+func (m *ValidatorHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.UnbondSlashingValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
-		i--
-		dAtA[i] = 0x78
-	}
-	{
-		size := m.SlashFractionLogicCall.Size()
-		i -= size
-		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+}
+func (m *ValidatorHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorHeight.Merge(m, src)
+}
+func (m *ValidatorHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorHeight proto.InternalMessageInfo
+
+func (m *ValidatorHeight) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ValidatorHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.SlashFractionValset.Size()
-		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// RateLimitFlow is the amount of a rate limited token which flowed over the bridge at a block height
+type RateLimitFlow struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Height        uint64                                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{9}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.TargetBatchTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
-		i--
-		dAtA[i] = 0x48
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.SignedLogicCallsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *RateLimitFlow) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+// AirdropClaimRecord records that a recipient has claimed their share of a Merkle airdrop
+type AirdropClaimRecord struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Claimer   string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+}
+
+func (m *AirdropClaimRecord) Reset()         { *m = AirdropClaimRecord{} }
+func (m *AirdropClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*AirdropClaimRecord) ProtoMessage()    {}
+func (*AirdropClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{10}
+}
+func (m *AirdropClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SignedValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *AirdropClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropClaimRecord.Merge(m, src)
+}
+func (m *AirdropClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropClaimRecord proto.InternalMessageInfo
+
+func (m *AirdropClaimRecord) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *AirdropClaimRecord) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+// ArchivedBatch is an executed batch along with its id in the executed batch archive
+type ArchivedBatch struct {
+	ArchiveId uint64        `protobuf:"varint,1,opt,name=archive_id,json=archiveId,proto3" json:"archive_id,omitempty"`
+	Batch     ExecutedBatch `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch"`
+}
+
+func (m *ArchivedBatch) Reset()         { *m = ArchivedBatch{} }
+func (m *ArchivedBatch) String() string { return proto.CompactTextString(m) }
+func (*ArchivedBatch) ProtoMessage()    {}
+func (*ArchivedBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{11}
+}
+func (m *ArchivedBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ArchivedBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedBatch.Merge(m, src)
+}
+func (m *ArchivedBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedBatch proto.InternalMessageInfo
+
+func (m *ArchivedBatch) GetArchiveId() uint64 {
+	if m != nil {
+		return m.ArchiveId
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ArchivedBatch) GetBatch() ExecutedBatch {
+	if m != nil {
+		return m.Batch
 	}
-	return len(dAtA) - i, nil
+	return ExecutedBatch{}
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*ChainFee)(nil), "gravity.v1.ChainFee")
+	proto.RegisterType((*MinTransferAmount)(nil), "gravity.v1.MinTransferAmount")
+	proto.RegisterType((*BridgePause)(nil), "gravity.v1.BridgePause")
+	proto.RegisterType((*TokenRateLimit)(nil), "gravity.v1.TokenRateLimit")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*GravityNonces)(nil), "gravity.v1.GravityNonces")
+	proto.RegisterType((*ValidatorNonce)(nil), "gravity.v1.ValidatorNonce")
+	proto.RegisterType((*ValidatorHeight)(nil), "gravity.v1.ValidatorHeight")
+	proto.RegisterType((*RateLimitFlow)(nil), "gravity.v1.RateLimitFlow")
+	proto.RegisterType((*AirdropClaimRecord)(nil), "gravity.v1.AirdropClaimRecord")
+	proto.RegisterType((*ArchivedBatch)(nil), "gravity.v1.ArchivedBatch")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 2367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x5b, 0x73, 0x23, 0x39,
+	0x15, 0x1e, 0x27, 0x99, 0x5c, 0x64, 0x3b, 0x17, 0xc5, 0x49, 0x94, 0xcb, 0x38, 0x5e, 0x6f, 0xed,
+	0x56, 0x6a, 0x61, 0x92, 0x99, 0x6c, 0xc1, 0xd6, 0x2e, 0x45, 0x41, 0xae, 0x33, 0x61, 0x27, 0x4c,
+	0x70, 0x32, 0x0b, 0xec, 0x3e, 0x34, 0x72, 0xb7, 0x62, 0x8b, 0xb4, 0x5b, 0xa6, 0x25, 0x3b, 0xc9,
+	0xbe, 0x40, 0xf1, 0xcc, 0x03, 0x4f, 0x54, 0xf1, 0x0f, 0xf8, 0x29, 0xfb, 0xb8, 0x8f, 0x14, 0x45,
+	0x6d, 0x51, 0x33, 0x3f, 0x82, 0x57, 0x4a, 0x47, 0x52, 0x5b, 0x6d, 0xa7, 0x60, 0x26, 0x3c, 0xc5,
+	0x7d, 0xce, 0x77, 0x3e, 0xa9, 0x8f, 0xce, 0x4d, 0x1d, 0x44, 0x5a, 0x29, 0xed, 0x73, 0x75, 0xbb,
+	0xd3, 0x7f, 0xba, 0xd3, 0x62, 0x09, 0x93, 0x5c, 0x6e, 0x77, 0x53, 0xa1, 0x04, 0x46, 0x56, 0xb3,
+	0xdd, 0x7f, 0xba, 0x56, 0x69, 0x89, 0x96, 0x00, 0xf1, 0x8e, 0xfe, 0x65, 0x10, 0x6b, 0xcb, 0x9e,
+	0xad, 0xba, 0xed, 0x32, 0x6b, 0xb9, 0xb6, 0xe4, 0xc9, 0x3b, 0xb2, 0x25, 0xef, 0x80, 0x37, 0xa9,
+	0x0a, 0xdb, 0x56, 0xbe, 0xe1, 0xc9, 0xa9, 0x52, 0x4c, 0x2a, 0xaa, 0xb8, 0x48, 0xac, 0xb6, 0x1a,
+	0x0a, 0xd9, 0x11, 0x72, 0xa7, 0x49, 0x25, 0xdb, 0xe9, 0x3f, 0x6d, 0x32, 0x45, 0x9f, 0xee, 0x84,
+	0x82, 0x5b, 0x7d, 0xfd, 0xdf, 0x65, 0x34, 0x79, 0x46, 0x53, 0xda, 0x91, 0xf8, 0x11, 0x72, 0x7b,
+	0x0e, 0x78, 0x44, 0x0a, 0xb5, 0xc2, 0xd6, 0x4c, 0x63, 0xc6, 0x4a, 0x4e, 0x22, 0xfc, 0x04, 0x55,
+	0x42, 0x91, 0xa8, 0x94, 0x86, 0x2a, 0x90, 0xa2, 0x97, 0x86, 0x2c, 0x68, 0x53, 0xd9, 0x26, 0x63,
+	0x00, 0xc4, 0x4e, 0x77, 0x0e, 0xaa, 0xe7, 0x54, 0xb6, 0xf1, 0x0f, 0xd1, 0x4a, 0x33, 0xe5, 0x51,
+	0x8b, 0x05, 0x4c, 0xb5, 0x59, 0xca, 0x7a, 0x9d, 0x80, 0x46, 0x51, 0xca, 0xa4, 0x24, 0x13, 0x60,
+	0xb4, 0x64, 0xd4, 0x47, 0x56, 0xbb, 0x67, 0x94, 0xf8, 0x43, 0x34, 0x67, 0xed, 0xc2, 0x36, 0xe5,
+	0x89, 0xde, 0xcd, 0xc3, 0x5a, 0x61, 0x6b, 0xa2, 0x51, 0x36, 0xe2, 0x03, 0x2d, 0x3d, 0x89, 0xf0,
+	0x2e, 0x5a, 0x92, 0xbc, 0x95, 0xb0, 0x28, 0xe8, 0xd3, 0x58, 0x32, 0x25, 0x83, 0x6b, 0x9e, 0x44,
+	0xe2, 0x9a, 0x4c, 0x02, 0x7a, 0xd1, 0x28, 0xbf, 0x30, 0xba, 0x5f, 0x82, 0xca, 0xb3, 0x01, 0x1f,
+	0xb2, 0xcc, 0x66, 0xca, 0xb7, 0xd9, 0x37, 0x3a, 0x6b, 0xf3, 0x29, 0x5a, 0xb5, 0x36, 0xb1, 0x68,
+	0xf1, 0x30, 0x08, 0x69, 0x1c, 0x67, 0x76, 0xd3, 0x60, 0xb7, 0x6c, 0x00, 0x2f, 0xb4, 0xfe, 0x40,
+	0xab, 0xad, 0xe9, 0x13, 0x54, 0x51, 0x34, 0x6d, 0x31, 0x65, 0x96, 0x0b, 0x14, 0xef, 0x30, 0xd1,
+	0x53, 0x64, 0x06, 0xac, 0xb0, 0xd1, 0xc1, 0x6a, 0x17, 0x46, 0x83, 0xbf, 0x8f, 0x30, 0xed, 0xb3,
+	0x94, 0xb6, 0x58, 0xd0, 0x8c, 0x45, 0x78, 0x05, 0x26, 0x04, 0x01, 0x7e, 0xde, 0x6a, 0xf6, 0xb5,
+	0x42, 0x1b, 0xe0, 0x1f, 0xa3, 0x75, 0x87, 0xce, 0x7c, 0xec, 0x99, 0x15, 0xc1, 0x8c, 0x58, 0x88,
+	0xf3, 0xf3, 0xc0, 0xbc, 0x89, 0x96, 0x64, 0x4c, 0x65, 0x3b, 0xb8, 0xd4, 0x47, 0xc7, 0x45, 0x62,
+	0x3d, 0x49, 0x4a, 0xb5, 0xc2, 0x56, 0x69, 0x7f, 0xfb, 0x9b, 0xef, 0x36, 0x1f, 0xfc, 0xe3, 0xbb,
+	0xcd, 0x0f, 0x5b, 0x5c, 0xb5, 0x7b, 0xcd, 0xed, 0x50, 0x74, 0x76, 0x6c, 0x3c, 0x99, 0x3f, 0x8f,
+	0x65, 0x74, 0x65, 0x63, 0xf7, 0x90, 0x85, 0x8d, 0x45, 0x20, 0x3b, 0xb6, 0x5c, 0xc6, 0xf1, 0xf8,
+	0x37, 0xa8, 0x32, 0xb4, 0x06, 0xb8, 0x82, 0x94, 0xef, 0xb5, 0x04, 0xce, 0x2d, 0x01, 0x9e, 0xc3,
+	0x1c, 0xad, 0x0e, 0xad, 0x30, 0x38, 0x27, 0x32, 0x7b, 0xaf, 0x65, 0x96, 0x73, 0xcb, 0x64, 0xc7,
+	0x8a, 0x0f, 0x50, 0xb5, 0x97, 0x34, 0x45, 0x12, 0x05, 0x00, 0xe0, 0x49, 0x6b, 0x38, 0xf6, 0xe6,
+	0xc0, 0xe5, 0xeb, 0x06, 0x75, 0x6e, 0x41, 0xf9, 0x18, 0xec, 0xa3, 0xda, 0x88, 0x47, 0x22, 0x7d,
+	0x7e, 0x81, 0x8e, 0x22, 0xaa, 0x7a, 0x29, 0x23, 0xf3, 0xf7, 0xda, 0xf6, 0xc6, 0x90, 0x77, 0xa2,
+	0x23, 0xd5, 0x3e, 0x77, 0x9c, 0xf8, 0x10, 0x95, 0xcd, 0x66, 0x83, 0x94, 0x5d, 0xd3, 0x34, 0x22,
+	0x0b, 0xb5, 0xc2, 0x56, 0x71, 0x77, 0x75, 0xdb, 0x70, 0x6d, 0xeb, 0x1a, 0xb1, 0x6d, 0x6b, 0xc4,
+	0xf6, 0x81, 0xe0, 0xc9, 0xfe, 0x84, 0x5e, 0xbf, 0x51, 0x32, 0x56, 0x0d, 0x30, 0xc2, 0xef, 0x23,
+	0x9b, 0x86, 0x81, 0x5e, 0xa5, 0xcf, 0x08, 0xae, 0x15, 0xb6, 0xa6, 0x1b, 0x25, 0x23, 0xdc, 0x03,
+	0x19, 0x7e, 0x8c, 0xb0, 0x17, 0x8f, 0x34, 0xbc, 0x8a, 0xb9, 0x54, 0x64, 0xb1, 0x36, 0xbe, 0x35,
+	0xd3, 0x58, 0x60, 0x59, 0x1c, 0x5a, 0x05, 0xde, 0x43, 0xc5, 0x94, 0x2a, 0x16, 0xc4, 0xbc, 0xc3,
+	0x95, 0x24, 0x95, 0xda, 0xf8, 0x56, 0x71, 0x77, 0x6d, 0x7b, 0x50, 0x42, 0xb7, 0x2f, 0xc4, 0x15,
+	0x4b, 0x1a, 0x54, 0xb1, 0x17, 0x1a, 0x62, 0x37, 0x86, 0x52, 0x27, 0x90, 0x78, 0x3f, 0xdb, 0x56,
+	0x97, 0xf6, 0x24, 0x93, 0x64, 0x09, 0x48, 0x56, 0x7c, 0x92, 0x7d, 0x00, 0x9c, 0x69, 0xbd, 0x7b,
+	0xb5, 0xe6, 0x40, 0x24, 0x75, 0x36, 0xb1, 0x1b, 0x16, 0xf6, 0x94, 0x2b, 0x0f, 0x01, 0x4d, 0xc3,
+	0x36, 0xef, 0xb3, 0x40, 0xf2, 0xaf, 0x19, 0x59, 0x36, 0xd9, 0xe4, 0x20, 0x10, 0x7c, 0x7b, 0x06,
+	0x70, 0xce, 0xbf, 0x66, 0xf8, 0x57, 0x68, 0xde, 0x6e, 0xe1, 0x92, 0xb1, 0x40, 0xb6, 0x69, 0xca,
+	0xc8, 0xca, 0xbd, 0xce, 0x71, 0xd6, 0xf0, 0x1c, 0x33, 0x76, 0xae, 0x59, 0xf0, 0x09, 0xaa, 0x0f,
+	0x33, 0x07, 0x4a, 0x04, 0xa1, 0xe8, 0x74, 0x7a, 0x89, 0x2e, 0xd8, 0x5d, 0x21, 0x62, 0x42, 0xe0,
+	0x20, 0x1e, 0xe5, 0x6d, 0x2f, 0xc4, 0x81, 0x43, 0x9d, 0x09, 0x11, 0xe3, 0x4f, 0xd0, 0x8c, 0xa9,
+	0xaa, 0x97, 0x8c, 0x91, 0x55, 0x08, 0x80, 0x8a, 0xef, 0x23, 0x28, 0xae, 0xc7, 0xcc, 0x39, 0x68,
+	0x3a, 0xb4, 0xcf, 0xf8, 0x15, 0xaa, 0x74, 0x78, 0x12, 0xa8, 0x94, 0x26, 0xf2, 0x92, 0xa5, 0x01,
+	0xed, 0x88, 0x5e, 0xa2, 0x24, 0x59, 0x03, 0x3f, 0x3f, 0xf2, 0x39, 0x4e, 0x79, 0x72, 0x61, 0x61,
+	0x7b, 0x80, 0xb2, 0x64, 0xb8, 0x33, 0xac, 0x90, 0xf8, 0x08, 0x6d, 0xda, 0x57, 0xcb, 0x32, 0x8a,
+	0xf5, 0x59, 0xa2, 0x63, 0x54, 0xb1, 0x44, 0x87, 0x31, 0x59, 0x07, 0xbf, 0x6f, 0x18, 0x98, 0x4b,
+	0xa9, 0x23, 0x0d, 0x6a, 0x38, 0x0c, 0xfe, 0x08, 0x2d, 0x84, 0x31, 0xe5, 0x9d, 0x20, 0xa2, 0x8a,
+	0x06, 0x6d, 0xc6, 0x5b, 0x6d, 0x45, 0x36, 0xc0, 0x70, 0x0e, 0x14, 0x87, 0x54, 0xd1, 0xe7, 0x20,
+	0xfe, 0x6c, 0xe2, 0x0f, 0xff, 0xac, 0x3d, 0xa8, 0x73, 0x34, 0xed, 0xde, 0x15, 0xbf, 0x87, 0x4a,
+	0x4d, 0x2a, 0xb9, 0x0c, 0xba, 0x82, 0xeb, 0x77, 0x2a, 0x80, 0x61, 0x11, 0x64, 0x67, 0x20, 0xc2,
+	0x9f, 0xa1, 0xe9, 0xcb, 0x98, 0x2a, 0x70, 0xdb, 0xd8, 0xdb, 0xe5, 0xcd, 0x94, 0x36, 0x38, 0x66,
+	0xac, 0xfe, 0xc7, 0x02, 0x5a, 0x18, 0xf1, 0x09, 0xfe, 0x00, 0xcd, 0x2a, 0x1d, 0xd5, 0x81, 0x6b,
+	0x9d, 0xb6, 0xe7, 0x96, 0x41, 0x7a, 0x60, 0x85, 0xf8, 0x18, 0x4d, 0x1a, 0x57, 0x9b, 0x4e, 0xfb,
+	0x4e, 0xb1, 0x74, 0x92, 0xa8, 0x86, 0xb5, 0xae, 0xff, 0xa9, 0x80, 0x8a, 0x5e, 0x02, 0xbc, 0xed,
+	0xf2, 0x6b, 0x68, 0x3a, 0x62, 0x5d, 0x21, 0x75, 0x5e, 0x8e, 0x41, 0x80, 0x65, 0xcf, 0xb8, 0x86,
+	0x8a, 0xd7, 0x5c, 0xb5, 0xa3, 0x94, 0x5e, 0xd3, 0x58, 0x92, 0x71, 0x50, 0xfb, 0x22, 0x4c, 0xd0,
+	0x94, 0xed, 0xb3, 0xd0, 0xf2, 0xa7, 0x1b, 0xee, 0xb1, 0xfe, 0x66, 0x0c, 0xcd, 0xe6, 0x93, 0xfa,
+	0x6d, 0x77, 0xb4, 0x8c, 0x26, 0x6d, 0xad, 0x1d, 0x83, 0x63, 0xb2, 0x4f, 0xf8, 0x17, 0xa8, 0xc4,
+	0x93, 0xcb, 0x58, 0x5c, 0x9b, 0x32, 0x42, 0xc6, 0xef, 0xe5, 0xae, 0xa2, 0xe1, 0x30, 0x3b, 0x3a,
+	0x47, 0x65, 0xd1, 0x53, 0x1e, 0xe7, 0xc4, 0xbd, 0x38, 0x4b, 0x96, 0xc4, 0x90, 0xfe, 0x16, 0xad,
+	0x86, 0x3c, 0x0d, 0x7b, 0x5c, 0x05, 0xcd, 0x94, 0xd1, 0x2b, 0x96, 0x06, 0xaa, 0x9d, 0x32, 0xd9,
+	0x16, 0xb1, 0x19, 0x74, 0xde, 0x7d, 0x81, 0x15, 0x4b, 0xb8, 0x6f, 0xf8, 0x2e, 0x1c, 0x5d, 0xfd,
+	0x6f, 0x15, 0x54, 0x7a, 0x66, 0xe6, 0xd2, 0x73, 0x45, 0x15, 0xc3, 0x1f, 0xa1, 0xc9, 0x2e, 0x8c,
+	0x7b, 0xe0, 0xdb, 0xe2, 0x2e, 0xf6, 0xf3, 0xd6, 0x0c, 0x82, 0x0d, 0x8b, 0xc0, 0xc7, 0x68, 0xd6,
+	0x2a, 0x83, 0x44, 0x24, 0x21, 0x93, 0x59, 0xe0, 0x7b, 0x36, 0xcf, 0xcc, 0xcf, 0x9f, 0x03, 0xc0,
+	0x06, 0x7e, 0xb9, 0xe5, 0x0b, 0xf1, 0x2e, 0x9a, 0xb2, 0x4d, 0x92, 0x8c, 0xd7, 0xc6, 0x87, 0x17,
+	0x35, 0xbd, 0xd1, 0xa5, 0x8c, 0x05, 0xe2, 0xcf, 0xd1, 0x9c, 0xf9, 0xa9, 0x83, 0xe1, 0x92, 0xa7,
+	0x1d, 0x1d, 0x40, 0xda, 0x76, 0x23, 0x57, 0x68, 0xa4, 0x6d, 0xad, 0x07, 0x06, 0x64, 0x59, 0x66,
+	0xfb, 0xbe, 0x50, 0xe2, 0x1f, 0x0d, 0xa2, 0xf0, 0x21, 0x90, 0xac, 0xfb, 0x24, 0x2f, 0x7b, 0xaa,
+	0x25, 0x78, 0xd2, 0xba, 0xb8, 0x81, 0x8a, 0xee, 0x76, 0x62, 0x2d, 0xf0, 0x73, 0x34, 0x0b, 0x3f,
+	0x07, 0x1b, 0x99, 0x1c, 0xe5, 0x38, 0x95, 0x2d, 0xb7, 0x05, 0x8f, 0xa3, 0x0c, 0x86, 0xd9, 0x36,
+	0x0e, 0x51, 0xd1, 0x1b, 0x20, 0xc9, 0xd4, 0x68, 0xe1, 0x74, 0x5b, 0xc9, 0x06, 0x0e, 0xd7, 0xe8,
+	0x62, 0x27, 0x90, 0xf8, 0x15, 0x5a, 0x1c, 0xb0, 0x0c, 0x36, 0x35, 0x0d, 0x6c, 0x9b, 0x77, 0x6f,
+	0x6a, 0x98, 0x6f, 0x21, 0xe3, 0xcb, 0x36, 0xb7, 0x87, 0x4a, 0xde, 0xed, 0x41, 0x92, 0x99, 0xd1,
+	0xf6, 0xb9, 0x37, 0xd0, 0xbb, 0xf6, 0xe9, 0x9b, 0xe0, 0x33, 0x54, 0x8e, 0x58, 0xcc, 0x5a, 0xba,
+	0x93, 0x5f, 0xb1, 0x5b, 0x49, 0x10, 0x70, 0x7c, 0x30, 0xb4, 0xa7, 0x73, 0xa6, 0x5e, 0xa6, 0xda,
+	0xb5, 0x2a, 0xa5, 0x4a, 0xa4, 0x76, 0xea, 0x77, 0x8c, 0x8e, 0xe1, 0x73, 0x76, 0xab, 0x23, 0x70,
+	0x8e, 0xa5, 0xe1, 0xee, 0x13, 0xdd, 0xec, 0x22, 0x96, 0x88, 0x8e, 0x24, 0x45, 0xe0, 0x24, 0x3e,
+	0xe7, 0x51, 0xe3, 0x60, 0xf7, 0xc9, 0x85, 0x38, 0xd4, 0x00, 0xe7, 0x79, 0x30, 0xb3, 0x32, 0xf0,
+	0x59, 0x2f, 0x31, 0x07, 0x1a, 0x65, 0x1d, 0x4c, 0x92, 0x12, 0x70, 0x55, 0xef, 0x0c, 0x06, 0x0b,
+	0xba, 0xb8, 0x71, 0xbd, 0x2b, 0x23, 0x70, 0x2a, 0x89, 0x9b, 0x68, 0xb5, 0xcb, 0x92, 0x48, 0xf7,
+	0x2c, 0xde, 0x0c, 0x03, 0xda, 0x53, 0x22, 0xb8, 0x14, 0xa9, 0x1e, 0x93, 0x24, 0x29, 0x03, 0xf9,
+	0x7b, 0xb9, 0xfc, 0x32, 0xe0, 0x93, 0x66, 0xb8, 0xd7, 0x53, 0xe2, 0xd8, 0x20, 0x2d, 0xff, 0x72,
+	0xf7, 0x2e, 0xa5, 0xd4, 0x13, 0x67, 0x97, 0x4a, 0x95, 0x1f, 0x0f, 0x83, 0xb0, 0xcd, 0xc2, 0x2b,
+	0xdb, 0xac, 0x66, 0x6b, 0xe3, 0x5b, 0xa5, 0xc6, 0xba, 0x46, 0xf9, 0xe3, 0xde, 0xc1, 0x00, 0x82,
+	0x23, 0x54, 0x8d, 0x81, 0x04, 0x3a, 0xab, 0x49, 0xe6, 0xa0, 0x79, 0xab, 0x67, 0x57, 0x1e, 0xe9,
+	0x43, 0x20, 0x73, 0xa3, 0x23, 0xd7, 0x17, 0x4e, 0x09, 0x69, 0x6c, 0xb7, 0xb9, 0xa6, 0x79, 0xa0,
+	0xf7, 0xda, 0x8c, 0xbf, 0xcd, 0x60, 0xf8, 0xf7, 0xe8, 0x7d, 0x58, 0x45, 0x34, 0x25, 0x4b, 0xfb,
+	0x2c, 0x1a, 0xbe, 0x92, 0xd8, 0xae, 0x3c, 0x0f, 0x45, 0xe4, 0x7b, 0xfe, 0x52, 0x2f, 0xa8, 0x54,
+	0x2f, 0xad, 0x55, 0xee, 0x96, 0x62, 0x3a, 0xb6, 0x5d, 0x7b, 0x33, 0xfe, 0xef, 0x30, 0x7c, 0x88,
+	0x2a, 0xf9, 0x0d, 0xd8, 0xdb, 0xcc, 0xc2, 0x68, 0xa9, 0x33, 0x65, 0xa3, 0x81, 0x7d, 0x4a, 0x23,
+	0xc3, 0x5f, 0x21, 0x92, 0xf9, 0x25, 0xd0, 0x53, 0x3c, 0x8b, 0xec, 0xd6, 0x25, 0xc1, 0xa3, 0xa9,
+	0x9f, 0xbd, 0x7f, 0x6e, 0xaf, 0xcb, 0x19, 0xc5, 0x3e, 0x30, 0x18, 0xa5, 0xc4, 0x31, 0x7a, 0xcf,
+	0xcf, 0x91, 0x20, 0x65, 0x2d, 0x0e, 0x89, 0xa0, 0xaf, 0x01, 0x6e, 0x95, 0xc5, 0xb7, 0x5d, 0xa5,
+	0xea, 0x65, 0x4b, 0xc3, 0x63, 0x72, 0xab, 0x7d, 0x85, 0x96, 0xef, 0x1c, 0xae, 0xdc, 0x88, 0xbd,
+	0x39, 0x3a, 0x1d, 0xe7, 0xe6, 0x2b, 0xbb, 0x4c, 0xe5, 0x8e, 0xd1, 0x4b, 0x07, 0xd5, 0xaa, 0x49,
+	0xce, 0x88, 0x75, 0x63, 0x71, 0xdb, 0x31, 0x43, 0xdb, 0xef, 0x7a, 0x4c, 0x2a, 0x37, 0x7d, 0xd7,
+	0x47, 0xd2, 0xf4, 0x30, 0xc3, 0x36, 0x0c, 0xd4, 0x2e, 0xb1, 0x02, 0x54, 0x23, 0x5a, 0xdd, 0x08,
+	0xe6, 0xcd, 0x2a, 0x1d, 0xde, 0x4a, 0x6d, 0x6d, 0x5a, 0x1e, 0x0d, 0x56, 0x20, 0x3f, 0x75, 0x10,
+	0x4b, 0x6a, 0x8a, 0x47, 0x26, 0x95, 0xf8, 0x14, 0xe1, 0xc1, 0x3d, 0x23, 0x30, 0x9d, 0x5e, 0x92,
+	0x95, 0xda, 0xf8, 0x70, 0x57, 0xcb, 0x86, 0x92, 0xe3, 0x58, 0x5c, 0x5b, 0xb6, 0xf9, 0xec, 0xb6,
+	0x71, 0x62, 0x0c, 0xf1, 0x4b, 0xb4, 0xe8, 0xd1, 0xd9, 0x26, 0x2f, 0x09, 0x79, 0x3b, 0xbe, 0x85,
+	0x8c, 0xef, 0xa5, 0xb5, 0xc4, 0x5f, 0xa2, 0xa5, 0x01, 0x21, 0x8b, 0x82, 0x6c, 0xf2, 0x5a, 0x05,
+	0xca, 0xda, 0x48, 0x25, 0x4d, 0x22, 0x3d, 0xdf, 0xeb, 0x89, 0xe0, 0x40, 0x0f, 0xb9, 0x96, 0x79,
+	0x31, 0x63, 0x66, 0xd1, 0xa1, 0x1b, 0xd6, 0xce, 0x50, 0x65, 0x64, 0xec, 0x48, 0x79, 0x97, 0xac,
+	0xd5, 0x0a, 0xc3, 0x45, 0xf0, 0x20, 0x3f, 0x4d, 0xa4, 0xbc, 0xdb, 0xc0, 0xe1, 0x88, 0x0c, 0x3f,
+	0x47, 0x73, 0x1d, 0x96, 0x5e, 0xc5, 0x2c, 0xa0, 0x3c, 0x8d, 0x52, 0xd1, 0x95, 0x64, 0x7d, 0xf4,
+	0xd5, 0x4f, 0x01, 0xb2, 0x67, 0x10, 0xae, 0x41, 0x77, 0x7c, 0xa1, 0x3e, 0xe4, 0x59, 0x4b, 0x11,
+	0xc0, 0xb0, 0x2e, 0xc9, 0xc6, 0x68, 0x69, 0xb6, 0x68, 0x78, 0xd1, 0x06, 0x0b, 0x45, 0x56, 0x3a,
+	0xcb, 0xd4, 0xd3, 0x40, 0xc4, 0x58, 0xbf, 0x05, 0x29, 0x0b, 0x19, 0xef, 0x2a, 0x49, 0x1e, 0x8d,
+	0x46, 0x8c, 0x75, 0x4c, 0xc3, 0x40, 0x5c, 0xc4, 0x44, 0x39, 0x29, 0x90, 0x99, 0xee, 0x6f, 0x6e,
+	0x7d, 0x10, 0x7e, 0xd5, 0x51, 0x32, 0xe8, 0xfa, 0x47, 0x0e, 0xe2, 0xc8, 0x9a, 0x39, 0xa9, 0xc4,
+	0x3f, 0x43, 0xf3, 0xf9, 0xfb, 0x25, 0x93, 0x64, 0x73, 0xd4, 0x63, 0xf6, 0x4e, 0x19, 0xf9, 0xa3,
+	0xc4, 0x5c, 0xee, 0xd6, 0xc9, 0x74, 0xec, 0xcd, 0xc1, 0x45, 0xd7, 0x0b, 0x92, 0xda, 0x3b, 0x05,
+	0xc9, 0xac, 0x31, 0x77, 0xf1, 0x51, 0xff, 0xeb, 0x04, 0x2a, 0xe7, 0x86, 0x39, 0xbc, 0x8d, 0x16,
+	0x63, 0xaa, 0x98, 0x54, 0xb6, 0x8e, 0x9a, 0xc6, 0x61, 0x2f, 0x47, 0x0b, 0x46, 0x65, 0x6a, 0x26,
+	0x18, 0x18, 0xbc, 0x5f, 0x7e, 0x0d, 0x7e, 0xcc, 0xe1, 0x07, 0x95, 0xd6, 0xe0, 0x3f, 0x45, 0xab,
+	0x80, 0x87, 0xda, 0x94, 0x55, 0x6b, 0x6b, 0x35, 0x6e, 0xbe, 0xab, 0x69, 0xc0, 0xb9, 0xd1, 0xfb,
+	0x4b, 0x7d, 0x82, 0x48, 0xce, 0xd4, 0x9c, 0x11, 0xb4, 0x19, 0x98, 0xd1, 0x27, 0x1a, 0x4b, 0x9e,
+	0xa5, 0x71, 0xa4, 0x56, 0xe2, 0x9f, 0xa2, 0x47, 0x39, 0x43, 0x6f, 0x94, 0x32, 0xd6, 0xe6, 0x4b,
+	0xe3, 0xaa, 0x67, 0x3d, 0x18, 0x9e, 0x80, 0xe1, 0x03, 0x34, 0x07, 0x0c, 0xea, 0x06, 0x6e, 0xdd,
+	0xfa, 0xeb, 0xa4, 0xf9, 0xde, 0x58, 0xd2, 0xe2, 0x8b, 0x1b, 0x7d, 0xcb, 0x3e, 0x89, 0x70, 0x1d,
+	0x95, 0x01, 0x66, 0x76, 0xc6, 0x23, 0xfb, 0x81, 0xb1, 0xa8, 0x85, 0xb0, 0x9f, 0x93, 0x48, 0x7f,
+	0x6f, 0x00, 0x8c, 0xf9, 0x58, 0xa4, 0xab, 0x73, 0xae, 0x51, 0x9a, 0x4f, 0x8b, 0xf0, 0xa2, 0xaf,
+	0x1c, 0xc2, 0x6f, 0x77, 0x1f, 0x23, 0x70, 0x4f, 0x90, 0x4f, 0x42, 0xbd, 0x96, 0xf9, 0xbc, 0x08,
+	0xa7, 0x91, 0x4b, 0xbf, 0x93, 0x28, 0x33, 0x1a, 0xfa, 0xd0, 0xc1, 0x23, 0x82, 0x06, 0x46, 0x47,
+	0x7e, 0xb0, 0x9d, 0x44, 0xf5, 0x43, 0x34, 0x9b, 0x9f, 0x06, 0xf0, 0x06, 0x9a, 0x19, 0x0c, 0x0f,
+	0xf6, 0x5b, 0x71, 0x26, 0xc0, 0x15, 0xf4, 0xd0, 0x3f, 0x7b, 0xf3, 0x50, 0x7f, 0x86, 0xe6, 0x86,
+	0xda, 0xd8, 0xff, 0xa0, 0x59, 0x46, 0x93, 0xd6, 0x15, 0xf6, 0xa6, 0x67, 0x9e, 0xea, 0x7f, 0x29,
+	0xa0, 0x72, 0xae, 0xa2, 0xbe, 0xc3, 0xd5, 0xf1, 0x2e, 0x42, 0xef, 0x8e, 0x3d, 0xfe, 0x7f, 0xdd,
+	0xb1, 0x4f, 0x11, 0x1e, 0xad, 0x52, 0xfa, 0xc3, 0xba, 0x77, 0x36, 0x26, 0x7d, 0x66, 0x68, 0x76,
+	0x22, 0x04, 0x4d, 0x41, 0xd1, 0x63, 0xa9, 0xfd, 0x96, 0xee, 0x1e, 0xeb, 0x0c, 0x95, 0x73, 0xb5,
+	0x00, 0x98, 0x8c, 0xc0, 0x67, 0x32, 0x92, 0x93, 0x08, 0xff, 0x00, 0x3d, 0x34, 0xdf, 0x56, 0xef,
+	0xb8, 0xa7, 0xe5, 0x8e, 0xd4, 0x96, 0x00, 0x83, 0xde, 0xff, 0xf5, 0x37, 0xaf, 0xab, 0x85, 0x6f,
+	0x5f, 0x57, 0x0b, 0xff, 0x7a, 0x5d, 0x2d, 0xfc, 0xf9, 0x4d, 0xf5, 0xc1, 0xb7, 0x6f, 0xaa, 0x0f,
+	0xfe, 0xfe, 0xa6, 0xfa, 0xe0, 0xcb, 0x9f, 0x78, 0xef, 0x6f, 0x6b, 0xc3, 0x63, 0x33, 0x26, 0x0c,
+	0x3f, 0x76, 0x44, 0xd4, 0x8b, 0xd9, 0xce, 0xcd, 0x8e, 0xfb, 0x6f, 0x04, 0x38, 0xa7, 0x39, 0x09,
+	0xff, 0x65, 0xf8, 0xf8, 0x3f, 0x03, 0x00, 0x48, 0x81, 0xb4, 0xbe, 0x28, 0x19, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -704,20 +1421,34 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedTransfers) > 0 {
-		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ClaimDataHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ClaimDataHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.BridgeSlashingEventRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeSlashingEventRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.MinTransferAmounts) > 0 {
+		for iNdEx := len(m.MinTransferAmounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MinTransferAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -725,13 +1456,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size, err := m.ChainFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.BridgeFeeShareToCommunityPool {
+		i--
+		if m.BridgeFeeShareToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.BridgeFeeShare.Size()
+		i -= size
+		if _, err := m.BridgeFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.ExecutedBatchArchiveSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutedBatchArchiveSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.BridgePauses) > 0 {
+		for iNdEx := len(m.BridgePauses) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BridgePauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -739,13 +1515,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -753,133 +1531,153 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+	if len(m.EthereumBlacklist) > 0 {
+		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EthereumBlacklist[iNdEx])
+			copy(dAtA[i:], m.EthereumBlacklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlacklist[iNdEx])))
 			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.LogicCallConfirms) > 0 {
-		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.LogicCalls) > 0 {
-		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	if m.BridgeActive {
+		i--
+		if m.BridgeActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.BatchConfirms) > 0 {
-		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	{
+		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.SlashFractionBadEthSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x78
 	}
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x72
 	{
-		size, err := m.GravityNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	dAtA[i] = 0x6a
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TargetBatchTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
+func (m *ChainFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -889,241 +1687,2764 @@ func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GravityNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GravityNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastBatchId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.LastTxPoolId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.LastSlashedLogicCallBlock != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.LastSlashedBatchBlock != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchBlock))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.LastSlashedValsetNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LastObservedNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.LatestValsetNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestValsetNonce))
+	i--
+	dAtA[i] = 0x12
+	if m.BasisPoints != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BasisPoints))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MinTransferAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MinTransferAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinTransferAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
-	}
-	if m.SignedValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
-	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
-	}
-	if m.SignedLogicCallsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
-	}
-	if m.TargetBatchTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
-	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
-	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
-	}
-	l = m.SlashFractionValset.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionLogicCall.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
-	}
-	l = m.SlashFractionBadEthSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.ValsetReward.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if m.BridgeActive {
-		n += 3
-	}
-	if len(m.EthereumBlacklist) > 0 {
-		for _, s := range m.EthereumBlacklist {
-			l = len(s)
-			n += 2 + l + sovGenesis(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BridgePause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgePause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgePause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = m.GravityNonces.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Batches {
+		i--
+		if m.Batches {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for _, e := range m.ValsetConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Withdrawals {
+		i--
+		if m.Withdrawals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Deposits {
+		i--
+		if m.Deposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.BatchConfirms) > 0 {
-		for _, e := range m.BatchConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.LogicCalls) > 0 {
-		for _, e := range m.LogicCalls {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for _, e := range m.LogicCallConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TokenRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CircuitBreakerThreshold.Size()
+		i -= size
+		if _, err := m.CircuitBreakerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OutflowLimit.Size()
+		i -= size
+		if _, err := m.OutflowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflowLimit.Size()
+		i -= size
+		if _, err := m.InflowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.UnbatchedTransfers) > 0 {
-		for _, e := range m.UnbatchedTransfers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GravityNonces) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LatestValsetNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LatestValsetNonce))
-	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
-	}
-	if m.LastSlashedValsetNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedValsetNonce))
-	}
-	if m.LastSlashedBatchBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedBatchBlock))
-	}
-	if m.LastSlashedLogicCallBlock != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
-	}
-	if m.LastTxPoolId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
-	}
-	if m.LastBatchId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastBatchId))
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedDeposits) > 0 {
+		for iNdEx := len(m.PausedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ExecutedBatches) > 0 {
+		for iNdEx := len(m.ExecutedBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.BatchExecutions) > 0 {
+		for iNdEx := len(m.BatchExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.DepositReceipts) > 0 {
+		for iNdEx := len(m.DepositReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.AirdropClaims) > 0 {
+		for iNdEx := len(m.AirdropClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AirdropClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.MerkleAirdrops) > 0 {
+		for iNdEx := len(m.MerkleAirdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleAirdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.CircuitBreakerTrip != nil {
+		{
+			size, err := m.CircuitBreakerTrip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.RateLimitedDeposits) > 0 {
+		for iNdEx := len(m.RateLimitedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.RateLimitOutflows) > 0 {
+		for iNdEx := len(m.RateLimitOutflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.RateLimitInflows) > 0 {
+		for iNdEx := len(m.RateLimitInflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitInflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Erc20Migrations) > 0 {
+		for iNdEx := len(m.Erc20Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Erc20DeploymentRequests) > 0 {
+		for iNdEx := len(m.Erc20DeploymentRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20DeploymentRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.BridgeSlashingEvents) > 0 {
+		for iNdEx := len(m.BridgeSlashingEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeSlashingEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.DelegateKeyRegistrationHeights) > 0 {
+		for iNdEx := len(m.DelegateKeyRegistrationHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeyRegistrationHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ValidatorBondedHeights) > 0 {
+		for iNdEx := len(m.ValidatorBondedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBondedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.LastObservedValset != nil {
+		{
+			size, err := m.LastObservedValset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	{
+		size, err := m.LastObservedEthereumBlockHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for iNdEx := len(m.LastEventNoncesByValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNoncesByValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PastEthSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.PastEthSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PastEthSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.GravityNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GravityNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GravityNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastExecutedBatchId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastExecutedBatchId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastMerkleAirdropId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastMerkleAirdropId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingBlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LastBatchId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastObservedNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LatestValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestValsetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ArchiveId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ArchiveId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	}
+	if m.TargetBatchTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionValset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionLogicCall.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.UnbondSlashingValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	}
+	l = m.SlashFractionBadEthSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.ValsetReward.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.BridgeActive {
+		n += 3
+	}
+	if len(m.EthereumBlacklist) > 0 {
+		for _, s := range m.EthereumBlacklist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgePauses) > 0 {
+		for _, e := range m.BridgePauses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ExecutedBatchArchiveSize != 0 {
+		n += 2 + sovGenesis(uint64(m.ExecutedBatchArchiveSize))
+	}
+	l = m.BridgeFeeShare.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.BridgeFeeShareToCommunityPool {
+		n += 3
+	}
+	l = m.ChainFee.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.MinTransferAmounts) > 0 {
+		for _, e := range m.MinTransferAmounts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeSlashingEventRetention != 0 {
+		n += 2 + sovGenesis(uint64(m.BridgeSlashingEventRetention))
+	}
+	if m.ClaimDataHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.ClaimDataHeight))
+	}
+	return n
+}
+
+func (m *ChainFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		n += 1 + sovGenesis(uint64(m.BasisPoints))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *MinTransferAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BridgePause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Deposits {
+		n += 2
+	}
+	if m.Withdrawals {
+		n += 2
+	}
+	if m.Batches {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovGenesis(uint64(m.Window))
+	}
+	l = m.InflowLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OutflowLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CircuitBreakerThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.GravityNonces.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for _, e := range m.LogicCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for _, e := range m.UnbatchedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for _, e := range m.PendingIbcAutoForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for _, b := range m.PastEthSignatureCheckpoints {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for _, e := range m.LastEventNoncesByValidator {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastObservedEthereumBlockHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.LastObservedValset != nil {
+		l = m.LastObservedValset.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorBondedHeights) > 0 {
+		for _, e := range m.ValidatorBondedHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeyRegistrationHeights) > 0 {
+		for _, e := range m.DelegateKeyRegistrationHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeSlashingEvents) > 0 {
+		for _, e := range m.BridgeSlashingEvents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20DeploymentRequests) > 0 {
+		for _, e := range m.Erc20DeploymentRequests {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Migrations) > 0 {
+		for _, e := range m.Erc20Migrations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitInflows) > 0 {
+		for _, e := range m.RateLimitInflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitOutflows) > 0 {
+		for _, e := range m.RateLimitOutflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitedDeposits) > 0 {
+		for _, e := range m.RateLimitedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CircuitBreakerTrip != nil {
+		l = m.CircuitBreakerTrip.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MerkleAirdrops) > 0 {
+		for _, e := range m.MerkleAirdrops {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AirdropClaims) > 0 {
+		for _, e := range m.AirdropClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositReceipts) > 0 {
+		for _, e := range m.DepositReceipts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchExecutions) > 0 {
+		for _, e := range m.BatchExecutions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedBatches) > 0 {
+		for _, e := range m.ExecutedBatches {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedDeposits) > 0 {
+		for _, e := range m.PausedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GravityNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestValsetNonce))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedBatchBlock))
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	}
+	if m.LastTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	}
+	if m.LastBatchId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBatchId))
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnbondingBlockHeight))
+	}
+	if m.LastMerkleAirdropId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastMerkleAirdropId))
+	}
+	if m.LastExecutedBatchId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastExecutedBatchId))
+	}
+	return n
+}
+
+func (m *ValidatorNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ValidatorHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RateLimitFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AirdropClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovGenesis(uint64(m.AirdropId))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchivedBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ArchiveId != 0 {
+		n += 1 + sovGenesis(uint64(m.ArchiveId))
+	}
+	l = m.Batch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, TokenRateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgePauses = append(m.BridgePauses, BridgePause{})
+			if err := m.BridgePauses[len(m.BridgePauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatchArchiveSize", wireType)
+			}
+			m.ExecutedBatchArchiveSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedBatchArchiveSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeShareToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeFeeShareToCommunityPool = bool(v != 0)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTransferAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTransferAmounts = append(m.MinTransferAmounts, MinTransferAmount{})
+			if err := m.MinTransferAmounts[len(m.MinTransferAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSlashingEventRetention", wireType)
+			}
+			m.BridgeSlashingEventRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeSlashingEventRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDataHeight", wireType)
+			}
+			m.ClaimDataHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimDataHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinTransferAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinTransferAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinTransferAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgePause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgePause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgePause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deposits = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdrawals = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,30 +4454,405 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1166,29 +4862,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1198,29 +4896,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
+			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthSignatureCheckpoints", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1230,29 +4930,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.PastEthSignatureCheckpoints = append(m.PastEthSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.PastEthSignatureCheckpoints[len(m.PastEthSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNoncesByValidator", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1262,16 +4962,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedValsetsWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNoncesByValidator = append(m.LastEventNoncesByValidator, ValidatorNonce{})
+			if err := m.LastEventNoncesByValidator[len(m.LastEventNoncesByValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumBlockHeight", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1281,16 +4996,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedBatchesWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedValset", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1300,16 +5029,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedLogicCallsWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedValset == nil {
+				m.LastObservedValset = &Valset{}
+			}
+			if err := m.LastObservedValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondedHeights", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1319,16 +5065,133 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBondedHeights = append(m.ValidatorBondedHeights, ValidatorHeight{})
+			if err := m.ValidatorBondedHeights[len(m.ValidatorBondedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeyRegistrationHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeyRegistrationHeights = append(m.DelegateKeyRegistrationHeights, ValidatorHeight{})
+			if err := m.DelegateKeyRegistrationHeights[len(m.DelegateKeyRegistrationHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSlashingEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeSlashingEvents = append(m.BridgeSlashingEvents, BridgeSlashingEvent{})
+			if err := m.BridgeSlashingEvents[len(m.BridgeSlashingEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20DeploymentRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20DeploymentRequests = append(m.Erc20DeploymentRequests, ERC20DeploymentRequest{})
+			if err := m.Erc20DeploymentRequests[len(m.Erc20DeploymentRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TargetBatchTimeout = 0
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Migrations", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1338,16 +5201,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Migrations = append(m.Erc20Migrations, ERC20Migration{})
+			if err := m.Erc20Migrations[len(m.Erc20Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitInflows", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1357,16 +5235,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitInflows = append(m.RateLimitInflows, RateLimitFlow{})
+			if err := m.RateLimitInflows[len(m.RateLimitInflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitOutflows", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1376,16 +5269,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitOutflows = append(m.RateLimitOutflows, RateLimitFlow{})
+			if err := m.RateLimitOutflows[len(m.RateLimitOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitedDeposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1395,30 +5303,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimitedDeposits = append(m.RateLimitedDeposits, MsgSendToCosmosClaim{})
+			if err := m.RateLimitedDeposits[len(m.RateLimitedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerTrip", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1428,30 +5337,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CircuitBreakerTrip == nil {
+				m.CircuitBreakerTrip = &CircuitBreakerTrip{}
+			}
+			if err := m.CircuitBreakerTrip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdrops", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1461,30 +5373,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerkleAirdrops = append(m.MerkleAirdrops, MerkleAirdrop{})
+			if err := m.MerkleAirdrops[len(m.MerkleAirdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropClaims", wireType)
 			}
-			m.UnbondSlashingValsetsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1494,16 +5407,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropClaims = append(m.AirdropClaims, AirdropClaimRecord{})
+			if err := m.AirdropClaims[len(m.AirdropClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReceipts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1513,28 +5441,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DepositReceipts = append(m.DepositReceipts, DepositReceipt{})
+			if err := m.DepositReceipts[len(m.DepositReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchExecutions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1561,15 +5490,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BatchExecutions = append(m.BatchExecutions, BatchExecution{})
+			if err := m.BatchExecutions[len(m.BatchExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBatches", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1579,17 +5509,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeActive = bool(v != 0)
-		case 19:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBatches = append(m.ExecutedBatches, ArchivedBatch{})
+			if err := m.ExecutedBatches[len(m.ExecutedBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDeposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1599,23 +5543,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			m.PausedDeposits = append(m.PausedDeposits, MsgSendToCosmosClaim{})
+			if err := m.PausedDeposits[len(m.PausedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1638,7 +5584,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,17 +5607,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GravityNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GravityNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestValsetNonce", wireType)
+			}
+			m.LatestValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+			}
+			m.LastObservedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedValsetNonce", wireType)
+			}
+			m.LastSlashedValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedBatchBlock", wireType)
+			}
+			m.LastSlashedBatchBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedBatchBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedLogicCallBlock", wireType)
 			}
-			var msglen int
+			m.LastSlashedLogicCallBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1681,33 +5703,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastSlashedLogicCallBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTxPoolId", wireType)
 			}
-			var msglen int
+			m.LastTxPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1717,30 +5722,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastTxPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchId", wireType)
 			}
-			var msglen int
+			m.LastBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1750,31 +5741,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastBatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Valsets = append(m.Valsets, Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingBlockHeight", wireType)
 			}
-			var msglen int
+			m.LastUnbondingBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1784,31 +5760,35 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastUnbondingBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMerkleAirdropId", wireType)
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastMerkleAirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMerkleAirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutedBatchId", wireType)
 			}
-			var msglen int
+			m.LastExecutedBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1818,31 +5798,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastExecutedBatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1852,31 +5867,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1886,31 +5899,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1920,31 +5968,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1954,31 +6000,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1988,31 +6069,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2022,31 +6101,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2056,23 +6120,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2097,7 +6161,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GravityNonces) Unmarshal(dAtA []byte) error {
+func (m *AirdropClaimRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2120,17 +6184,17 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GravityNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: AirdropClaimRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GravityNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AirdropClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestValsetNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
-			m.LatestValsetNonce = 0
+			m.AirdropId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2140,16 +6204,16 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestValsetNonce |= uint64(b&0x7F) << shift
+				m.AirdropId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
-			m.LastObservedNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2159,73 +6223,79 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedValsetNonce", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.LastSlashedValsetNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastSlashedValsetNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedBatchBlock", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastSlashedBatchBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastSlashedBatchBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedLogicCallBlock", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.LastSlashedLogicCallBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastSlashedLogicCallBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTxPoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveId", wireType)
 			}
-			m.LastTxPoolId = 0
+			m.ArchiveId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2235,16 +6305,16 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastTxPoolId |= uint64(b&0x7F) << shift
+				m.ArchiveId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			m.LastBatchId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2254,11 +6324,25 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastBatchId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
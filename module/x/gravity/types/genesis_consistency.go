@@ -0,0 +1,284 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisViolations lists every inconsistency found in a GenesisState by ValidateConsistency
+type GenesisViolations []error
+
+func (v GenesisViolations) Error() string {
+	msgs := make([]string, len(v))
+	for i, err := range v {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d gravity genesis violations:\n%s", len(v), strings.Join(msgs, "\n"))
+}
+
+// add records a new violation
+func (v *GenesisViolations) add(format string, args ...interface{}) {
+	*v = append(*v, fmt.Errorf(format, args...))
+}
+
+// ValidateConsistency checks the objects in the genesis state against each other and against moduleBalances,
+// the balances held by the gravity module account. ValidateBasic checks fields one at a time and stops at the
+// first error, this returns every violation found so that a genesis file can be fixed in a single pass
+func (s GenesisState) ValidateConsistency(moduleBalances sdk.Coins) GenesisViolations {
+	var v GenesisViolations
+	s.checkValsets(&v)
+	s.checkBatchesAndTransfers(&v)
+	s.checkLogicCalls(&v)
+	s.checkDelegateKeys(&v)
+	cosmosOriginated := s.checkErc20Mappings(&v)
+	s.checkAirdropsAndArchive(&v)
+	s.checkEscrow(&v, moduleBalances, cosmosOriginated)
+	return v
+}
+
+func (s GenesisState) checkValsets(v *GenesisViolations) {
+	valsets := make(map[uint64]bool, len(s.Valsets))
+	for _, valset := range s.Valsets {
+		if valsets[valset.Nonce] {
+			v.add("valset %d is duplicated", valset.Nonce)
+		}
+		valsets[valset.Nonce] = true
+		if valset.Nonce > s.GravityNonces.LatestValsetNonce {
+			v.add("valset %d is after the latest valset nonce %d", valset.Nonce, s.GravityNonces.LatestValsetNonce)
+		}
+	}
+	for _, confirm := range s.ValsetConfirms {
+		if !valsets[confirm.Nonce] {
+			v.add("valset confirm by %s is for missing valset %d", confirm.Orchestrator, confirm.Nonce)
+		}
+	}
+}
+
+func (s GenesisState) checkBatchesAndTransfers(v *GenesisViolations) {
+	txIds := make(map[uint64]bool)
+	checkTx := func(tx OutgoingTransferTx, location string) {
+		if txIds[tx.Id] {
+			v.add("transfer %d in %s is duplicated", tx.Id, location)
+		}
+		txIds[tx.Id] = true
+		if tx.Id > s.GravityNonces.LastTxPoolId {
+			v.add("transfer %d in %s is after the last tx pool id %d", tx.Id, location, s.GravityNonces.LastTxPoolId)
+		}
+	}
+
+	batches := make(map[string]bool, len(s.Batches))
+	for _, batch := range s.Batches {
+		key := fmt.Sprintf("%s/%d", normalizeEthAddress(batch.TokenContract), batch.BatchNonce)
+		if batches[key] {
+			v.add("batch %d of %s is duplicated", batch.BatchNonce, batch.TokenContract)
+		}
+		batches[key] = true
+		if batch.BatchNonce > s.GravityNonces.LastBatchId {
+			v.add("batch %d of %s is after the last batch id %d", batch.BatchNonce, batch.TokenContract, s.GravityNonces.LastBatchId)
+		}
+		for _, tx := range batch.Transactions {
+			checkTx(tx, fmt.Sprintf("batch %d", batch.BatchNonce))
+		}
+	}
+	for _, confirm := range s.BatchConfirms {
+		key := fmt.Sprintf("%s/%d", normalizeEthAddress(confirm.TokenContract), confirm.Nonce)
+		if !batches[key] {
+			v.add("batch confirm by %s is for missing batch %d of %s", confirm.Orchestrator, confirm.Nonce, confirm.TokenContract)
+		}
+	}
+
+	for _, tx := range s.UnbatchedTransfers {
+		checkTx(tx, "the pool")
+	}
+}
+
+func (s GenesisState) checkLogicCalls(v *GenesisViolations) {
+	calls := make(map[string]bool, len(s.LogicCalls))
+	for _, call := range s.LogicCalls {
+		key := fmt.Sprintf("%x/%d", call.InvalidationId, call.InvalidationNonce)
+		if calls[key] {
+			v.add("logic call %x with nonce %d is duplicated", call.InvalidationId, call.InvalidationNonce)
+		}
+		calls[key] = true
+	}
+	for _, confirm := range s.LogicCallConfirms {
+		invalidationID, err := hex.DecodeString(confirm.InvalidationId)
+		if err != nil {
+			v.add("logic call confirm by %s has invalid invalidation id %s", confirm.Orchestrator, confirm.InvalidationId)
+			continue
+		}
+		if !calls[fmt.Sprintf("%x/%d", invalidationID, confirm.InvalidationNonce)] {
+			v.add("logic call confirm by %s is for missing logic call %s with nonce %d",
+				confirm.Orchestrator, confirm.InvalidationId, confirm.InvalidationNonce)
+		}
+	}
+}
+
+func (s GenesisState) checkDelegateKeys(v *GenesisViolations) {
+	validators := make(map[string]bool, len(s.DelegateKeys))
+	orchestrators := make(map[string]bool, len(s.DelegateKeys))
+	ethAddresses := make(map[string]bool, len(s.DelegateKeys))
+	for _, keys := range s.DelegateKeys {
+		if err := keys.ValidateBasic(); err != nil {
+			v.add("delegate keys of %s are invalid: %s", keys.Validator, err)
+			continue
+		}
+		if validators[keys.Validator] {
+			v.add("validator %s has more than one set of delegate keys", keys.Validator)
+		}
+		validators[keys.Validator] = true
+		if orchestrators[keys.Orchestrator] {
+			v.add("orchestrator %s is used by more than one validator", keys.Orchestrator)
+		}
+		orchestrators[keys.Orchestrator] = true
+		ethAddress := normalizeEthAddress(keys.EthAddress)
+		if ethAddresses[ethAddress] {
+			v.add("ethereum address %s is used by more than one validator", keys.EthAddress)
+		}
+		ethAddresses[ethAddress] = true
+	}
+}
+
+// checkErc20Mappings checks that every ERC20 maps to one denom and every denom to one active ERC20, ERC20s
+// deprecated by a migration may share their denom with its replacement. Returns the active cosmos
+// originated ERC20s, whose vouchers are not escrowed by the module
+func (s GenesisState) checkErc20Mappings(v *GenesisViolations) map[string]bool {
+	deprecated := make(map[string]string, len(s.Erc20Migrations))
+	for _, migration := range s.Erc20Migrations {
+		oldErc20 := normalizeEthAddress(migration.OldErc20)
+		if _, ok := deprecated[oldErc20]; ok {
+			v.add("erc20 %s is migrated more than once", migration.OldErc20)
+		}
+		deprecated[oldErc20] = migration.Denom
+	}
+
+	erc20s := make(map[string]string, len(s.Erc20ToDenoms))
+	activeDenoms := make(map[string]bool, len(s.Erc20ToDenoms))
+	cosmosOriginated := make(map[string]bool, len(s.Erc20ToDenoms))
+	for _, mapping := range s.Erc20ToDenoms {
+		if _, err := NewEthAddress(mapping.Erc20); err != nil {
+			v.add("erc20 %s mapped to %s is invalid: %s", mapping.Erc20, mapping.Denom, err)
+			continue
+		}
+		erc20 := normalizeEthAddress(mapping.Erc20)
+		if denom, ok := erc20s[erc20]; ok {
+			v.add("erc20 %s is mapped to both %s and %s", mapping.Erc20, denom, mapping.Denom)
+		}
+		erc20s[erc20] = mapping.Denom
+		if _, ok := deprecated[erc20]; ok {
+			continue
+		}
+		if activeDenoms[mapping.Denom] {
+			v.add("denom %s is mapped to more than one active erc20", mapping.Denom)
+		}
+		activeDenoms[mapping.Denom] = true
+		cosmosOriginated[erc20] = true
+	}
+	for _, migration := range s.Erc20Migrations {
+		if erc20s[normalizeEthAddress(migration.OldErc20)] != migration.Denom {
+			v.add("migrated erc20 %s is not mapped to its denom %s", migration.OldErc20, migration.Denom)
+		}
+	}
+
+	if s.Params != nil {
+		reward := s.Params.ValsetReward
+		if reward.IsValid() && !reward.IsZero() && !activeDenoms[reward.Denom] {
+			v.add("valset reward denom %s is not a cosmos originated denom", reward.Denom)
+		}
+	}
+	return cosmosOriginated
+}
+
+func (s GenesisState) checkAirdropsAndArchive(v *GenesisViolations) {
+	airdrops := make(map[uint64]bool, len(s.MerkleAirdrops))
+	for _, airdrop := range s.MerkleAirdrops {
+		if airdrops[airdrop.Id] {
+			v.add("merkle airdrop %d is duplicated", airdrop.Id)
+		}
+		airdrops[airdrop.Id] = true
+		if airdrop.Id > s.GravityNonces.LastMerkleAirdropId {
+			v.add("merkle airdrop %d is after the last merkle airdrop id %d", airdrop.Id, s.GravityNonces.LastMerkleAirdropId)
+		}
+	}
+	for _, claim := range s.AirdropClaims {
+		if !airdrops[claim.AirdropId] {
+			v.add("airdrop claim by %s is for missing merkle airdrop %d", claim.Claimer, claim.AirdropId)
+		}
+	}
+
+	for _, archived := range s.ExecutedBatches {
+		if archived.ArchiveId > s.GravityNonces.LastExecutedBatchId {
+			v.add("archived batch %d is after the last executed batch id %d", archived.ArchiveId, s.GravityNonces.LastExecutedBatchId)
+		}
+	}
+}
+
+// checkEscrow checks that the module holds exactly the Ethereum originated vouchers escrowed by the pool, the
+// unobserved batches, the pending IBC auto forwards and the unclaimed Merkle airdrops, mirroring the
+// ModuleBalanceInvariant. Cosmos originated balances can not be checked as there is no record of the amount bridged
+func (s GenesisState) checkEscrow(v *GenesisViolations, moduleBalances sdk.Coins, cosmosOriginated map[string]bool) {
+	expected := sdk.NewCoins()
+	escrow := func(token ERC20Token) {
+		if cosmosOriginated[normalizeEthAddress(token.Contract)] {
+			return
+		}
+		internal, err := token.ToInternal()
+		if err != nil {
+			v.add("escrowed token %s is invalid: %s", token.Contract, err)
+			return
+		}
+		expected = expected.Add(internal.GravityCoin())
+	}
+	for _, tx := range s.UnbatchedTransfers {
+		escrow(tx.Erc20Token)
+		escrow(tx.Erc20Fee)
+	}
+	for _, batch := range s.Batches {
+		for _, tx := range batch.Transactions {
+			escrow(tx.Erc20Token)
+			escrow(tx.Erc20Fee)
+		}
+	}
+	for _, forward := range s.PendingIbcAutoForwards {
+		if forward.Token != nil {
+			expected = expected.Add(*forward.Token)
+		}
+	}
+	for _, airdrop := range s.MerkleAirdrops {
+		if !airdrop.Total.IsAllGTE(airdrop.Claimed) {
+			v.add("merkle airdrop %d has claimed %s which is more than its total %s", airdrop.Id, airdrop.Claimed, airdrop.Total)
+			continue
+		}
+		expected = expected.Add(airdrop.Remaining()...)
+	}
+
+	checked := make(map[string]bool)
+	for _, coins := range []sdk.Coins{expected, moduleBalances} {
+		for _, coin := range coins {
+			if checked[coin.Denom] {
+				continue
+			}
+			checked[coin.Denom] = true
+			erc20, err := GravityDenomToERC20(coin.Denom)
+			if err != nil || cosmosOriginated[erc20.GetAddress().Hex()] {
+				continue
+			}
+			if actual, want := moduleBalances.AmountOf(coin.Denom), expected.AmountOf(coin.Denom); !actual.Equal(want) {
+				v.add("module account holds %s%s but %s%s is escrowed", actual, coin.Denom, want, coin.Denom)
+			}
+		}
+	}
+}
+
+// normalizeEthAddress returns the checksummed form of an Ethereum address so that differently cased copies
+// compare equal, invalid addresses are returned unchanged
+func normalizeEthAddress(address string) string {
+	ethAddress, err := NewEthAddress(address)
+	if err != nil {
+		return address
+	}
+	return ethAddress.GetAddress().Hex()
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -82,6 +83,93 @@ func TestGenesisStateValidate(t *testing.T) {
 			UnbatchedTransfers: []OutgoingTransferTx{},
 		}, expErr: true},
 	}
+	rateLimited := DefaultGenesisState()
+	rateLimited.Params.RateLimits = []TokenRateLimit{{
+		TokenContract:           "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Window:                  100,
+		InflowLimit:             types.NewInt(1000),
+		OutflowLimit:            types.ZeroInt(),
+		CircuitBreakerThreshold: types.ZeroInt(),
+	}}
+	specs["rate limits"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: rateLimited, expErr: false}
+
+	duplicateRateLimit := DefaultGenesisState()
+	duplicateRateLimit.Params.RateLimits = append(rateLimited.Params.RateLimits, rateLimited.Params.RateLimits[0])
+	duplicateRateLimit.Params.RateLimits[1].TokenContract = "0x429881672b9ae42b8eba0e26cd9c73711b891ca5"
+	specs["duplicate rate limits"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: duplicateRateLimit, expErr: true}
+
+	zeroWindow := DefaultGenesisState()
+	zeroWindow.Params.RateLimits = []TokenRateLimit{rateLimited.Params.RateLimits[0]}
+	zeroWindow.Params.RateLimits[0].Window = 0
+	specs["rate limit without window"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: zeroWindow, expErr: true}
+
+	paused := DefaultGenesisState()
+	paused.Params.BridgePauses = []BridgePause{
+		{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Deposits: true},
+		{Withdrawals: true, Batches: true},
+	}
+	specs["bridge pauses"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: paused, expErr: false}
+	duplicatePause := DefaultGenesisState()
+	duplicatePause.Params.BridgePauses = []BridgePause{
+		{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Deposits: true},
+		{TokenContract: "0x429881672b9ae42b8eba0e26cd9c73711b891ca5", Batches: true},
+	}
+	specs["duplicate bridge pauses"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: duplicatePause, expErr: true}
+	emptyPause := DefaultGenesisState()
+	emptyPause.Params.BridgePauses = []BridgePause{{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"}}
+	specs["bridge pause without direction"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: emptyPause, expErr: true}
+	feeShare := DefaultGenesisState()
+	feeShare.Params.BridgeFeeShare = types.NewDecWithPrec(1, 1)
+	specs["bridge fee share"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: feeShare, expErr: false}
+	overFeeShare := DefaultGenesisState()
+	overFeeShare.Params.BridgeFeeShare = types.NewDecWithPrec(11, 1)
+	specs["bridge fee share over one"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: overFeeShare, expErr: true}
+	chainFee := DefaultGenesisState()
+	chainFee.Params.ChainFee = ChainFee{BasisPoints: 10, FlatFee: types.NewInt64Coin("stake", 1)}
+	specs["chain fee"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: chainFee, expErr: false}
+	overChainFee := DefaultGenesisState()
+	overChainFee.Params.ChainFee.BasisPoints = MaxBasisPoints + 1
+	specs["chain fee over 100%"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: overChainFee, expErr: true}
+	dupMinimum := DefaultGenesisState()
+	dupMinimum.Params.MinTransferAmounts = []MinTransferAmount{
+		{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Amount: types.NewInt(10)},
+		{TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Amount: types.NewInt(20)},
+	}
+	specs["duplicate min transfer amount"] = struct {
+		src    *GenesisState
+		expErr bool
+	}{src: dupMinimum, expErr: true}
+
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			err := spec.src.ValidateBasic()
@@ -94,6 +182,83 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisStateValidateConsistency(t *testing.T) {
+	const (
+		ethOriginated    = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		cosmosOriginated = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		deprecated       = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD8"
+	)
+	transfer := func(id uint64, amount int64, fee int64) OutgoingTransferTx {
+		return OutgoingTransferTx{
+			Id:          id,
+			Erc20Token:  ERC20Token{Contract: ethOriginated, Amount: types.NewInt(amount)},
+			Erc20Fee:    ERC20Token{Contract: ethOriginated, Amount: types.NewInt(fee)},
+			DestAddress: cosmosOriginated,
+		}
+	}
+	voucher := "gravity" + ethOriginated
+
+	specs := map[string]struct {
+		mutate        func(*GenesisState, *types.Coins)
+		expViolations int
+	}{
+		"consistent": {mutate: func(*GenesisState, *types.Coins) {}, expViolations: 0},
+		"confirm for missing batch": {mutate: func(s *GenesisState, _ *types.Coins) {
+			s.BatchConfirms[0].Nonce = 2
+		}, expViolations: 1},
+		"confirm for missing valset": {mutate: func(s *GenesisState, _ *types.Coins) {
+			s.ValsetConfirms = []MsgValsetConfirm{{Nonce: 1, Orchestrator: "orchestrator"}}
+		}, expViolations: 1},
+		"transfer after last tx pool id": {mutate: func(s *GenesisState, _ *types.Coins) {
+			s.GravityNonces.LastTxPoolId = 1
+		}, expViolations: 1},
+		"duplicate transfer": {mutate: func(s *GenesisState, c *types.Coins) {
+			s.UnbatchedTransfers = append(s.UnbatchedTransfers, transfer(2, 1, 0))
+			*c = c.Add(types.NewInt64Coin(voucher, 1))
+		}, expViolations: 1},
+		"erc20 mapped twice": {mutate: func(s *GenesisState, _ *types.Coins) {
+			s.Erc20ToDenoms = append(s.Erc20ToDenoms, ERC20ToDenom{Erc20: strings.ToLower(cosmosOriginated), Denom: "ubar"})
+		}, expViolations: 1},
+		"denom mapped twice": {mutate: func(s *GenesisState, _ *types.Coins) {
+			s.Erc20ToDenoms = append(s.Erc20ToDenoms, ERC20ToDenom{Erc20: deprecated, Denom: "ufoo"})
+		}, expViolations: 1},
+		"deprecated erc20 shares its denom": {mutate: func(s *GenesisState, _ *types.Coins) {
+			s.Erc20ToDenoms = append(s.Erc20ToDenoms, ERC20ToDenom{Erc20: deprecated, Denom: "ufoo"})
+			s.Erc20Migrations = []ERC20Migration{{Denom: "ufoo", OldErc20: deprecated, NewErc20: cosmosOriginated}}
+		}, expViolations: 0},
+		"escrow short of the pool and batches": {mutate: func(_ *GenesisState, c *types.Coins) {
+			*c = c.Sub(types.NewCoins(types.NewInt64Coin(voucher, 1)))
+		}, expViolations: 1},
+		"unescrowed vouchers": {mutate: func(_ *GenesisState, c *types.Coins) {
+			*c = c.Add(types.NewInt64Coin("gravity"+deprecated, 1))
+		}, expViolations: 1},
+		"cosmos originated balances are not checked": {mutate: func(_ *GenesisState, c *types.Coins) {
+			*c = c.Add(types.NewCoins(types.NewInt64Coin("ufoo", 1), types.NewInt64Coin("gravity"+cosmosOriginated, 1))...)
+		}, expViolations: 0},
+		"every violation is reported": {mutate: func(s *GenesisState, c *types.Coins) {
+			s.BatchConfirms[0].Nonce = 2
+			s.GravityNonces.LastTxPoolId = 1
+			*c = types.NewCoins()
+		}, expViolations: 3},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		t.Run(msg, func(t *testing.T) {
+			state := DefaultGenesisState()
+			state.GravityNonces = GravityNonces{LastTxPoolId: 2, LastBatchId: 1}
+			state.UnbatchedTransfers = []OutgoingTransferTx{transfer(1, 100, 1)}
+			state.Batches = []OutgoingTxBatch{{BatchNonce: 1, TokenContract: ethOriginated, Transactions: []OutgoingTransferTx{transfer(2, 50, 2)}}}
+			state.BatchConfirms = []MsgConfirmBatch{{Nonce: 1, TokenContract: ethOriginated, Orchestrator: "orchestrator"}}
+			state.Erc20ToDenoms = []ERC20ToDenom{{Erc20: cosmosOriginated, Denom: "ufoo"}}
+			balances := types.NewCoins(types.NewInt64Coin(voucher, 153))
+
+			spec.mutate(state, &balances)
+			violations := state.ValidateConsistency(balances)
+			require.Len(t, violations, spec.expViolations, violations)
+		})
+	}
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
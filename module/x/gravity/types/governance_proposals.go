@@ -1,17 +1,24 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	fmt "fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
-	ProposalTypeUnhaltBridge = "UnhaltBridge"
-	ProposalTypeAirdrop      = "Airdrop"
-	ProposalTypeIBCMetadata  = "IBCMetadata"
+	ProposalTypeUnhaltBridge           = "UnhaltBridge"
+	ProposalTypeAirdrop                = "Airdrop"
+	ProposalTypeIBCMetadata            = "IBCMetadata"
+	ProposalTypeERC20Metadata          = "ERC20Metadata"
+	ProposalTypeRequestERC20Deployment = "RequestERC20Deployment"
+	ProposalTypeDeprecateERC20         = "DeprecateERC20"
+	ProposalTypeAirdropV2              = "AirdropV2"
 )
 
 func (p *UnhaltBridgeProposal) GetTitle() string { return p.Title }
@@ -87,6 +94,88 @@ func (p AirdropProposal) String() string {
 	return b.String()
 }
 
+func (p *AirdropV2Proposal) GetTitle() string { return p.Title }
+
+func (p *AirdropV2Proposal) GetDescription() string { return p.Description }
+
+func (p *AirdropV2Proposal) ProposalRoute() string { return RouterKey }
+
+func (p *AirdropV2Proposal) ProposalType() string {
+	return ProposalTypeAirdropV2
+}
+
+func (p *AirdropV2Proposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.MerkleRoot == "" {
+		if len(p.Recipients) == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "airdrop has no recipients or merkle root")
+		}
+		seen := make(map[string]bool, len(p.Recipients))
+		for _, r := range p.Recipients {
+			if err := r.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(ErrInvalid, err.Error())
+			}
+			if seen[r.Address] {
+				return sdkerrors.Wrapf(ErrDuplicate, "recipient %s", r.Address)
+			}
+			seen[r.Address] = true
+		}
+		if !p.Total.Empty() || p.ClaimWindow != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "total and claim window are only used with a merkle root")
+		}
+		return nil
+	}
+	if len(p.Recipients) != 0 {
+		return sdkerrors.Wrap(ErrInvalid, "airdrop may not have both recipients and a merkle root")
+	}
+	root, err := hex.DecodeString(p.MerkleRoot)
+	if err != nil || len(root) != sha256.Size {
+		return sdkerrors.Wrap(ErrInvalid, "merkle root must be a hex encoded sha256 hash")
+	}
+	if p.Total.Empty() || !p.Total.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid total %s", p.Total)
+	}
+	if p.ClaimWindow == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "claim window must be at least one block")
+	}
+	return nil
+}
+
+// TotalAmount returns the sum of the coins airdropped by the proposal
+func (p AirdropV2Proposal) TotalAmount() sdk.Coins {
+	if p.MerkleRoot != "" {
+		return p.Total
+	}
+	total := sdk.NewCoins()
+	for _, r := range p.Recipients {
+		total = total.Add(r.Amount...)
+	}
+	return total
+}
+
+func (p AirdropV2Proposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Airdrop V2 Proposal:
+  Title:          %s
+  Description:    %s
+  Total Amount:   %s
+`, p.Title, p.Description, p.TotalAmount()))
+	if p.MerkleRoot != "" {
+		b.WriteString(fmt.Sprintf(`  Merkle Root:    %s
+  Claim Window:   %d
+`, p.MerkleRoot, p.ClaimWindow))
+		return b.String()
+	}
+	b.WriteString("  Recipients:\n")
+	for _, r := range p.Recipients {
+		b.WriteString(fmt.Sprintf("    %s: %s\n", r.Address, r.Amount))
+	}
+	return b.String()
+}
+
 func (p *IBCMetadataProposal) GetTitle() string { return p.Title }
 
 func (p *IBCMetadataProposal) GetDescription() string { return p.Description }
@@ -126,3 +215,125 @@ func (p IBCMetadataProposal) String() string {
 `, p.Title, p.Description, p.Metadata.Name, p.Metadata.Symbol, p.Metadata.Display, decimals, p.Metadata.Description))
 	return b.String()
 }
+
+func (p *ERC20MetadataProposal) GetTitle() string { return p.Title }
+
+func (p *ERC20MetadataProposal) GetDescription() string { return p.Description }
+
+func (p *ERC20MetadataProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ERC20MetadataProposal) ProposalType() string {
+	return ProposalTypeERC20Metadata
+}
+
+func (p *ERC20MetadataProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	tokenContract, err := NewEthAddress(p.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract")
+	}
+	if p.Metadata.Base != GravityDenom(*tokenContract) {
+		return sdkerrors.Wrap(ErrInvalid, "metadata base must be the gravity denom of the token contract")
+	}
+	return p.Metadata.Validate()
+}
+
+func (p ERC20MetadataProposal) String() string {
+	decimals := uint32(0)
+	for _, denomUnit := range p.Metadata.DenomUnits {
+		if denomUnit.Denom == p.Metadata.Display {
+			decimals = denomUnit.Exponent
+			break
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`ERC20 Metadata setting proposal:
+  Title:             %s
+  Description:       %s
+  Token Contract:    %s
+  Token Name:        %s
+  Token Symbol:      %s
+  Token Display:     %s
+  Token Decimals:    %d
+  Token Description: %s
+`, p.Title, p.Description, p.TokenContract, p.Metadata.Name, p.Metadata.Symbol, p.Metadata.Display, decimals, p.Metadata.Description))
+	return b.String()
+}
+
+func (p *RequestERC20DeploymentProposal) GetTitle() string { return p.Title }
+
+func (p *RequestERC20DeploymentProposal) GetDescription() string { return p.Description }
+
+func (p *RequestERC20DeploymentProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RequestERC20DeploymentProposal) ProposalType() string {
+	return ProposalTypeRequestERC20Deployment
+}
+
+func (p *RequestERC20DeploymentProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(err, "invalid denom")
+	}
+	// Ethereum originated vouchers already have a canonical ERC20, the one they were deposited from
+	if _, err := GravityDenomToERC20(p.Denom); err == nil {
+		return sdkerrors.Wrap(ErrInvalid, "denom is an Ethereum originated voucher")
+	}
+	return nil
+}
+
+func (p RequestERC20DeploymentProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Request ERC20 Deployment proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+`, p.Title, p.Description, p.Denom))
+	return b.String()
+}
+
+func (p *DeprecateERC20Proposal) GetTitle() string { return p.Title }
+
+func (p *DeprecateERC20Proposal) GetDescription() string { return p.Description }
+
+func (p *DeprecateERC20Proposal) ProposalRoute() string { return RouterKey }
+
+func (p *DeprecateERC20Proposal) ProposalType() string {
+	return ProposalTypeDeprecateERC20
+}
+
+func (p *DeprecateERC20Proposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(err, "invalid denom")
+	}
+	// Ethereum originated vouchers are always represented by the contract they were deposited from
+	if _, err := GravityDenomToERC20(p.Denom); err == nil {
+		return sdkerrors.Wrap(ErrInvalid, "denom is an Ethereum originated voucher")
+	}
+	if p.MigrationWindow == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "migration window must be at least one block")
+	}
+	return nil
+}
+
+func (p DeprecateERC20Proposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Deprecate ERC20 proposal:
+  Title:            %s
+  Description:      %s
+  Denom:            %s
+  Migration Window: %d
+`, p.Title, p.Description, p.Denom, p.MigrationWindow))
+	return b.String()
+}
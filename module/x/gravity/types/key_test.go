@@ -7,16 +7,27 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestPrefixKeysSameLength(t *testing.T) {
+func TestPrefixKeysSingleByte(t *testing.T) {
 	allKeys := getAllKeys()
-	prefixKeys := allKeys[0:27]
-	length := len(HashString("All keys should be same length when hashed"))
+	prefixKeys := allKeys[0:45]
 
 	for _, key := range prefixKeys {
-		require.Equal(t, length, len(key))
+		require.Len(t, key, 1)
 	}
 }
 
+func TestSplitLengthPrefixed(t *testing.T) {
+	validator := sdk.ValAddress([]byte("gravityvaloper1ahx7f"))
+	key := GetBridgeSlashingEventKey(validator, 7, "batch")
+
+	component, rest := SplitLengthPrefixed(key[len(BridgeSlashingEventKey):])
+	require.Equal(t, validator, sdk.ValAddress(component))
+	require.Equal(t, AppendBytes(UInt64Bytes(7), []byte("batch")), rest)
+
+	require.Panics(t, func() { SplitLengthPrefixed([]byte{}) })
+	require.Panics(t, func() { SplitLengthPrefixed([]byte{5, 1, 2}) })
+}
+
 func TestNoDuplicateKeys(t *testing.T) {
 	keys := getAllKeys()
 
@@ -30,20 +41,18 @@ func getAllKeys() [][]byte {
 	i := 0
 	inc := func(i *int) *int { *i += 1; return i }
 
-	keys := make([][]byte, 47)
+	keys := make([][]byte, 65)
 
 	keys[i] = EthAddressByValidatorKey
 	keys[*inc(&i)] = ValidatorByEthAddressKey
 	keys[*inc(&i)] = ValsetRequestKey
 	keys[*inc(&i)] = ValsetConfirmKey
-	keys[*inc(&i)] = OracleClaimKey
 	keys[*inc(&i)] = OracleAttestationKey
 	keys[*inc(&i)] = OutgoingTXPoolKey
 	keys[*inc(&i)] = OutgoingTXBatchKey
 	keys[*inc(&i)] = BatchConfirmKey
 	keys[*inc(&i)] = LastEventNonceByValidatorKey
 	keys[*inc(&i)] = LastObservedEventNonceKey
-	keys[*inc(&i)] = SequenceKeyPrefix
 	keys[*inc(&i)] = KeyLastTXPoolID
 	keys[*inc(&i)] = KeyLastOutgoingBatchID
 	keys[*inc(&i)] = KeyOrchestratorAddress
@@ -59,6 +68,26 @@ func getAllKeys() [][]byte {
 	keys[*inc(&i)] = LastUnBondingBlockHeight
 	keys[*inc(&i)] = LastObservedValsetKey
 	keys[*inc(&i)] = PastEthSignatureCheckpointKey
+	keys[*inc(&i)] = PendingIbcAutoForwards
+	keys[*inc(&i)] = ValidatorBondedHeightKey
+	keys[*inc(&i)] = DelegateKeyRegistrationHeightKey
+	keys[*inc(&i)] = BridgeSlashingEventKey
+	keys[*inc(&i)] = ERC20DeploymentRequestKey
+	keys[*inc(&i)] = ERC20MigrationKey
+	keys[*inc(&i)] = RateLimitInflowKey
+	keys[*inc(&i)] = RateLimitOutflowKey
+	keys[*inc(&i)] = RateLimitedDepositKey
+	keys[*inc(&i)] = CircuitBreakerTripKey
+	keys[*inc(&i)] = MerkleAirdropKey
+	keys[*inc(&i)] = AirdropClaimKey
+	keys[*inc(&i)] = LastMerkleAirdropIDKey
+	keys[*inc(&i)] = DepositReceiptKey
+	keys[*inc(&i)] = BatchExecutionKey
+	keys[*inc(&i)] = ExecutedBatchKey
+	keys[*inc(&i)] = ExecutedBatchByNonceKey
+	keys[*inc(&i)] = ExecutedBatchByTxIdKey
+	keys[*inc(&i)] = LastExecutedBatchIDKey
+	keys[*inc(&i)] = PausedDepositKey
 
 	// sdk.AccAddress, sdk.ValAddress
 	dummyAddr := []byte("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
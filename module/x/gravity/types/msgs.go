@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -25,6 +26,7 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgClaimAirdrop{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
@@ -319,8 +321,22 @@ var (
 	_ EthereumClaim = &MsgBatchSendToEthClaim{}
 	_ EthereumClaim = &MsgERC20DeployedClaim{}
 	_ EthereumClaim = &MsgLogicCallExecutedClaim{}
+
+	_ OptionalClaimData = &MsgSendToCosmosClaim{}
+	_ OptionalClaimData = &MsgBatchSendToEthClaim{}
 )
 
+// OptionalClaimData is implemented by claims with fields added after launch, which older orchestrators do not
+// report. The fields are part of the ClaimHash once set. Claims for events before Params.ClaimDataHeight have them
+// dropped, so that every orchestrator votes for the same claim, from the height on they are required
+type OptionalClaimData interface {
+	EthereumClaim
+	// HasClaimData returns true if the claim carries the fields required from Params.ClaimDataHeight on
+	HasClaimData() bool
+	// ClearClaimData drops the fields from the claim
+	ClearClaimData()
+}
+
 // GetType returns the type of the claim
 func (msg *MsgSendToCosmosClaim) GetType() ClaimType {
 	return CLAIM_TYPE_SEND_TO_COSMOS
@@ -348,9 +364,34 @@ func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
 	if msg.EventNonce == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
+	// ERC20 decimals are a uint8 on Ethereum
+	if msg.TokenDecimals > math.MaxUint8 {
+		return fmt.Errorf("token decimals %d exceeds uint8", msg.TokenDecimals)
+	}
+	if msg.EthTxHash != "" {
+		if err := ValidateEthTxHash(msg.EthTxHash); err != nil {
+			return sdkerrors.Wrap(err, "eth tx hash")
+		}
+	}
 	return nil
 }
 
+// HasTokenMetadata returns true if the orchestrator reported ERC20 metadata with this claim
+func (msg *MsgSendToCosmosClaim) HasTokenMetadata() bool {
+	return msg.TokenName != "" || msg.TokenSymbol != "" || msg.TokenDecimals != 0
+}
+
+// HasClaimData implements OptionalClaimData, only the tx hash is required since not every ERC20 reports metadata
+func (msg *MsgSendToCosmosClaim) HasClaimData() bool {
+	return msg.EthTxHash != ""
+}
+
+// ClearClaimData implements OptionalClaimData
+func (msg *MsgSendToCosmosClaim) ClearClaimData() {
+	msg.EthTxHash = ""
+	msg.TokenName, msg.TokenSymbol, msg.TokenDecimals = "", "", 0
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgSendToCosmosClaim) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -397,6 +438,15 @@ const (
 // structure for who has made what claim and is verified by the msg ante-handler for signatures
 func (msg *MsgSendToCosmosClaim) ClaimHash() ([]byte, error) {
 	path := fmt.Sprintf("%d/%d/%s/%s/%s/%s", msg.EventNonce, msg.BlockHeight, msg.TokenContract, msg.Amount.String(), msg.EthereumSender, msg.CosmosReceiver)
+	// the tx hash and token metadata are only set from Params.ClaimDataHeight on (see OptionalClaimData), claims
+	// without them keep the hash they had before they were added. The name and symbol are quoted so that no two
+	// of them can produce the same path
+	if msg.EthTxHash != "" {
+		path = fmt.Sprintf("%s/%s", path, msg.EthTxHash)
+	}
+	if msg.HasTokenMetadata() {
+		path = fmt.Sprintf("%s/%q/%q/%d", path, msg.TokenName, msg.TokenSymbol, msg.TokenDecimals)
+	}
 	return tmhash.Sum([]byte(path)), nil
 }
 
@@ -435,15 +485,34 @@ func (e *MsgBatchSendToEthClaim) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
 	}
+	if e.EthTxHash != "" {
+		if err := ValidateEthTxHash(e.EthTxHash); err != nil {
+			return sdkerrors.Wrap(err, "eth tx hash")
+		}
+	}
 	return nil
 }
 
 // Hash implements WithdrawBatch.Hash
 func (msg *MsgBatchSendToEthClaim) ClaimHash() ([]byte, error) {
 	path := fmt.Sprintf("%s/%d/%d/%s", msg.TokenContract, msg.BatchNonce, msg.EventNonce, msg.TokenContract)
+	// the tx hash is only set from Params.ClaimDataHeight on, like the optional fields of MsgSendToCosmosClaim
+	if msg.EthTxHash != "" {
+		path = fmt.Sprintf("%s/%s", path, msg.EthTxHash)
+	}
 	return tmhash.Sum([]byte(path)), nil
 }
 
+// HasClaimData implements OptionalClaimData
+func (msg *MsgBatchSendToEthClaim) HasClaimData() bool {
+	return msg.EthTxHash != ""
+}
+
+// ClearClaimData implements OptionalClaimData
+func (msg *MsgBatchSendToEthClaim) ClearClaimData() {
+	msg.EthTxHash = ""
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgBatchSendToEthClaim) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -748,3 +817,47 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// NewMsgClaimAirdrop returns a new MsgClaimAirdrop
+func NewMsgClaimAirdrop(claimer sdk.AccAddress, airdropID uint64, amount sdk.Coins, proof []string) *MsgClaimAirdrop {
+	return &MsgClaimAirdrop{
+		Claimer:   claimer.String(),
+		AirdropId: airdropID,
+		Amount:    amount,
+		Proof:     proof,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgClaimAirdrop) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgClaimAirdrop) Type() string { return "claim_airdrop" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgClaimAirdrop) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Claimer)
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if _, err = DecodeAirdropProof(msg.Proof); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgClaimAirdrop) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
@@ -727,6 +727,15 @@ type MsgSendToCosmosClaim struct {
 	EthereumSender string                                 `protobuf:"bytes,5,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
 	CosmosReceiver string                                 `protobuf:"bytes,6,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
 	Orchestrator   string                                 `protobuf:"bytes,7,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	// optional ERC20 metadata as reported by the token contract, used to set the bank
+	// denom metadata of the voucher when it is first minted. Empty if not available, dropped
+	// before Params.claim_data_height
+	TokenName     string `protobuf:"bytes,8,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
+	TokenSymbol   string `protobuf:"bytes,9,opt,name=token_symbol,json=tokenSymbol,proto3" json:"token_symbol,omitempty"`
+	TokenDecimals uint32 `protobuf:"varint,10,opt,name=token_decimals,json=tokenDecimals,proto3" json:"token_decimals,omitempty"`
+	// hash of the Ethereum transaction which emitted the deposit, required from Params.claim_data_height
+	// on and dropped before it
+	EthTxHash string `protobuf:"bytes,11,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
 }
 
 func (m *MsgSendToCosmosClaim) Reset()         { *m = MsgSendToCosmosClaim{} }
@@ -804,6 +813,34 @@ func (m *MsgSendToCosmosClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgSendToCosmosClaim) GetTokenName() string {
+	if m != nil {
+		return m.TokenName
+	}
+	return ""
+}
+
+func (m *MsgSendToCosmosClaim) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *MsgSendToCosmosClaim) GetTokenDecimals() uint32 {
+	if m != nil {
+		return m.TokenDecimals
+	}
+	return 0
+}
+
+func (m *MsgSendToCosmosClaim) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
 type MsgSendToCosmosClaimResponse struct {
 }
 
@@ -940,6 +977,9 @@ type MsgBatchSendToEthClaim struct {
 	BatchNonce    uint64 `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
 	TokenContract string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Orchestrator  string `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	// hash of the Ethereum transaction which executed the batch, required from Params.claim_data_height
+	// on and dropped before it
+	EthTxHash string `protobuf:"bytes,6,opt,name=eth_tx_hash,json=ethTxHash,proto3" json:"eth_tx_hash,omitempty"`
 }
 
 func (m *MsgBatchSendToEthClaim) Reset()         { *m = MsgBatchSendToEthClaim{} }
@@ -1010,6 +1050,13 @@ func (m *MsgBatchSendToEthClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgBatchSendToEthClaim) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
 type MsgBatchSendToEthClaimResponse struct {
 }
 
@@ -1613,6 +1660,113 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
 
+// MsgClaimAirdrop claims the claimer's share of a Merkle airdrop created by an AirdropV2Proposal.
+// AMOUNT must match the claimer's leaf in the Merkle tree and PROOF is the hex encoded list of
+// sibling hashes from that leaf to the airdrop's Merkle root
+type MsgClaimAirdrop struct {
+	Claimer   string                                   `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	AirdropId uint64                                   `protobuf:"varint,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Proof     []string                                 `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimAirdrop) Reset()         { *m = MsgClaimAirdrop{} }
+func (m *MsgClaimAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdrop) ProtoMessage()    {}
+func (*MsgClaimAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
+}
+func (m *MsgClaimAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdrop.Merge(m, src)
+}
+func (m *MsgClaimAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdrop proto.InternalMessageInfo
+
+func (m *MsgClaimAirdrop) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *MsgClaimAirdrop) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
+	}
+	return 0
+}
+
+func (m *MsgClaimAirdrop) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgClaimAirdrop) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type MsgClaimAirdropResponse struct {
+}
+
+func (m *MsgClaimAirdropResponse) Reset()         { *m = MsgClaimAirdropResponse{} }
+func (m *MsgClaimAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdropResponse) ProtoMessage()    {}
+func (*MsgClaimAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
+}
+func (m *MsgClaimAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdropResponse.Merge(m, src)
+}
+func (m *MsgClaimAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdropResponse proto.InternalMessageInfo
+
 type EventSetOperatorAddress struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -1622,7 +1776,7 @@ func (m *EventSetOperatorAddress) Reset()         { *m = EventSetOperatorAddress
 func (m *EventSetOperatorAddress) String() string { return proto.CompactTextString(m) }
 func (*EventSetOperatorAddress) ProtoMessage()    {}
 func (*EventSetOperatorAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{28}
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
 }
 func (m *EventSetOperatorAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1674,7 +1828,7 @@ func (m *EventValsetConfirmKey) Reset()         { *m = EventValsetConfirmKey{} }
 func (m *EventValsetConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventValsetConfirmKey) ProtoMessage()    {}
 func (*EventValsetConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{29}
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
 }
 func (m *EventValsetConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1726,7 +1880,7 @@ func (m *EventBatchCreated) Reset()         { *m = EventBatchCreated{} }
 func (m *EventBatchCreated) String() string { return proto.CompactTextString(m) }
 func (*EventBatchCreated) ProtoMessage()    {}
 func (*EventBatchCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{30}
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
 }
 func (m *EventBatchCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1778,7 +1932,7 @@ func (m *EventBatchConfirmKey) Reset()         { *m = EventBatchConfirmKey{} }
 func (m *EventBatchConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventBatchConfirmKey) ProtoMessage()    {}
 func (*EventBatchConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{31}
+	return fileDescriptor_2f8523f2f6feb451, []int{33}
 }
 func (m *EventBatchConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1829,7 +1983,7 @@ func (m *EventBatchSendToEthClaim) Reset()         { *m = EventBatchSendToEthCla
 func (m *EventBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*EventBatchSendToEthClaim) ProtoMessage()    {}
 func (*EventBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{32}
+	return fileDescriptor_2f8523f2f6feb451, []int{34}
 }
 func (m *EventBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1875,7 +2029,7 @@ func (m *EventClaim) Reset()         { *m = EventClaim{} }
 func (m *EventClaim) String() string { return proto.CompactTextString(m) }
 func (*EventClaim) ProtoMessage()    {}
 func (*EventClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{33}
+	return fileDescriptor_2f8523f2f6feb451, []int{35}
 }
 func (m *EventClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1935,7 +2089,7 @@ func (m *EventBadSignatureEvidence) Reset()         { *m = EventBadSignatureEvid
 func (m *EventBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*EventBadSignatureEvidence) ProtoMessage()    {}
 func (*EventBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{34}
+	return fileDescriptor_2f8523f2f6feb451, []int{36}
 }
 func (m *EventBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1994,7 +2148,7 @@ func (m *EventERC20DeployedClaim) Reset()         { *m = EventERC20DeployedClaim
 func (m *EventERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventERC20DeployedClaim) ProtoMessage()    {}
 func (*EventERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{35}
+	return fileDescriptor_2f8523f2f6feb451, []int{37}
 }
 func (m *EventERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2037,6 +2191,126 @@ func (m *EventERC20DeployedClaim) GetNonce() string {
 	return ""
 }
 
+type EventERC20Deprecated struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	OldToken  string `protobuf:"bytes,2,opt,name=old_token,json=oldToken,proto3" json:"old_token,omitempty"`
+	EndHeight string `protobuf:"bytes,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *EventERC20Deprecated) Reset()         { *m = EventERC20Deprecated{} }
+func (m *EventERC20Deprecated) String() string { return proto.CompactTextString(m) }
+func (*EventERC20Deprecated) ProtoMessage()    {}
+func (*EventERC20Deprecated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{38}
+}
+func (m *EventERC20Deprecated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventERC20Deprecated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventERC20Deprecated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventERC20Deprecated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventERC20Deprecated.Merge(m, src)
+}
+func (m *EventERC20Deprecated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventERC20Deprecated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventERC20Deprecated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventERC20Deprecated proto.InternalMessageInfo
+
+func (m *EventERC20Deprecated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventERC20Deprecated) GetOldToken() string {
+	if m != nil {
+		return m.OldToken
+	}
+	return ""
+}
+
+func (m *EventERC20Deprecated) GetEndHeight() string {
+	if m != nil {
+		return m.EndHeight
+	}
+	return ""
+}
+
+type EventERC20MigrationCompleted struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	OldToken string `protobuf:"bytes,2,opt,name=old_token,json=oldToken,proto3" json:"old_token,omitempty"`
+	NewToken string `protobuf:"bytes,3,opt,name=new_token,json=newToken,proto3" json:"new_token,omitempty"`
+}
+
+func (m *EventERC20MigrationCompleted) Reset()         { *m = EventERC20MigrationCompleted{} }
+func (m *EventERC20MigrationCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventERC20MigrationCompleted) ProtoMessage()    {}
+func (*EventERC20MigrationCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{39}
+}
+func (m *EventERC20MigrationCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventERC20MigrationCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventERC20MigrationCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventERC20MigrationCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventERC20MigrationCompleted.Merge(m, src)
+}
+func (m *EventERC20MigrationCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventERC20MigrationCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventERC20MigrationCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventERC20MigrationCompleted proto.InternalMessageInfo
+
+func (m *EventERC20MigrationCompleted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventERC20MigrationCompleted) GetOldToken() string {
+	if m != nil {
+		return m.OldToken
+	}
+	return ""
+}
+
+func (m *EventERC20MigrationCompleted) GetNewToken() string {
+	if m != nil {
+		return m.NewToken
+	}
+	return ""
+}
+
 type EventValsetUpdatedClaim struct {
 	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
@@ -2045,7 +2319,7 @@ func (m *EventValsetUpdatedClaim) Reset()         { *m = EventValsetUpdatedClaim
 func (m *EventValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventValsetUpdatedClaim) ProtoMessage()    {}
 func (*EventValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{36}
+	return fileDescriptor_2f8523f2f6feb451, []int{40}
 }
 func (m *EventValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2092,7 +2366,7 @@ func (m *EventMultisigUpdateRequest) Reset()         { *m = EventMultisigUpdateR
 func (m *EventMultisigUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*EventMultisigUpdateRequest) ProtoMessage()    {}
 func (*EventMultisigUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{37}
+	return fileDescriptor_2f8523f2f6feb451, []int{41}
 }
 func (m *EventMultisigUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2158,7 +2432,7 @@ func (m *EventOutgoingLogicCallCanceled) Reset()         { *m = EventOutgoingLog
 func (m *EventOutgoingLogicCallCanceled) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingLogicCallCanceled) ProtoMessage()    {}
 func (*EventOutgoingLogicCallCanceled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{38}
+	return fileDescriptor_2f8523f2f6feb451, []int{42}
 }
 func (m *EventOutgoingLogicCallCanceled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2484,7 @@ func (m *EventSignatureSlashing) Reset()         { *m = EventSignatureSlashing{}
 func (m *EventSignatureSlashing) String() string { return proto.CompactTextString(m) }
 func (*EventSignatureSlashing) ProtoMessage()    {}
 func (*EventSignatureSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{39}
+	return fileDescriptor_2f8523f2f6feb451, []int{43}
 }
 func (m *EventSignatureSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2262,7 +2536,7 @@ func (m *EventOutgoingTxId) Reset()         { *m = EventOutgoingTxId{} }
 func (m *EventOutgoingTxId) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingTxId) ProtoMessage()    {}
 func (*EventOutgoingTxId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{40}
+	return fileDescriptor_2f8523f2f6feb451, []int{44}
 }
 func (m *EventOutgoingTxId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2305,6 +2579,118 @@ func (m *EventOutgoingTxId) GetTxId() string {
 	return ""
 }
 
+type EventAirdropClaimed struct {
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Claimer   string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventAirdropClaimed) Reset()         { *m = EventAirdropClaimed{} }
+func (m *EventAirdropClaimed) String() string { return proto.CompactTextString(m) }
+func (*EventAirdropClaimed) ProtoMessage()    {}
+func (*EventAirdropClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{45}
+}
+func (m *EventAirdropClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAirdropClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAirdropClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAirdropClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAirdropClaimed.Merge(m, src)
+}
+func (m *EventAirdropClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAirdropClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAirdropClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAirdropClaimed proto.InternalMessageInfo
+
+func (m *EventAirdropClaimed) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *EventAirdropClaimed) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *EventAirdropClaimed) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type EventAirdropExpired struct {
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Returned  string `protobuf:"bytes,2,opt,name=returned,proto3" json:"returned,omitempty"`
+}
+
+func (m *EventAirdropExpired) Reset()         { *m = EventAirdropExpired{} }
+func (m *EventAirdropExpired) String() string { return proto.CompactTextString(m) }
+func (*EventAirdropExpired) ProtoMessage()    {}
+func (*EventAirdropExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{46}
+}
+func (m *EventAirdropExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAirdropExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAirdropExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAirdropExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAirdropExpired.Merge(m, src)
+}
+func (m *EventAirdropExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAirdropExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAirdropExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAirdropExpired proto.InternalMessageInfo
+
+func (m *EventAirdropExpired) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *EventAirdropExpired) GetReturned() string {
+	if m != nil {
+		return m.Returned
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
 	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
@@ -2334,6 +2720,8 @@ func init() {
 	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "gravity.v1.MsgCancelSendToEthResponse")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgClaimAirdrop)(nil), "gravity.v1.MsgClaimAirdrop")
+	proto.RegisterType((*MsgClaimAirdropResponse)(nil), "gravity.v1.MsgClaimAirdropResponse")
 	proto.RegisterType((*EventSetOperatorAddress)(nil), "gravity.v1.EventSetOperatorAddress")
 	proto.RegisterType((*EventValsetConfirmKey)(nil), "gravity.v1.EventValsetConfirmKey")
 	proto.RegisterType((*EventBatchCreated)(nil), "gravity.v1.EventBatchCreated")
@@ -2342,144 +2730,166 @@ func init() {
 	proto.RegisterType((*EventClaim)(nil), "gravity.v1.EventClaim")
 	proto.RegisterType((*EventBadSignatureEvidence)(nil), "gravity.v1.EventBadSignatureEvidence")
 	proto.RegisterType((*EventERC20DeployedClaim)(nil), "gravity.v1.EventERC20DeployedClaim")
+	proto.RegisterType((*EventERC20Deprecated)(nil), "gravity.v1.EventERC20Deprecated")
+	proto.RegisterType((*EventERC20MigrationCompleted)(nil), "gravity.v1.EventERC20MigrationCompleted")
 	proto.RegisterType((*EventValsetUpdatedClaim)(nil), "gravity.v1.EventValsetUpdatedClaim")
 	proto.RegisterType((*EventMultisigUpdateRequest)(nil), "gravity.v1.EventMultisigUpdateRequest")
 	proto.RegisterType((*EventOutgoingLogicCallCanceled)(nil), "gravity.v1.EventOutgoingLogicCallCanceled")
 	proto.RegisterType((*EventSignatureSlashing)(nil), "gravity.v1.EventSignatureSlashing")
 	proto.RegisterType((*EventOutgoingTxId)(nil), "gravity.v1.EventOutgoingTxId")
+	proto.RegisterType((*EventAirdropClaimed)(nil), "gravity.v1.EventAirdropClaimed")
+	proto.RegisterType((*EventAirdropExpired)(nil), "gravity.v1.EventAirdropExpired")
 }
 
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 2025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
-	0xf5, 0x0f, 0x6d, 0x39, 0x89, 0x9f, 0xec, 0x28, 0x66, 0x1c, 0x47, 0x62, 0x6c, 0xd9, 0x66, 0xd6,
-	0x76, 0x36, 0xfb, 0xb5, 0x14, 0xfb, 0x7b, 0x28, 0x8a, 0x05, 0xba, 0xb0, 0x14, 0xa7, 0x2b, 0xb4,
-	0xce, 0x02, 0x72, 0xba, 0x40, 0x8b, 0x02, 0x04, 0x45, 0x4e, 0x28, 0x36, 0x14, 0xe9, 0x72, 0x46,
-	0x5a, 0xfb, 0xb2, 0x40, 0x7b, 0x2b, 0xb6, 0x87, 0xfe, 0x38, 0x15, 0xd8, 0x02, 0x05, 0xda, 0x6b,
-	0xd1, 0x4b, 0x4f, 0xbd, 0xf4, 0x1a, 0xf4, 0x50, 0x2c, 0xd0, 0x43, 0x8b, 0x16, 0x58, 0x14, 0x49,
-	0xff, 0x90, 0x62, 0x7e, 0x70, 0x34, 0xa4, 0x46, 0xb2, 0x5b, 0xa4, 0x27, 0x71, 0xde, 0xbc, 0x79,
-	0xef, 0x33, 0xef, 0xbd, 0x79, 0x3f, 0x04, 0x77, 0x83, 0xd4, 0x1d, 0x85, 0xe4, 0xa2, 0x39, 0x3a,
-	0x68, 0x0e, 0x70, 0x80, 0x1b, 0x67, 0x69, 0x42, 0x12, 0x13, 0x04, 0xb9, 0x31, 0x3a, 0xb0, 0xea,
-	0x5e, 0x82, 0x07, 0x09, 0x6e, 0xf6, 0x5c, 0x8c, 0x9a, 0xa3, 0x83, 0x1e, 0x22, 0xee, 0x41, 0xd3,
-	0x4b, 0xc2, 0x98, 0xf3, 0x5a, 0xab, 0x41, 0x12, 0x24, 0xec, 0xb3, 0x49, 0xbf, 0x04, 0x75, 0x3d,
-	0x48, 0x92, 0x20, 0x42, 0x4d, 0xf7, 0x2c, 0x6c, 0xba, 0x71, 0x9c, 0x10, 0x97, 0x84, 0x49, 0x2c,
-	0xe4, 0x5b, 0x6b, 0x8a, 0x5a, 0x72, 0x71, 0x86, 0x32, 0x7a, 0x4d, 0x9c, 0x62, 0xab, 0xde, 0xf0,
-	0x45, 0xd3, 0x8d, 0x2f, 0xb2, 0x2d, 0x0e, 0xc3, 0xe1, 0x9a, 0xf8, 0x82, 0x6f, 0xd9, 0x9f, 0x42,
-	0xed, 0x04, 0x07, 0xa7, 0x88, 0x7c, 0x94, 0x7a, 0x7d, 0x84, 0x49, 0xea, 0x92, 0x24, 0x3d, 0xf2,
-	0xfd, 0x14, 0x61, 0x6c, 0xae, 0xc3, 0xe2, 0xc8, 0x8d, 0x42, 0x9f, 0xd2, 0xaa, 0xc6, 0x96, 0xf1,
-	0x70, 0xb1, 0x3b, 0x26, 0x98, 0x36, 0x2c, 0x25, 0xca, 0xa1, 0xea, 0x1c, 0x63, 0xc8, 0xd1, 0xcc,
-	0x4d, 0x28, 0x23, 0xd2, 0x77, 0x5c, 0x2e, 0xb0, 0x3a, 0xcf, 0x58, 0x00, 0x91, 0xbe, 0x50, 0x61,
-	0x3f, 0x80, 0xed, 0xa9, 0xfa, 0xbb, 0x08, 0x9f, 0x25, 0x31, 0x46, 0xf6, 0x67, 0x06, 0xdc, 0x3e,
-	0xc1, 0xc1, 0xc7, 0x6e, 0x84, 0x11, 0x69, 0x27, 0xf1, 0x8b, 0x30, 0x1d, 0x98, 0xab, 0xb0, 0x10,
-	0x27, 0xb1, 0x87, 0x18, 0xb0, 0x52, 0x97, 0x2f, 0xde, 0x0a, 0x28, 0x7a, 0x6f, 0x1c, 0x06, 0xb1,
-	0x4b, 0x86, 0x29, 0xaa, 0x96, 0xf8, 0xbd, 0x25, 0xc1, 0xb6, 0xa0, 0x5a, 0x04, 0x23, 0x91, 0xfe,
-	0xc1, 0x80, 0x25, 0x76, 0x9f, 0xd8, 0x7f, 0x9e, 0x1c, 0x93, 0xbe, 0xb9, 0x06, 0xd7, 0x31, 0x8a,
-	0x7d, 0x94, 0xd9, 0x4f, 0xac, 0xcc, 0x1a, 0xdc, 0xa4, 0x18, 0x7c, 0x84, 0x89, 0xc0, 0x78, 0x03,
-	0x91, 0xfe, 0x13, 0x84, 0x89, 0xf9, 0x15, 0xb8, 0xee, 0x0e, 0x92, 0x61, 0x4c, 0x18, 0xb2, 0xf2,
-	0x61, 0xad, 0x21, 0x3c, 0x46, 0xa3, 0xa8, 0x21, 0xa2, 0xa8, 0xd1, 0x4e, 0xc2, 0xb8, 0x55, 0x7a,
-	0xf5, 0xe5, 0xe6, 0xb5, 0xae, 0x60, 0x37, 0xbf, 0x06, 0xd0, 0x4b, 0x43, 0x3f, 0x40, 0xce, 0x0b,
-	0xc4, 0x71, 0x5f, 0xe1, 0xf0, 0x22, 0x3f, 0xf2, 0x14, 0x21, 0x7b, 0x0d, 0x56, 0x55, 0xec, 0xf2,
-	0x52, 0x1f, 0x40, 0xe5, 0x04, 0x07, 0x5d, 0xf4, 0xfd, 0x21, 0xc2, 0xa4, 0xe5, 0x12, 0x6f, 0xfa,
-	0xb5, 0x56, 0x61, 0xc1, 0x47, 0x71, 0x32, 0x10, 0x77, 0xe2, 0x0b, 0xbb, 0x06, 0xf7, 0x0a, 0x02,
-	0xa4, 0xec, 0xdf, 0x19, 0x4c, 0xb8, 0xb0, 0x23, 0x17, 0xae, 0xf7, 0xec, 0x0e, 0xdc, 0x22, 0xc9,
-	0x4b, 0x14, 0x3b, 0x5e, 0x12, 0x93, 0xd4, 0xf5, 0x32, 0xbb, 0x2d, 0x33, 0x6a, 0x5b, 0x10, 0xcd,
-	0x0d, 0xa0, 0x9e, 0x74, 0xa8, 0xbb, 0x50, 0x2a, 0x7c, 0xbb, 0x88, 0x48, 0xff, 0x94, 0x11, 0x26,
-	0xe2, 0xa3, 0xa4, 0x89, 0x8f, 0x9c, 0xfb, 0x17, 0x8a, 0xee, 0xe7, 0x97, 0x51, 0x01, 0xcb, 0xcb,
-	0xfc, 0xd9, 0x80, 0x3b, 0xe3, 0xbd, 0x6f, 0x26, 0x41, 0xe8, 0xb5, 0xdd, 0x28, 0x32, 0xf7, 0xa0,
-	0x12, 0xc6, 0xe2, 0xe1, 0x84, 0x49, 0xec, 0x84, 0xbe, 0x30, 0xdb, 0x2d, 0x95, 0xdc, 0xf1, 0xcd,
-	0x7d, 0x30, 0x73, 0x8c, 0xdc, 0x0c, 0x73, 0xcc, 0x0c, 0x2b, 0xea, 0xce, 0x33, 0x66, 0x92, 0xff,
-	0xf9, 0x5d, 0x37, 0xe0, 0xbe, 0xe6, 0x3e, 0xf2, 0xbe, 0x7f, 0x9c, 0x53, 0x22, 0xa6, 0xcd, 0xe2,
-	0xac, 0x1d, 0xb9, 0xe1, 0x80, 0xbd, 0xb0, 0x11, 0x8a, 0x89, 0xa3, 0xfa, 0x11, 0x18, 0x89, 0x23,
-	0xdf, 0x86, 0xa5, 0x5e, 0x94, 0x78, 0x2f, 0x9d, 0x3e, 0x0a, 0x83, 0x3e, 0x11, 0x57, 0x2c, 0x33,
-	0xda, 0x87, 0x8c, 0xa4, 0xf1, 0xf7, 0xbc, 0xce, 0xdf, 0x4f, 0xe5, 0x6b, 0x61, 0xd7, 0x6b, 0x35,
-	0x68, 0x54, 0xff, 0xfd, 0xcb, 0xcd, 0xdd, 0x20, 0x24, 0xfd, 0x61, 0xaf, 0xe1, 0x25, 0x03, 0x91,
-	0xf1, 0xc4, 0xcf, 0x3e, 0xf6, 0x5f, 0x8a, 0xc4, 0xd9, 0x89, 0x89, 0x7c, 0x3c, 0x7b, 0x50, 0x41,
-	0xa4, 0x8f, 0x52, 0x34, 0x1c, 0x38, 0x22, 0xb4, 0xb9, 0x39, 0x6e, 0x65, 0xe4, 0x53, 0x1e, 0xe2,
-	0x7b, 0x50, 0x11, 0xe9, 0x34, 0x45, 0x1e, 0x0a, 0x47, 0x28, 0xad, 0x5e, 0xe7, 0x8c, 0x9c, 0xdc,
-	0x15, 0xd4, 0x09, 0xf3, 0xdf, 0x98, 0x34, 0xbf, 0x5d, 0x87, 0x75, 0x9d, 0x01, 0xa5, 0x85, 0x3d,
-	0x96, 0x9e, 0x8f, 0xcf, 0x91, 0x37, 0x24, 0xa8, 0xd3, 0xf3, 0x8e, 0x86, 0x24, 0x79, 0x9a, 0xa4,
-	0x9f, 0xb8, 0xa9, 0x8f, 0xcd, 0x47, 0xb0, 0xf2, 0x42, 0x7c, 0x3b, 0x24, 0x71, 0xbc, 0x08, 0xb9,
-	0xa9, 0xb0, 0x75, 0x25, 0xdb, 0x78, 0x9e, 0xb4, 0x29, 0xd9, 0xb4, 0xe0, 0x26, 0x62, 0x52, 0x64,
-	0x4e, 0x94, 0x6b, 0x91, 0x83, 0xf5, 0x4a, 0x24, 0x92, 0x57, 0x06, 0xac, 0x9d, 0xe0, 0x80, 0x05,
-	0xbc, 0x4c, 0x11, 0x6f, 0xcf, 0xdb, 0x9b, 0x50, 0xee, 0x51, 0xd1, 0x42, 0xc6, 0x3c, 0x97, 0xc1,
-	0x48, 0xcf, 0xa6, 0x3c, 0xff, 0x92, 0x2e, 0x1c, 0x8a, 0x46, 0x5f, 0xd0, 0x18, 0x7d, 0x0b, 0xea,
-	0xfa, 0x9b, 0xc8, 0xcb, 0xfe, 0x74, 0x0e, 0xee, 0x52, 0x93, 0x74, 0xdb, 0x87, 0x8f, 0x9f, 0xa0,
-	0xb3, 0x28, 0xb9, 0x40, 0xfe, 0xdb, 0xbb, 0xeb, 0x36, 0x2c, 0x89, 0x08, 0xe2, 0xb9, 0x92, 0xc7,
-	0x75, 0x99, 0xd3, 0x9e, 0x50, 0xd2, 0x55, 0x6f, 0x6b, 0x42, 0x29, 0x76, 0x07, 0xd9, 0xc3, 0x65,
-	0xdf, 0x2c, 0x35, 0x5f, 0x0c, 0x7a, 0x49, 0x24, 0xc2, 0x52, 0xac, 0x68, 0x04, 0xf8, 0xc8, 0x0b,
-	0x07, 0x6e, 0x84, 0x59, 0x28, 0x96, 0xba, 0x72, 0x3d, 0x61, 0xb5, 0x9b, 0x1a, 0xab, 0x6d, 0xc2,
-	0x86, 0xd6, 0x24, 0xd2, 0x68, 0xff, 0x30, 0x58, 0xb0, 0xca, 0x34, 0x21, 0x02, 0xea, 0x2d, 0x1a,
-	0x4e, 0x93, 0x47, 0xa9, 0xed, 0x96, 0xae, 0x98, 0x47, 0x4b, 0xd3, 0xf2, 0xe8, 0x55, 0x82, 0x86,
-	0x3f, 0x12, 0xfd, 0xe5, 0xa4, 0x09, 0xfe, 0xca, 0xe3, 0x86, 0xf7, 0x06, 0xdf, 0x3a, 0xf3, 0xdd,
-	0xff, 0xe8, 0xfa, 0x23, 0x76, 0x2c, 0x97, 0xf4, 0xcb, 0x9c, 0xa6, 0xb7, 0xd0, 0xfc, 0xa4, 0x85,
-	0xde, 0x87, 0x1b, 0x03, 0x34, 0xe8, 0xa1, 0x14, 0x57, 0x4b, 0x5b, 0xf3, 0x0f, 0xcb, 0x87, 0xf7,
-	0x1b, 0xe3, 0x76, 0xb4, 0xd1, 0x62, 0xa5, 0xfe, 0xe3, 0xac, 0x83, 0x13, 0x1d, 0x40, 0x76, 0xc2,
-	0x3c, 0x85, 0xe5, 0x14, 0xd1, 0x57, 0xef, 0x88, 0x8c, 0xba, 0xf0, 0x5f, 0x65, 0xd4, 0x25, 0x2e,
-	0xe4, 0x88, 0xe7, 0xd5, 0x6d, 0x10, 0x6b, 0x87, 0x85, 0xae, 0x08, 0xca, 0x32, 0xa7, 0x3d, 0xa7,
-	0xa4, 0x2b, 0x25, 0x4a, 0x1e, 0x7d, 0x93, 0x86, 0x95, 0xa6, 0x3f, 0x05, 0x93, 0x96, 0x2a, 0x37,
-	0xf6, 0x50, 0x34, 0x6e, 0xbf, 0xe8, 0x3b, 0x4a, 0xdd, 0x18, 0xbb, 0x9e, 0x5a, 0x78, 0x4b, 0xdd,
-	0x65, 0x85, 0xda, 0xf1, 0x95, 0x76, 0x66, 0x4e, 0x6d, 0x67, 0xec, 0x75, 0xb0, 0x26, 0x85, 0x4a,
-	0x95, 0xbf, 0x30, 0x18, 0xa8, 0xd3, 0x61, 0x6f, 0x10, 0x92, 0x96, 0xeb, 0x9f, 0x66, 0x75, 0xf3,
-	0x78, 0x14, 0xfa, 0x88, 0x7a, 0xac, 0x05, 0x37, 0xf0, 0xb0, 0xf7, 0x3d, 0xe4, 0x11, 0xa6, 0xb7,
-	0x7c, 0xb8, 0xda, 0xe0, 0x5d, 0x7a, 0x23, 0xeb, 0xd2, 0x1b, 0x47, 0xf1, 0x45, 0xcb, 0xfc, 0xd3,
-	0xef, 0xf7, 0x6f, 0x1d, 0x67, 0x65, 0x86, 0x16, 0x6f, 0xbf, 0x9b, 0x1d, 0xcc, 0x57, 0xe8, 0xb9,
-	0x42, 0x85, 0x56, 0x90, 0xcf, 0xe7, 0x90, 0xef, 0xc1, 0xce, 0x4c, 0x68, 0xf2, 0x12, 0x27, 0x70,
-	0xef, 0x98, 0x46, 0x21, 0x6d, 0xc1, 0xcf, 0x50, 0xae, 0xfd, 0xaf, 0xd2, 0x60, 0xc2, 0xd8, 0x0d,
-	0x90, 0x68, 0x57, 0xb2, 0x25, 0xdd, 0xc9, 0xba, 0x67, 0xd1, 0xbc, 0x8a, 0xa5, 0xdd, 0x86, 0xbb,
-	0x4c, 0x5c, 0xae, 0x3d, 0xfe, 0x06, 0xba, 0x98, 0x21, 0xec, 0x36, 0xcc, 0xbf, 0x44, 0x17, 0x42,
-	0x10, 0xfd, 0xb4, 0x9f, 0xc1, 0x0a, 0x13, 0xc2, 0x52, 0x74, 0x3b, 0x45, 0xd4, 0xdb, 0x33, 0x04,
-	0x14, 0x6a, 0x07, 0x17, 0xa4, 0xd4, 0x0e, 0xfb, 0xbb, 0xb0, 0xaa, 0xc8, 0xbb, 0x0a, 0xa6, 0x47,
-	0xb0, 0xc2, 0x45, 0x7a, 0x9c, 0xdb, 0x19, 0x23, 0xac, 0xf4, 0xf2, 0x52, 0xec, 0xc7, 0x50, 0x1d,
-	0x4b, 0x2f, 0x94, 0xc6, 0x5c, 0x2b, 0xbb, 0x28, 0x5a, 0x59, 0x3b, 0x02, 0x60, 0x27, 0x38, 0xcf,
-	0x74, 0x14, 0x1b, 0x00, 0x1e, 0x65, 0x71, 0xfa, 0x2e, 0xee, 0x67, 0xbe, 0x67, 0x94, 0x0f, 0x5d,
-	0xcc, 0x82, 0xdb, 0x25, 0x04, 0x61, 0x92, 0xcb, 0x86, 0x8b, 0xdd, 0x65, 0x85, 0xda, 0xf1, 0xed,
-	0xcf, 0x0d, 0xa8, 0x09, 0x80, 0x9a, 0x10, 0xbd, 0xc4, 0x06, 0xbe, 0x93, 0x75, 0x98, 0x6a, 0x00,
-	0x56, 0x7a, 0xae, 0x7f, 0xcc, 0xfb, 0x4c, 0x1e, 0x86, 0x5f, 0x85, 0xda, 0x04, 0xaf, 0x93, 0x85,
-	0x3e, 0x47, 0xb5, 0x56, 0x38, 0x73, 0xca, 0x77, 0xed, 0x63, 0x11, 0x80, 0x9a, 0x62, 0xbb, 0x0a,
-	0x0b, 0x3c, 0x69, 0x08, 0xeb, 0xb1, 0xc5, 0xd8, 0xa6, 0x73, 0xaa, 0x4d, 0x9b, 0x42, 0x8c, 0x26,
-	0xf7, 0xea, 0x9d, 0xf0, 0x1b, 0x03, 0x2c, 0x76, 0xe2, 0x64, 0x18, 0x91, 0x10, 0x87, 0x01, 0x3f,
-	0x23, 0xa6, 0x14, 0x5a, 0x6b, 0xc4, 0x30, 0x25, 0x4b, 0xb0, 0xe8, 0xd9, 0x39, 0x59, 0xd6, 0xe0,
-	0xdd, 0x31, 0x63, 0xdf, 0x0d, 0x99, 0x1b, 0xc4, 0x60, 0x22, 0x18, 0x29, 0xb5, 0xe3, 0xd3, 0x28,
-	0x1d, 0x08, 0x4d, 0x63, 0x57, 0x41, 0x46, 0xea, 0xf8, 0x63, 0x98, 0x25, 0x15, 0xe6, 0xaf, 0x0c,
-	0xa8, 0x33, 0x98, 0x1f, 0x0d, 0x49, 0x90, 0x84, 0xf1, 0xb8, 0x04, 0xf1, 0xb4, 0x84, 0x7c, 0xf3,
-	0x7d, 0xb0, 0x22, 0x4a, 0x74, 0x3c, 0x37, 0x8a, 0x1c, 0xfd, 0xa4, 0x71, 0x2f, 0xca, 0x8e, 0x75,
-	0xf2, 0xa5, 0xf2, 0x08, 0x36, 0xa6, 0x1d, 0x56, 0xad, 0x6c, 0x69, 0xcf, 0xf3, 0xe7, 0xf5, 0x14,
-	0xd6, 0x78, 0x0a, 0x91, 0xae, 0x8d, 0x5c, 0xdc, 0x0f, 0xe3, 0x80, 0xf6, 0x27, 0xb4, 0x2e, 0x08,
-	0x0c, 0xec, 0x7b, 0x46, 0xee, 0x68, 0x89, 0x67, 0x9f, 0xdd, 0xf4, 0xf9, 0x79, 0x67, 0xd6, 0xb3,
-	0xbf, 0x03, 0x0b, 0xe4, 0x7c, 0x6c, 0xee, 0x12, 0x39, 0xef, 0xf8, 0x87, 0xbf, 0xae, 0xc0, 0xfc,
-	0x09, 0x0e, 0xcc, 0x4f, 0x60, 0x39, 0xff, 0x77, 0xc1, 0xba, 0x5a, 0x08, 0x8b, 0xf3, 0xbb, 0xf5,
-	0xce, 0xac, 0x5d, 0x99, 0x2b, 0xed, 0x1f, 0xfe, 0xe5, 0x5f, 0x3f, 0x9f, 0x5b, 0xb7, 0xad, 0xa6,
-	0xf2, 0x1f, 0x8c, 0xa8, 0xda, 0x22, 0x51, 0x98, 0x7d, 0x58, 0x1c, 0x97, 0x9f, 0x6a, 0x41, 0xac,
-	0xdc, 0xb1, 0xb6, 0xa6, 0xed, 0x48, 0x65, 0x9b, 0x4c, 0x59, 0xcd, 0xbe, 0xa7, 0x2a, 0xa3, 0xd9,
-	0x9d, 0xf6, 0xfa, 0x88, 0xf4, 0x4d, 0x0c, 0x4b, 0xb9, 0x99, 0xfc, 0x7e, 0x41, 0xa4, 0xba, 0x69,
-	0x3d, 0x98, 0xb1, 0x29, 0x55, 0x6e, 0x33, 0x95, 0xf7, 0xed, 0x9a, 0xaa, 0x32, 0xe5, 0x9c, 0x0e,
-	0x4b, 0x7b, 0x54, 0x69, 0x6e, 0x56, 0x2f, 0x2a, 0x55, 0x37, 0x27, 0x94, 0x6a, 0x87, 0x66, 0xad,
-	0xd2, 0x2c, 0xed, 0x72, 0xa5, 0x9f, 0xc2, 0xed, 0x89, 0x99, 0x7a, 0x53, 0x2f, 0x5b, 0x32, 0x58,
-	0x7b, 0x97, 0x30, 0x48, 0x00, 0x5b, 0x0c, 0x80, 0x65, 0x57, 0x27, 0x00, 0x0c, 0x1c, 0x16, 0xf5,
-	0xe6, 0x8f, 0x0c, 0x58, 0x99, 0x1c, 0x72, 0xf5, 0x2e, 0x54, 0x38, 0xac, 0x87, 0x97, 0x71, 0x48,
-	0x0c, 0x0f, 0x19, 0x06, 0xdb, 0xde, 0xd2, 0x39, 0x5b, 0x0c, 0x0b, 0x2c, 0xf1, 0x9b, 0xbf, 0x34,
-	0x60, 0x6d, 0xca, 0x3c, 0xb8, 0x53, 0x50, 0xa7, 0x67, 0xb3, 0xf6, 0xaf, 0xc4, 0x26, 0xa1, 0xed,
-	0x33, 0x68, 0x7b, 0xf6, 0x8e, 0x0a, 0x8d, 0xcf, 0x8e, 0xc8, 0x09, 0x7b, 0x9e, 0xe3, 0x0e, 0x49,
-	0xe2, 0x64, 0xf3, 0xa6, 0xf9, 0x33, 0x03, 0xee, 0xe8, 0x2a, 0xa1, 0x5d, 0xd0, 0xaa, 0xe1, 0xb1,
-	0x1e, 0x5d, 0xce, 0x23, 0x61, 0xbd, 0xc7, 0x60, 0xed, 0xd8, 0x0f, 0x54, 0x58, 0xbc, 0x66, 0x2b,
-	0x8f, 0x44, 0x18, 0xed, 0x33, 0x03, 0x56, 0xd4, 0xc2, 0xc0, 0x21, 0x6d, 0x6b, 0x1f, 0xbd, 0x5a,
-	0x3a, 0xac, 0x77, 0x2f, 0x65, 0x99, 0xed, 0x42, 0x91, 0x1c, 0x86, 0xfc, 0x80, 0x40, 0xf3, 0x63,
-	0x03, 0x4c, 0x4d, 0xb5, 0x2b, 0xc2, 0x99, 0x64, 0x99, 0x80, 0x33, 0x63, 0x1a, 0xd3, 0xc2, 0x41,
-	0xa9, 0x77, 0xf8, 0xd8, 0xf1, 0xc5, 0x01, 0x25, 0xa2, 0xa6, 0x0c, 0x6d, 0xc5, 0x88, 0xd2, 0xb3,
-	0x4d, 0x44, 0xd4, 0x25, 0x53, 0x92, 0x36, 0xa2, 0x94, 0x22, 0x24, 0x82, 0x2b, 0xc3, 0xf7, 0xb9,
-	0x01, 0x6b, 0x53, 0xfe, 0xa0, 0xde, 0x99, 0x78, 0x60, 0x3a, 0xb6, 0x09, 0x7c, 0x97, 0xfc, 0xdd,
-	0xfc, 0x7f, 0x0c, 0xdf, 0xae, 0xfd, 0x4e, 0xfe, 0x31, 0x12, 0x47, 0x9d, 0x48, 0xb2, 0xbf, 0x8f,
-	0xcd, 0x1f, 0x18, 0x50, 0x29, 0x8e, 0x1d, 0xf5, 0x62, 0xee, 0xc9, 0xef, 0x5b, 0xbb, 0xb3, 0xf7,
-	0x25, 0x92, 0x5d, 0x86, 0x64, 0xcb, 0xae, 0xe7, 0x52, 0x13, 0x63, 0x56, 0xa3, 0xdc, 0xfc, 0xad,
-	0x01, 0xd6, 0x8c, 0x31, 0xa4, 0x18, 0x36, 0xd3, 0x59, 0xad, 0x83, 0x2b, 0xb3, 0x4a, 0x90, 0x07,
-	0x0c, 0xe4, 0x7b, 0xf6, 0xbb, 0x39, 0x73, 0xb1, 0x73, 0x0e, 0x6d, 0x0a, 0xc7, 0x0d, 0x21, 0x12,
-	0x47, 0x5b, 0xdf, 0x7e, 0xf5, 0xba, 0x6e, 0x7c, 0xf1, 0xba, 0x6e, 0xfc, 0xf3, 0x75, 0xdd, 0xf8,
-	0xc9, 0x9b, 0xfa, 0xb5, 0x2f, 0xde, 0xd4, 0xaf, 0xfd, 0xed, 0x4d, 0xfd, 0xda, 0x77, 0x3e, 0x50,
-	0x86, 0xcc, 0xaf, 0x73, 0x71, 0xfb, 0x7c, 0x6c, 0x2d, 0x2e, 0x07, 0x89, 0x3f, 0x8c, 0x50, 0xf3,
-	0x5c, 0x6a, 0x65, 0x13, 0x68, 0xef, 0x3a, 0x9b, 0xac, 0xfe, 0xff, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc8, 0xc6, 0x70, 0xc5, 0x9e, 0x19, 0x00, 0x00,
+	// 2317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x9f, 0x8e, 0x9d, 0x0f, 0x3f, 0x27, 0x93, 0x49, 0x4f, 0x36, 0xe3, 0x74, 0x12, 0x27, 0xe9,
+	0xd9, 0x4c, 0x32, 0xb3, 0xc4, 0x9e, 0x84, 0x03, 0x42, 0x2b, 0xb1, 0x4a, 0x3c, 0x19, 0xd6, 0x82,
+	0xcc, 0x22, 0x27, 0xac, 0x04, 0x42, 0x6a, 0xb5, 0xbb, 0x2b, 0xed, 0x66, 0xda, 0x5d, 0xa6, 0xbb,
+	0x9c, 0x49, 0x2e, 0x2b, 0xc1, 0x0d, 0x2d, 0x07, 0x3e, 0x2e, 0x20, 0x2d, 0x12, 0x07, 0x4e, 0x48,
+	0x88, 0x0b, 0x27, 0xee, 0x48, 0x23, 0x0e, 0x68, 0x25, 0x0e, 0x20, 0x90, 0x16, 0x34, 0xc3, 0x81,
+	0x3f, 0x03, 0xd5, 0x47, 0x97, 0xab, 0xdb, 0x6d, 0xc7, 0xac, 0x86, 0x53, 0x5c, 0xaf, 0x5e, 0xbd,
+	0xf7, 0xab, 0x57, 0xef, 0xb3, 0x03, 0x6f, 0x79, 0x91, 0x7d, 0xe9, 0x93, 0xeb, 0xfa, 0xe5, 0x41,
+	0xbd, 0x1b, 0x7b, 0x71, 0xad, 0x17, 0x61, 0x82, 0x75, 0x10, 0xe4, 0xda, 0xe5, 0x81, 0x51, 0x75,
+	0x70, 0xdc, 0xc5, 0x71, 0xbd, 0x6d, 0xc7, 0xa8, 0x7e, 0x79, 0xd0, 0x46, 0xc4, 0x3e, 0xa8, 0x3b,
+	0xd8, 0x0f, 0x39, 0xaf, 0xb1, 0xec, 0x61, 0x0f, 0xb3, 0x9f, 0x75, 0xfa, 0x4b, 0x50, 0xd7, 0x3d,
+	0x8c, 0xbd, 0x00, 0xd5, 0xed, 0x9e, 0x5f, 0xb7, 0xc3, 0x10, 0x13, 0x9b, 0xf8, 0x38, 0x14, 0xf2,
+	0x8d, 0x15, 0x45, 0x2d, 0xb9, 0xee, 0xa1, 0x84, 0xbe, 0x2a, 0x4e, 0xb1, 0x55, 0xbb, 0x7f, 0x51,
+	0xb7, 0xc3, 0xeb, 0x64, 0x8b, 0xc3, 0xb0, 0xb8, 0x26, 0xbe, 0xe0, 0x5b, 0xe6, 0x47, 0xb0, 0x7a,
+	0x1a, 0x7b, 0x67, 0x88, 0x7c, 0x10, 0x39, 0x1d, 0x14, 0x93, 0xc8, 0x26, 0x38, 0x3a, 0x72, 0xdd,
+	0x08, 0xc5, 0xb1, 0xbe, 0x0e, 0xa5, 0x4b, 0x3b, 0xf0, 0x5d, 0x4a, 0xab, 0x68, 0x5b, 0xda, 0x5e,
+	0xa9, 0x35, 0x20, 0xe8, 0x26, 0xcc, 0x63, 0xe5, 0x50, 0x65, 0x8a, 0x31, 0xa4, 0x68, 0xfa, 0x26,
+	0x94, 0x11, 0xe9, 0x58, 0x36, 0x17, 0x58, 0x29, 0x30, 0x16, 0x40, 0xa4, 0x23, 0x54, 0x98, 0xf7,
+	0x61, 0x7b, 0xa4, 0xfe, 0x16, 0x8a, 0x7b, 0x38, 0x8c, 0x91, 0xf9, 0xb1, 0x06, 0x77, 0x4e, 0x63,
+	0xef, 0x43, 0x3b, 0x88, 0x11, 0x69, 0xe0, 0xf0, 0xc2, 0x8f, 0xba, 0xfa, 0x32, 0x4c, 0x87, 0x38,
+	0x74, 0x10, 0x03, 0x56, 0x6c, 0xf1, 0xc5, 0x1b, 0x01, 0x45, 0xef, 0x1d, 0xfb, 0x5e, 0x68, 0x93,
+	0x7e, 0x84, 0x2a, 0x45, 0x7e, 0x6f, 0x49, 0x30, 0x0d, 0xa8, 0x64, 0xc1, 0x48, 0xa4, 0x7f, 0xd0,
+	0x60, 0x9e, 0xdd, 0x27, 0x74, 0xcf, 0xf1, 0x09, 0xe9, 0xe8, 0x2b, 0x30, 0x13, 0xa3, 0xd0, 0x45,
+	0x89, 0xfd, 0xc4, 0x4a, 0x5f, 0x85, 0x39, 0x8a, 0xc1, 0x45, 0x31, 0x11, 0x18, 0x67, 0x11, 0xe9,
+	0x3c, 0x41, 0x31, 0xd1, 0xbf, 0x04, 0x33, 0x76, 0x17, 0xf7, 0x43, 0xc2, 0x90, 0x95, 0x0f, 0x57,
+	0x6b, 0xe2, 0xc5, 0xa8, 0x17, 0xd5, 0x84, 0x17, 0xd5, 0x1a, 0xd8, 0x0f, 0x8f, 0x8b, 0x2f, 0x3f,
+	0xdb, 0xbc, 0xd5, 0x12, 0xec, 0xfa, 0x57, 0x00, 0xda, 0x91, 0xef, 0x7a, 0xc8, 0xba, 0x40, 0x1c,
+	0xf7, 0x04, 0x87, 0x4b, 0xfc, 0xc8, 0x53, 0x84, 0xcc, 0x15, 0x58, 0x56, 0xb1, 0xcb, 0x4b, 0xbd,
+	0x07, 0x8b, 0xa7, 0xb1, 0xd7, 0x42, 0xdf, 0xeb, 0xa3, 0x98, 0x1c, 0xdb, 0xc4, 0x19, 0x7d, 0xad,
+	0x65, 0x98, 0x76, 0x51, 0x88, 0xbb, 0xe2, 0x4e, 0x7c, 0x61, 0xae, 0xc2, 0xbd, 0x8c, 0x00, 0x29,
+	0xfb, 0x77, 0x1a, 0x13, 0x2e, 0xec, 0xc8, 0x85, 0xe7, 0xbf, 0xec, 0x0e, 0xdc, 0x26, 0xf8, 0x39,
+	0x0a, 0x2d, 0x07, 0x87, 0x24, 0xb2, 0x9d, 0xc4, 0x6e, 0x0b, 0x8c, 0xda, 0x10, 0x44, 0x7d, 0x03,
+	0xe8, 0x4b, 0x5a, 0xf4, 0xb9, 0x50, 0x24, 0xde, 0xb6, 0x84, 0x48, 0xe7, 0x8c, 0x11, 0x86, 0xfc,
+	0xa3, 0x98, 0xe3, 0x1f, 0xa9, 0xe7, 0x9f, 0xce, 0x3e, 0x3f, 0xbf, 0x8c, 0x0a, 0x58, 0x5e, 0xe6,
+	0xcf, 0x1a, 0xdc, 0x1d, 0xec, 0x7d, 0x1d, 0x7b, 0xbe, 0xd3, 0xb0, 0x83, 0x40, 0xdf, 0x85, 0x45,
+	0x3f, 0x14, 0x81, 0xe3, 0xe3, 0xd0, 0xf2, 0x5d, 0x61, 0xb6, 0xdb, 0x2a, 0xb9, 0xe9, 0xea, 0xfb,
+	0xa0, 0xa7, 0x18, 0xb9, 0x19, 0xa6, 0x98, 0x19, 0x96, 0xd4, 0x9d, 0x67, 0xcc, 0x24, 0xff, 0xf7,
+	0xbb, 0x6e, 0xc0, 0x5a, 0xce, 0x7d, 0xe4, 0x7d, 0x5f, 0x16, 0x14, 0x8f, 0x69, 0x30, 0x3f, 0x6b,
+	0x04, 0xb6, 0xdf, 0x65, 0x11, 0x76, 0x89, 0x42, 0x62, 0xa9, 0xef, 0x08, 0x8c, 0xc4, 0x91, 0x6f,
+	0xc3, 0x7c, 0x3b, 0xc0, 0xce, 0x73, 0xab, 0x83, 0x7c, 0xaf, 0x43, 0xc4, 0x15, 0xcb, 0x8c, 0xf6,
+	0x3e, 0x23, 0xe5, 0xbc, 0x77, 0x21, 0xef, 0xbd, 0x9f, 0xca, 0x68, 0x61, 0xd7, 0x3b, 0xae, 0x51,
+	0xaf, 0xfe, 0xfb, 0x67, 0x9b, 0x0f, 0x3c, 0x9f, 0x74, 0xfa, 0xed, 0x9a, 0x83, 0xbb, 0x22, 0xe3,
+	0x89, 0x3f, 0xfb, 0xb1, 0xfb, 0x5c, 0x24, 0xce, 0x66, 0x48, 0x64, 0xf0, 0xec, 0xc2, 0x22, 0x22,
+	0x1d, 0x14, 0xa1, 0x7e, 0xd7, 0x12, 0xae, 0xcd, 0xcd, 0x71, 0x3b, 0x21, 0x9f, 0x71, 0x17, 0xdf,
+	0x85, 0x45, 0x91, 0x4e, 0x23, 0xe4, 0x20, 0xff, 0x12, 0x45, 0x95, 0x19, 0xce, 0xc8, 0xc9, 0x2d,
+	0x41, 0x1d, 0x32, 0xff, 0x6c, 0x8e, 0xf9, 0x37, 0x00, 0xf8, 0x25, 0x43, 0xbb, 0x8b, 0x2a, 0x73,
+	0xdc, 0xfe, 0x8c, 0xf2, 0xcc, 0xee, 0x32, 0x33, 0xf1, 0xed, 0xf8, 0xba, 0xdb, 0xc6, 0x41, 0xa5,
+	0xc4, 0x18, 0xca, 0x8c, 0x76, 0xc6, 0x48, 0x03, 0x33, 0xb9, 0xc8, 0xf1, 0xbb, 0x76, 0x10, 0x57,
+	0x60, 0x4b, 0xdb, 0x5b, 0x10, 0x66, 0x7a, 0x22, 0x88, 0x7a, 0x95, 0xe7, 0x3c, 0x72, 0x65, 0x75,
+	0xec, 0xb8, 0x53, 0x29, 0x4b, 0x5f, 0x39, 0xbf, 0x7a, 0xdf, 0x8e, 0x3b, 0x66, 0x15, 0xd6, 0xf3,
+	0x5e, 0x52, 0x3e, 0xb5, 0xc3, 0xea, 0xc4, 0xc9, 0x15, 0x72, 0xfa, 0x04, 0x35, 0xdb, 0xce, 0x51,
+	0x9f, 0xe0, 0xa7, 0x38, 0x7a, 0x61, 0x47, 0x6e, 0xac, 0x3f, 0x82, 0xa5, 0x0b, 0xf1, 0xdb, 0x22,
+	0xd8, 0x72, 0x02, 0x64, 0x47, 0xe2, 0xd1, 0x17, 0x93, 0x8d, 0x73, 0xdc, 0xa0, 0x64, 0xdd, 0x80,
+	0x39, 0xc4, 0xa4, 0xc8, 0xe4, 0x2c, 0xd7, 0xa2, 0x18, 0xe4, 0x2b, 0x91, 0x48, 0xfe, 0xa3, 0xc1,
+	0xca, 0x69, 0xec, 0xb1, 0xc8, 0x93, 0xb9, 0xea, 0xcd, 0xb9, 0xdd, 0x26, 0x94, 0xdb, 0x54, 0xb4,
+	0x90, 0x51, 0xe0, 0x32, 0x18, 0xe9, 0xd9, 0x88, 0x3c, 0x54, 0xcc, 0xf3, 0xcb, 0xec, 0xeb, 0x4f,
+	0xe7, 0xbc, 0x7e, 0xe6, 0x51, 0x66, 0xb2, 0x8f, 0xb2, 0x05, 0xd5, 0xfc, 0x9b, 0x4a, 0x63, 0xfc,
+	0x64, 0x0a, 0xde, 0xa2, 0x26, 0x6b, 0x35, 0x0e, 0x1f, 0x3f, 0x41, 0xbd, 0x00, 0x5f, 0x23, 0xf7,
+	0xcd, 0xd9, 0x62, 0x1b, 0xe6, 0x85, 0xab, 0xf3, 0xa4, 0xce, 0x03, 0xb0, 0xcc, 0x69, 0x4f, 0x28,
+	0x69, 0x52, 0x6b, 0xe8, 0x50, 0x64, 0x1e, 0xce, 0xad, 0xc0, 0x7e, 0xb3, 0x1a, 0xc2, 0xdd, 0x7a,
+	0x46, 0xd4, 0x10, 0xb6, 0xa2, 0x1e, 0x22, 0x7d, 0x79, 0x96, 0x81, 0x92, 0xeb, 0x21, 0xab, 0xce,
+	0x0d, 0x5b, 0xd5, 0xdc, 0x84, 0x8d, 0x5c, 0x93, 0x48, 0xa3, 0xfd, 0x43, 0x63, 0xce, 0x2c, 0xf3,
+	0x99, 0x70, 0xb8, 0x37, 0x68, 0xb8, 0x9c, 0x84, 0x4f, 0x6d, 0x37, 0x3f, 0x61, 0xc2, 0x2f, 0x8e,
+	0x4a, 0xf8, 0x13, 0x38, 0x95, 0x08, 0xa2, 0xfc, 0xcb, 0x49, 0x13, 0xfc, 0x95, 0xfb, 0x0d, 0x6f,
+	0x62, 0xbe, 0xd9, 0x73, 0xed, 0xff, 0xe9, 0xfa, 0x97, 0xec, 0x58, 0xaa, 0x3a, 0x95, 0x39, 0x2d,
+	0xdf, 0x42, 0x85, 0x61, 0x0b, 0xbd, 0x0b, 0xb3, 0x5d, 0xd4, 0x6d, 0xa3, 0x28, 0xae, 0x14, 0xb7,
+	0x0a, 0x7b, 0xe5, 0xc3, 0xb5, 0xda, 0xa0, 0x6f, 0xae, 0x1d, 0xb3, 0x9e, 0xe4, 0xc3, 0xa4, 0xd5,
+	0x14, 0xad, 0x4a, 0x72, 0x42, 0x3f, 0x83, 0x85, 0x08, 0xd1, 0xac, 0x60, 0x89, 0xd4, 0x3f, 0xfd,
+	0xb9, 0x52, 0xff, 0x3c, 0x17, 0x72, 0xc4, 0x0b, 0xc0, 0x36, 0x88, 0xb5, 0xc5, 0x5c, 0x57, 0x38,
+	0x65, 0x99, 0xd3, 0xce, 0x29, 0x69, 0x92, 0x8c, 0x2e, 0xbc, 0x6f, 0xd8, 0xb0, 0xd2, 0xf4, 0x67,
+	0xa0, 0xd3, 0x9a, 0x6a, 0x87, 0x0e, 0x0a, 0x06, 0x7d, 0x22, 0x8d, 0xa3, 0xc8, 0x0e, 0x63, 0xdb,
+	0x51, 0x3b, 0x84, 0x62, 0x6b, 0x41, 0xa1, 0x36, 0x5d, 0xa5, 0xef, 0x9a, 0x52, 0xfb, 0x2e, 0x73,
+	0x1d, 0x8c, 0x61, 0xa1, 0x52, 0xe5, 0x2f, 0x34, 0x06, 0xea, 0xac, 0xdf, 0xee, 0xfa, 0xe4, 0xd8,
+	0x76, 0xcf, 0x92, 0x02, 0x7f, 0x72, 0xe9, 0xbb, 0x88, 0xbe, 0xd8, 0x31, 0xcc, 0xc6, 0xfd, 0xf6,
+	0x77, 0x91, 0x43, 0x98, 0xde, 0xf2, 0xe1, 0x72, 0x8d, 0x8f, 0x13, 0xb5, 0x64, 0x9c, 0xa8, 0x1d,
+	0x85, 0xd7, 0xc7, 0xfa, 0x9f, 0x7e, 0xbf, 0x7f, 0xfb, 0x24, 0xa9, 0x87, 0xb4, 0xcb, 0x70, 0x5b,
+	0xc9, 0xc1, 0x74, 0x2b, 0x31, 0x95, 0x69, 0x25, 0x14, 0xe4, 0x85, 0x14, 0xf2, 0x5d, 0xd8, 0x19,
+	0x0b, 0x4d, 0x5e, 0xe2, 0x8f, 0xa2, 0x53, 0xa4, 0xc6, 0x3c, 0xf2, 0x23, 0x37, 0xc2, 0x3d, 0xbd,
+	0x02, 0xb3, 0x0e, 0x5d, 0xcb, 0x3e, 0x34, 0x59, 0xd2, 0xc2, 0x6a, 0x73, 0x26, 0x6a, 0x4b, 0xee,
+	0xa3, 0x25, 0x41, 0x69, 0xba, 0xba, 0xa3, 0xf4, 0xd8, 0x85, 0xf1, 0x6d, 0xf2, 0x63, 0xea, 0x55,
+	0xbf, 0xf9, 0xe7, 0xe6, 0xde, 0x04, 0x5e, 0x45, 0x0f, 0xc4, 0xb2, 0xa5, 0x58, 0x86, 0xe9, 0x5e,
+	0x84, 0xf1, 0x05, 0xf3, 0xf0, 0x52, 0x8b, 0x2f, 0x92, 0xfe, 0x51, 0xb9, 0x86, 0xbc, 0xe2, 0x29,
+	0xdc, 0x3b, 0xa1, 0x81, 0x46, 0xc7, 0xa1, 0x1e, 0x4a, 0x8d, 0x62, 0x15, 0x1a, 0x2f, 0x71, 0x6c,
+	0x7b, 0x28, 0xb9, 0xa9, 0x58, 0xd2, 0x9d, 0x64, 0x92, 0x11, 0x83, 0x84, 0x58, 0x9a, 0x0d, 0x78,
+	0x8b, 0x89, 0x4b, 0x8d, 0x2a, 0x5f, 0x43, 0xd7, 0x63, 0x84, 0xdd, 0x81, 0xc2, 0x73, 0x74, 0x2d,
+	0x04, 0xd1, 0x9f, 0xe6, 0x33, 0x58, 0x62, 0x42, 0x58, 0x15, 0x6a, 0x44, 0x88, 0x3a, 0xf4, 0x18,
+	0x01, 0x99, 0xf2, 0xc9, 0x05, 0x29, 0xe5, 0xd3, 0xfc, 0x0e, 0x2c, 0x2b, 0xf2, 0x26, 0xc1, 0xf4,
+	0x08, 0x96, 0xb8, 0x48, 0x87, 0x73, 0x5b, 0x03, 0x84, 0x8b, 0xed, 0xb4, 0x14, 0xf3, 0x31, 0x54,
+	0x06, 0xd2, 0x33, 0xdd, 0x41, 0x6a, 0xac, 0x28, 0x89, 0xb1, 0xc2, 0x0c, 0x00, 0xd8, 0x09, 0xce,
+	0x33, 0x1a, 0xc5, 0x06, 0x00, 0xf3, 0x2d, 0x5e, 0xaa, 0x85, 0x7b, 0x33, 0x0a, 0x2d, 0xd5, 0x34,
+	0x7e, 0x6d, 0x42, 0x50, 0x4c, 0x52, 0x09, 0xbf, 0xd4, 0x5a, 0x50, 0xa8, 0x4d, 0xd7, 0xfc, 0x44,
+	0x83, 0x55, 0x01, 0x30, 0x27, 0x0a, 0x6f, 0xb0, 0x81, 0x6b, 0x25, 0xdd, 0xbe, 0x1a, 0x63, 0x8b,
+	0x6d, 0xdb, 0x3d, 0xe1, 0x3d, 0x3f, 0x8f, 0xb4, 0x2f, 0xc3, 0xea, 0x10, 0xaf, 0x95, 0x44, 0x37,
+	0x47, 0xb5, 0x92, 0x39, 0x73, 0xc6, 0x77, 0xcd, 0x13, 0xe1, 0x80, 0x39, 0xfd, 0xc4, 0x32, 0x4c,
+	0xf3, 0xbc, 0x28, 0xac, 0xc7, 0x16, 0x03, 0x9b, 0x4e, 0xa9, 0x36, 0xed, 0xc0, 0x72, 0x4a, 0x4c,
+	0x84, 0x1c, 0xe6, 0x36, 0x72, 0x3a, 0xd4, 0x94, 0xe9, 0x50, 0x5f, 0x83, 0x12, 0x0e, 0x92, 0xac,
+	0x2b, 0x5a, 0x42, 0x1c, 0x88, 0x94, 0x4b, 0x47, 0x9c, 0xd0, 0x55, 0x0b, 0x09, 0xed, 0x90, 0x42,
+	0x97, 0x97, 0x11, 0x33, 0x84, 0xf5, 0x81, 0xa6, 0x53, 0xdf, 0x8b, 0x98, 0xa1, 0x1b, 0xb8, 0xdb,
+	0x0b, 0xd0, 0xe7, 0xd4, 0xb8, 0x06, 0xa5, 0x10, 0xbd, 0x10, 0x9b, 0x5c, 0xe1, 0x5c, 0x88, 0x5e,
+	0xb0, 0x4d, 0xb3, 0x0e, 0xf7, 0x94, 0x90, 0x4a, 0x15, 0xce, 0x7c, 0xf7, 0xfa, 0xb5, 0x06, 0x06,
+	0x3b, 0x71, 0xda, 0x0f, 0x88, 0x1f, 0xfb, 0x1e, 0x3f, 0x23, 0x66, 0x61, 0xda, 0x28, 0x88, 0x91,
+	0x5d, 0xf6, 0x4f, 0x62, 0x32, 0xe4, 0x64, 0xd9, 0x40, 0x3d, 0x18, 0x30, 0x76, 0x6c, 0x3f, 0x4c,
+	0x92, 0x5a, 0xa9, 0xb5, 0x20, 0x18, 0x29, 0xb5, 0xe9, 0xd2, 0xf8, 0xeb, 0x0a, 0x4d, 0x03, 0x27,
+	0x84, 0x84, 0xd4, 0x74, 0x07, 0x30, 0x8b, 0x2a, 0xcc, 0x5f, 0x69, 0x50, 0x65, 0x30, 0x3f, 0xe8,
+	0x13, 0x0f, 0xfb, 0xe1, 0xa0, 0x7f, 0xe0, 0x35, 0x05, 0xb9, 0xfa, 0xbb, 0x60, 0x04, 0x94, 0x68,
+	0x39, 0x76, 0x10, 0x58, 0xf9, 0xf3, 0xec, 0xbd, 0x20, 0x39, 0xd6, 0x4c, 0xf7, 0x39, 0x47, 0xb0,
+	0x31, 0xea, 0xb0, 0xea, 0x3f, 0x46, 0xee, 0x79, 0x9e, 0x38, 0x9e, 0xc2, 0x0a, 0x4f, 0x8e, 0xd2,
+	0x69, 0x03, 0x3b, 0xee, 0xf8, 0xa1, 0x47, 0x9b, 0x4b, 0x9a, 0x7e, 0x05, 0x06, 0xf6, 0x7b, 0x4c,
+	0x56, 0x3c, 0x86, 0xa5, 0xd4, 0x4d, 0xcf, 0xaf, 0x9a, 0xe3, 0x12, 0xda, 0x5d, 0x98, 0x26, 0x57,
+	0x03, 0x73, 0x17, 0xc9, 0x55, 0xd3, 0x35, 0x2f, 0xe0, 0x2e, 0x93, 0x21, 0x12, 0x38, 0x73, 0x00,
+	0xe4, 0x66, 0x8a, 0x0e, 0x17, 0xa4, 0x14, 0x1d, 0xa5, 0x5a, 0x4d, 0xa5, 0xab, 0xd5, 0x4a, 0xea,
+	0x93, 0x4f, 0x29, 0xa9, 0x20, 0xe6, 0x37, 0xd2, 0x7a, 0x4e, 0xae, 0x7a, 0x7e, 0x74, 0xb3, 0x1e,
+	0x03, 0xe6, 0x22, 0x44, 0xfa, 0x51, 0x88, 0x12, 0xd4, 0x72, 0x7d, 0xf8, 0xf3, 0x3b, 0x50, 0x38,
+	0x8d, 0x3d, 0xfd, 0x05, 0x2c, 0xa4, 0x3f, 0xa7, 0xad, 0xab, 0xfd, 0x57, 0xf6, 0xfb, 0x96, 0xf1,
+	0xf6, 0xb8, 0x5d, 0x59, 0xbf, 0xcc, 0x1f, 0xfc, 0xe5, 0xdf, 0x3f, 0x9b, 0x5a, 0x37, 0x8d, 0xba,
+	0xf2, 0x8d, 0x52, 0x34, 0x8b, 0x22, 0x79, 0xeb, 0x1d, 0x28, 0x0d, 0xba, 0x9e, 0x4a, 0x46, 0xac,
+	0xdc, 0x31, 0xb6, 0x46, 0xed, 0x48, 0x65, 0x9b, 0x4c, 0xd9, 0xaa, 0x79, 0x4f, 0x55, 0x46, 0x9b,
+	0x0a, 0x3a, 0x82, 0x22, 0xd2, 0xd1, 0x63, 0x98, 0x4f, 0x7d, 0xb3, 0x5a, 0xcb, 0x88, 0x54, 0x37,
+	0x8d, 0xfb, 0x63, 0x36, 0xa5, 0xca, 0x6d, 0xa6, 0x72, 0xcd, 0x5c, 0x55, 0x55, 0x46, 0x9c, 0xd3,
+	0x62, 0xa5, 0x88, 0x2a, 0x4d, 0x7d, 0xcb, 0xca, 0x2a, 0x55, 0x37, 0x8d, 0xfb, 0x63, 0x36, 0xc7,
+	0x2b, 0x4d, 0x4a, 0x21, 0x57, 0xfa, 0x11, 0xdc, 0x19, 0xfa, 0xe6, 0xb4, 0x99, 0x2f, 0x5b, 0x32,
+	0x18, 0xbb, 0x37, 0x30, 0x48, 0x00, 0x5b, 0x0c, 0x80, 0x61, 0x56, 0x86, 0x00, 0x74, 0x2d, 0x16,
+	0xaf, 0xfa, 0x0f, 0x35, 0x58, 0x1a, 0xfe, 0x08, 0x94, 0xff, 0x84, 0x0a, 0x87, 0xb1, 0x77, 0x13,
+	0x87, 0xc4, 0xb0, 0xc7, 0x30, 0x98, 0xe6, 0x56, 0xde, 0x63, 0x8b, 0x19, 0x95, 0x05, 0x93, 0xfe,
+	0x4b, 0x0d, 0x56, 0x46, 0x7c, 0xa6, 0xd8, 0xc9, 0xa8, 0xcb, 0x67, 0x33, 0xf6, 0x27, 0x62, 0x93,
+	0xd0, 0xf6, 0x19, 0xb4, 0x5d, 0x73, 0x47, 0x85, 0xc6, 0x3f, 0x69, 0x20, 0xcb, 0x6f, 0x3b, 0x96,
+	0xdd, 0x27, 0xd8, 0x4a, 0x3e, 0x83, 0xe8, 0x3f, 0xd5, 0xe0, 0x6e, 0x5e, 0x77, 0x62, 0x66, 0xb4,
+	0xe6, 0xf0, 0x18, 0x8f, 0x6e, 0xe6, 0x91, 0xb0, 0xde, 0x61, 0xb0, 0x76, 0xcc, 0xfb, 0x2a, 0x2c,
+	0xde, 0x47, 0x29, 0x41, 0x22, 0x8c, 0xf6, 0xb1, 0x06, 0x4b, 0x6a, 0x49, 0xe3, 0x90, 0xb6, 0x73,
+	0x83, 0x5e, 0x2d, 0x7a, 0xc6, 0xc3, 0x1b, 0x59, 0xc6, 0x3f, 0xa1, 0x48, 0x0e, 0x7d, 0x7e, 0x40,
+	0xa0, 0xf9, 0x91, 0x06, 0x7a, 0x4e, 0x07, 0x92, 0x85, 0x33, 0xcc, 0x62, 0x3c, 0xbc, 0x91, 0x65,
+	0x3c, 0x1c, 0x14, 0x39, 0x87, 0x8f, 0x2d, 0x57, 0x1c, 0x50, 0x3c, 0x6a, 0xc4, 0xb7, 0x82, 0xac,
+	0x47, 0xe5, 0xb3, 0x19, 0xfb, 0x13, 0xb1, 0x8d, 0xf7, 0x28, 0xa5, 0x7c, 0x0a, 0xe7, 0x4a, 0xf0,
+	0x7d, 0xa2, 0xc1, 0xca, 0x88, 0x7f, 0xe0, 0xec, 0x0c, 0x05, 0x58, 0x1e, 0x9b, 0xb1, 0x3f, 0x11,
+	0x9b, 0xc4, 0xf7, 0x05, 0x86, 0xef, 0x81, 0xf9, 0x76, 0x3a, 0x18, 0x89, 0xa5, 0x0e, 0xc2, 0xc9,
+	0xbf, 0x57, 0xf4, 0xef, 0x6b, 0xb0, 0x98, 0x9d, 0x76, 0xab, 0xd9, 0xdc, 0x93, 0xde, 0x37, 0x1e,
+	0x8c, 0xdf, 0x97, 0x48, 0x1e, 0x30, 0x24, 0x5b, 0x66, 0x35, 0x95, 0x9a, 0x18, 0xb3, 0xea, 0xe5,
+	0xfa, 0x6f, 0x35, 0x30, 0xc6, 0x4c, 0xbf, 0x59, 0xb7, 0x19, 0xcd, 0x6a, 0x1c, 0x4c, 0xcc, 0x2a,
+	0x41, 0x1e, 0x30, 0x90, 0xef, 0x98, 0x0f, 0x53, 0xe6, 0x62, 0xe7, 0x2c, 0xda, 0xa8, 0x0f, 0x9a,
+	0x74, 0x94, 0x00, 0xa2, 0x55, 0x44, 0x9d, 0x73, 0x87, 0xaa, 0x88, 0xb2, 0x69, 0xdc, 0x1f, 0xb3,
+	0x79, 0x43, 0x15, 0xa1, 0x9c, 0x96, 0x68, 0x1c, 0x8e, 0xbf, 0xf5, 0xf2, 0x55, 0x55, 0xfb, 0xf4,
+	0x55, 0x55, 0xfb, 0xd7, 0xab, 0xaa, 0xf6, 0xe3, 0xd7, 0xd5, 0x5b, 0x9f, 0xbe, 0xae, 0xde, 0xfa,
+	0xdb, 0xeb, 0xea, 0xad, 0x6f, 0xbf, 0xa7, 0x8c, 0xbe, 0x5f, 0xe5, 0xc7, 0xf7, 0xf9, 0x27, 0x9a,
+	0xec, 0xb2, 0x8b, 0xdd, 0x7e, 0x80, 0xea, 0x57, 0x52, 0x0b, 0x9b, 0x8b, 0xdb, 0x33, 0xec, 0x2b,
+	0xc2, 0x17, 0xff, 0x3b, 0x00, 0x04, 0xf4, 0x42, 0x3c, 0x33, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2508,6 +2918,7 @@ type MsgClient interface {
 	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error)
 }
 
 type msgClient struct {
@@ -2644,6 +3055,15 @@ func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmi
 	return out, nil
 }
 
+func (c *msgClient) ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error) {
+	out := new(MsgClaimAirdropResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/ClaimAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
@@ -2660,6 +3080,7 @@ type MsgServer interface {
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	ClaimAirdrop(context.Context, *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2708,6 +3129,9 @@ func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCanc
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
+func (*UnimplementedMsgServer) ClaimAirdrop(ctx context.Context, req *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAirdrop not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2965,6 +3389,24 @@ func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/ClaimAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAirdrop(ctx, req.(*MsgClaimAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -3025,6 +3467,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
 		},
+		{
+			MethodName: "ClaimAirdrop",
+			Handler:    _Msg_ClaimAirdrop_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -3487,6 +3933,32 @@ func (m *MsgSendToCosmosClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TokenDecimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TokenDecimals))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenSymbol) > 0 {
+		i -= len(m.TokenSymbol)
+		copy(dAtA[i:], m.TokenSymbol)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenSymbol)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TokenName) > 0 {
+		i -= len(m.TokenName)
+		copy(dAtA[i:], m.TokenName)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenName)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -3639,6 +4111,13 @@ func (m *MsgBatchSendToEthClaim) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -4094,6 +4573,87 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSetOperatorAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4397,6 +4957,94 @@ func (m *EventERC20DeployedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventERC20Deprecated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventERC20Deprecated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventERC20Deprecated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndHeight) > 0 {
+		i -= len(m.EndHeight)
+		copy(dAtA[i:], m.EndHeight)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EndHeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldToken) > 0 {
+		i -= len(m.OldToken)
+		copy(dAtA[i:], m.OldToken)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OldToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventERC20MigrationCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventERC20MigrationCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventERC20MigrationCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewToken) > 0 {
+		i -= len(m.NewToken)
+		copy(dAtA[i:], m.NewToken)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldToken) > 0 {
+		i -= len(m.OldToken)
+		copy(dAtA[i:], m.OldToken)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OldToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventValsetUpdatedClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4589,6 +5237,87 @@ func (m *EventOutgoingTxId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAirdropClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAirdropClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAirdropClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAirdropExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAirdropExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAirdropExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Returned) > 0 {
+		i -= len(m.Returned)
+		copy(dAtA[i:], m.Returned)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Returned)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -4823,6 +5552,21 @@ func (m *MsgSendToCosmosClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.TokenName)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenSymbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.TokenDecimals != 0 {
+		n += 1 + sovMsgs(uint64(m.TokenDecimals))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4883,6 +5627,10 @@ func (m *MsgBatchSendToEthClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5075,6 +5823,43 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.AirdropId != 0 {
+		n += 1 + sovMsgs(uint64(m.AirdropId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *EventSetOperatorAddress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5215,7 +6000,49 @@ func (m *EventERC20DeployedClaim) Size() (n int) {
 	return n
 }
 
-func (m *EventValsetUpdatedClaim) Size() (n int) {
+func (m *EventERC20Deprecated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OldToken)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EndHeight)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventERC20MigrationCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OldToken)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.NewToken)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValsetUpdatedClaim) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5304,6 +6131,44 @@ func (m *EventOutgoingTxId) Size() (n int) {
 	return n
 }
 
+func (m *EventAirdropClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAirdropExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Returned)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6838,6 +7703,121 @@ func (m *MsgSendToCosmosClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDecimals", wireType)
+			}
+			m.TokenDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -7210,6 +8190,38 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -8461,7 +9473,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8484,15 +9496,15 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetOperatorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8520,11 +9532,64 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8552,7 +9617,171 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetOperatorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9405,7 +10634,7 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+func (m *EventERC20Deprecated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9428,15 +10657,15 @@ func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValsetUpdatedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventERC20Deprecated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventERC20Deprecated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9464,7 +10693,71 @@ func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9487,7 +10780,7 @@ func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *EventERC20MigrationCompleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9510,15 +10803,15 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMultisigUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventERC20MigrationCompleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMultisigUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventERC20MigrationCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9546,11 +10839,11 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9578,11 +10871,11 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			m.OldToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9610,21 +10903,249 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultisigId = string(dAtA[iNdEx:postIndex])
+			m.NewToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValsetUpdatedClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMultisigUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMultisigUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
 				iNdEx++
 				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
@@ -10007,6 +11528,266 @@ func (m *EventOutgoingTxId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAirdropClaimed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAirdropClaimed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAirdropClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAirdropExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAirdropExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAirdropExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Returned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -66,3 +66,62 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 	}
 
 }
+
+func TestClaimEthTxHash(t *testing.T) {
+	claim := MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Amount:         sdk.NewInt(1),
+		EthereumSender: "0xf9613b532673Cc223aBa451dFA8539B87e1F666D",
+		CosmosReceiver: "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+		Orchestrator:   "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+	}
+	assert.NoError(t, claim.ValidateBasic())
+	withoutHash, err := claim.ClaimHash()
+	assert.NoError(t, err)
+
+	// the tx hash is part of the claim hash once set, and cleared with the rest of the claim data
+	assert.False(t, claim.HasClaimData())
+	claim.EthTxHash = "0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1"
+	assert.NoError(t, claim.ValidateBasic())
+	withHash, err := claim.ClaimHash()
+	assert.NoError(t, err)
+	assert.NotEqual(t, withoutHash, withHash)
+	assert.True(t, claim.HasClaimData())
+	cleared := claim
+	cleared.ClearClaimData()
+	clearedHash, err := cleared.ClaimHash()
+	assert.NoError(t, err)
+	assert.Equal(t, withoutHash, clearedHash)
+
+	for _, invalid := range []string{
+		"8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1",
+		"0x8F2F5B3A58E25A6E2E5B1E2FA7C6D4E1C1A6F1F3B0D8D5A0B6B0D2E7A4F3C2D1",
+		"0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1",
+		"0xzz2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1",
+	} {
+		claim.EthTxHash = invalid
+		assert.Error(t, claim.ValidateBasic(), invalid)
+	}
+
+	batchClaim := MsgBatchSendToEthClaim{
+		EventNonce:    1,
+		BlockHeight:   1,
+		BatchNonce:    1,
+		TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Orchestrator:  "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+		EthTxHash:     "0x1234",
+	}
+	assert.Error(t, batchClaim.ValidateBasic())
+
+	batchClaim.EthTxHash = ""
+	batchWithoutHash, err := batchClaim.ClaimHash()
+	assert.NoError(t, err)
+	assert.False(t, batchClaim.HasClaimData())
+	batchClaim.EthTxHash = "0x8f2f5b3a58e25a6e2e5b1e2fa7c6d4e1c1a6f1f3b0d8d5a0b6b0d2e7a4f3c2d1"
+	batchWithHash, err := batchClaim.ClaimHash()
+	assert.NoError(t, err)
+	assert.NotEqual(t, batchWithoutHash, batchWithHash)
+	assert.True(t, batchClaim.HasClaimData())
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ValidateBasic checks that the rate limit has a valid token contract and window and non negative limits
+// and circuit breaker threshold
+func (l TokenRateLimit) ValidateBasic() error {
+	if err := ValidateEthAddress(l.TokenContract); err != nil {
+		return fmt.Errorf("invalid rate limit token contract %s: %v", l.TokenContract, err)
+	}
+	if l.Window == 0 {
+		return fmt.Errorf("rate limit window for %s must be at least one block", l.TokenContract)
+	}
+	if l.InflowLimit.IsNil() || l.InflowLimit.IsNegative() {
+		return fmt.Errorf("invalid inflow limit for %s", l.TokenContract)
+	}
+	if l.OutflowLimit.IsNil() || l.OutflowLimit.IsNegative() {
+		return fmt.Errorf("invalid outflow limit for %s", l.TokenContract)
+	}
+	if l.CircuitBreakerThreshold.IsNil() || l.CircuitBreakerThreshold.IsNegative() {
+		return fmt.Errorf("invalid circuit breaker threshold for %s", l.TokenContract)
+	}
+	return nil
+}
+
+// GetRateLimit returns the rate limit configured for tokenContract, if any
+func (p Params) GetRateLimit(tokenContract EthAddress) (TokenRateLimit, bool) {
+	for _, limit := range p.RateLimits {
+		contract, err := NewEthAddress(limit.TokenContract)
+		if err == nil && *contract == tokenContract {
+			return limit, true
+		}
+	}
+	return TokenRateLimit{}, false
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxBasisPoints is one hundred percent expressed in basis points
+const MaxBasisPoints = 10000
+
+// ValidateBasic checks that the chain fee does not exceed the transfer amount and that the flat fee is a valid coin
+func (f ChainFee) ValidateBasic() error {
+	if f.BasisPoints > MaxBasisPoints {
+		return fmt.Errorf("chain fee of %d basis points exceeds %d", f.BasisPoints, MaxBasisPoints)
+	}
+	// an unset flat fee is allowed, it disables the flat part of the chain fee
+	if f.FlatFee.Denom == "" && (f.FlatFee.Amount.IsNil() || f.FlatFee.Amount.IsZero()) {
+		return nil
+	}
+	if err := f.FlatFee.Validate(); err != nil {
+		return fmt.Errorf("invalid chain flat fee: %v", err)
+	}
+	return nil
+}
+
+// Compute returns the chain fee charged for sending amount to Ethereum
+func (f ChainFee) Compute(amount sdk.Coin) sdk.Coins {
+	fee := sdk.NewCoins()
+	if f.BasisPoints > 0 {
+		bps := amount.Amount.MulRaw(int64(f.BasisPoints)).QuoRaw(MaxBasisPoints)
+		fee = fee.Add(sdk.NewCoin(amount.Denom, bps))
+	}
+	if f.FlatFee.Denom != "" && f.FlatFee.Amount.IsPositive() {
+		fee = fee.Add(f.FlatFee)
+	}
+	return fee
+}
+
+// ValidateBasic checks that the minimum has a valid token contract and a non negative amount
+func (m MinTransferAmount) ValidateBasic() error {
+	if err := ValidateEthAddress(m.TokenContract); err != nil {
+		return fmt.Errorf("invalid min transfer amount token contract %s: %v", m.TokenContract, err)
+	}
+	if m.Amount.IsNil() || m.Amount.IsNegative() {
+		return fmt.Errorf("invalid min transfer amount for %s", m.TokenContract)
+	}
+	return nil
+}
+
+// MinTransferAmount returns the smallest amount of tokenContract which may be sent to Ethereum, zero if
+// governance has not set a minimum
+func (p Params) MinTransferAmount(tokenContract EthAddress) sdk.Int {
+	for _, min := range p.MinTransferAmounts {
+		contract, err := NewEthAddress(min.TokenContract)
+		if err == nil && *contract == tokenContract {
+			return min.Amount
+		}
+	}
+	return sdk.ZeroInt()
+}
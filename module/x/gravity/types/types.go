@@ -1,7 +1,6 @@
 package types
 
 import (
-	"crypto/md5"
 	"encoding/binary"
 	fmt "fmt"
 	"strconv"
@@ -93,14 +92,6 @@ func GetNativePrefixedAccAddress(ctx sdk.Context, bech32IbcKeeper bech32ibckeepe
 	return sdk.AccAddressFromBech32(nativeStr)
 }
 
-// Hashing string using cryptographic MD5 function
-// returns 128bit(16byte) value
-func HashString(input string) []byte {
-	md5 := md5.New()
-	md5.Write([]byte(input))
-	return md5.Sum(nil)
-}
-
 func AppendBytes(args ...[]byte) []byte {
 	length := 0
 	for _, v := range args {
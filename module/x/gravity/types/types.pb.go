@@ -7,8 +7,8 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types1 "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -352,6 +352,187 @@ func (m *AirdropProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AirdropProposal proto.InternalMessageInfo
 
+// AirdropV2Proposal distributes tokens from the Community Pool in one or more denoms. Recipients are either listed
+// as bech32 addresses, each with the coins they receive, and paid when the proposal passes, or committed to by the
+// Merkle root of the recipient list, in which case the total is set aside when the proposal passes and each
+// recipient claims their share with MsgClaimAirdrop before the claim window closes. Unclaimed funds are returned
+// to the Community Pool. If the Community Pool can not fund the airdrop nothing will occur
+type AirdropV2Proposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// recipients paid when the proposal passes, must be empty if merkle_root is set
+	Recipients []AirdropRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
+	// hex encoded root of the Merkle tree of recipients, see AirdropLeafHash
+	MerkleRoot string `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// the sum of every leaf in the Merkle tree, set aside when the proposal passes
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// the number of blocks recipients have to claim from a Merkle airdrop
+	ClaimWindow uint64 `protobuf:"varint,6,opt,name=claim_window,json=claimWindow,proto3" json:"claim_window,omitempty"`
+}
+
+func (m *AirdropV2Proposal) Reset()      { *m = AirdropV2Proposal{} }
+func (*AirdropV2Proposal) ProtoMessage() {}
+func (*AirdropV2Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{6}
+}
+func (m *AirdropV2Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropV2Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropV2Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirdropV2Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropV2Proposal.Merge(m, src)
+}
+func (m *AirdropV2Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropV2Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropV2Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropV2Proposal proto.InternalMessageInfo
+
+// AirdropRecipient is a single recipient of an AirdropV2Proposal
+type AirdropRecipient struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *AirdropRecipient) Reset()         { *m = AirdropRecipient{} }
+func (m *AirdropRecipient) String() string { return proto.CompactTextString(m) }
+func (*AirdropRecipient) ProtoMessage()    {}
+func (*AirdropRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{7}
+}
+func (m *AirdropRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirdropRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropRecipient.Merge(m, src)
+}
+func (m *AirdropRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropRecipient proto.InternalMessageInfo
+
+func (m *AirdropRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AirdropRecipient) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MerkleAirdrop is an airdrop created by an AirdropV2Proposal with a Merkle root, its remaining funds are held by
+// the gravity module until claimed or until end_height, when they are returned to the Community Pool
+type MerkleAirdrop struct {
+	Id         uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerkleRoot string                                   `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Total      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	Claimed    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+	EndHeight  uint64                                   `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MerkleAirdrop) Reset()         { *m = MerkleAirdrop{} }
+func (m *MerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MerkleAirdrop) ProtoMessage()    {}
+func (*MerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{8}
+}
+func (m *MerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdrop.Merge(m, src)
+}
+func (m *MerkleAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAirdrop proto.InternalMessageInfo
+
+func (m *MerkleAirdrop) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MerkleAirdrop) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *MerkleAirdrop) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *MerkleAirdrop) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func (m *MerkleAirdrop) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 // IBCMetadataProposal defines a custom governance proposal type that allows governance to set the
 // metadata for an IBC token, this will allow Gravity to deploy an ERC20 representing this token on
 // Ethereum
@@ -362,16 +543,16 @@ var xxx_messageInfo_AirdropProposal proto.InternalMessageInfo
 // Deicmals: the decimals for the display unit
 // ibc_denom is the denom of the token in question on this chain
 type IBCMetadataProposal struct {
-	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Metadata    types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
-	IbcDenom    string         `protobuf:"bytes,4,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
+	Title       string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata    types1.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+	IbcDenom    string          `protobuf:"bytes,4,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
 }
 
 func (m *IBCMetadataProposal) Reset()      { *m = IBCMetadataProposal{} }
 func (*IBCMetadataProposal) ProtoMessage() {}
 func (*IBCMetadataProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_163831c23fcc179f, []int{6}
+	return fileDescriptor_163831c23fcc179f, []int{9}
 }
 func (m *IBCMetadataProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,27 +581,28 @@ func (m *IBCMetadataProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IBCMetadataProposal proto.InternalMessageInfo
 
-// PendingIbcAutoForward represents a SendToCosmos transaction with a foreign CosmosReceiver which will be added to the
-// PendingIbcAutoForward queue in attestation_handler and sent over IBC on some submission of a MsgExecuteIbcAutoForwards
-type PendingIbcAutoForward struct {
-	ForeignReceiver string       `protobuf:"bytes,1,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
-	Token           *types1.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	IbcChannel      string       `protobuf:"bytes,3,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
-	EventNonce      uint64       `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+// ERC20MetadataProposal defines a custom governance proposal type that sets or overrides the bank
+// metadata of an Ethereum originated voucher, the base unit of the metadata must be the voucher denom
+// (gravity0x...) of token_contract. Metadata is normally set from the ERC20 details reported in the
+// first MsgSendToCosmosClaim for a token, this proposal allows wrong or missing metadata to be fixed
+type ERC20MetadataProposal struct {
+	Title         string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TokenContract string          `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Metadata      types1.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *PendingIbcAutoForward) Reset()         { *m = PendingIbcAutoForward{} }
-func (m *PendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
-func (*PendingIbcAutoForward) ProtoMessage()    {}
-func (*PendingIbcAutoForward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_163831c23fcc179f, []int{7}
+func (m *ERC20MetadataProposal) Reset()      { *m = ERC20MetadataProposal{} }
+func (*ERC20MetadataProposal) ProtoMessage() {}
+func (*ERC20MetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{10}
 }
-func (m *PendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
+func (m *ERC20MetadataProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ERC20MetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PendingIbcAutoForward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ERC20MetadataProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -430,139 +612,578 @@ func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *PendingIbcAutoForward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingIbcAutoForward.Merge(m, src)
+func (m *ERC20MetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20MetadataProposal.Merge(m, src)
 }
-func (m *PendingIbcAutoForward) XXX_Size() int {
+func (m *ERC20MetadataProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *PendingIbcAutoForward) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingIbcAutoForward.DiscardUnknown(m)
+func (m *ERC20MetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20MetadataProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingIbcAutoForward proto.InternalMessageInfo
+var xxx_messageInfo_ERC20MetadataProposal proto.InternalMessageInfo
 
-func (m *PendingIbcAutoForward) GetForeignReceiver() string {
+// RequestERC20DeploymentProposal whitelists a Cosmos originated denom for ERC20 deployment, once this
+// proposal passes anyone may deploy the ERC20 using the Gravity contract and the first ERC20DeployedClaim
+// for the denom which matches its bank metadata will become the canonical representation. Deployed claims
+// for denoms without an approved request are rejected, this prevents squatting on the canonical mapping
+type RequestERC20DeploymentProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RequestERC20DeploymentProposal) Reset()      { *m = RequestERC20DeploymentProposal{} }
+func (*RequestERC20DeploymentProposal) ProtoMessage() {}
+func (*RequestERC20DeploymentProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{11}
+}
+func (m *RequestERC20DeploymentProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestERC20DeploymentProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestERC20DeploymentProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestERC20DeploymentProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestERC20DeploymentProposal.Merge(m, src)
+}
+func (m *RequestERC20DeploymentProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestERC20DeploymentProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestERC20DeploymentProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestERC20DeploymentProposal proto.InternalMessageInfo
+
+// ERC20DeploymentRequest records a governance approved request to deploy an ERC20 for a Cosmos originated
+// denom, the request is pending until an ERC20DeployedClaim for the denom is observed
+type ERC20DeploymentRequest struct {
+	Denom          string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ApprovedHeight uint64 `protobuf:"varint,2,opt,name=approved_height,json=approvedHeight,proto3" json:"approved_height,omitempty"`
+	Erc20          string `protobuf:"bytes,3,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	DeployedHeight uint64 `protobuf:"varint,4,opt,name=deployed_height,json=deployedHeight,proto3" json:"deployed_height,omitempty"`
+}
+
+func (m *ERC20DeploymentRequest) Reset()         { *m = ERC20DeploymentRequest{} }
+func (m *ERC20DeploymentRequest) String() string { return proto.CompactTextString(m) }
+func (*ERC20DeploymentRequest) ProtoMessage()    {}
+func (*ERC20DeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{12}
+}
+func (m *ERC20DeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20DeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20DeploymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20DeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20DeploymentRequest.Merge(m, src)
+}
+func (m *ERC20DeploymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20DeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20DeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20DeploymentRequest proto.InternalMessageInfo
+
+func (m *ERC20DeploymentRequest) GetDenom() string {
 	if m != nil {
-		return m.ForeignReceiver
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *PendingIbcAutoForward) GetToken() *types1.Coin {
+func (m *ERC20DeploymentRequest) GetApprovedHeight() uint64 {
 	if m != nil {
-		return m.Token
+		return m.ApprovedHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingIbcAutoForward) GetIbcChannel() string {
+func (m *ERC20DeploymentRequest) GetErc20() string {
 	if m != nil {
-		return m.IbcChannel
+		return m.Erc20
 	}
 	return ""
 }
 
-func (m *PendingIbcAutoForward) GetEventNonce() uint64 {
+func (m *ERC20DeploymentRequest) GetDeployedHeight() uint64 {
 	if m != nil {
-		return m.EventNonce
+		return m.DeployedHeight
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
-	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
-	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
-	proto.RegisterType((*UnhaltBridgeProposal)(nil), "gravity.v1.UnhaltBridgeProposal")
-	proto.RegisterType((*AirdropProposal)(nil), "gravity.v1.AirdropProposal")
-	proto.RegisterType((*IBCMetadataProposal)(nil), "gravity.v1.IBCMetadataProposal")
-	proto.RegisterType((*PendingIbcAutoForward)(nil), "gravity.v1.PendingIbcAutoForward")
+// DeprecateERC20Proposal deprecates the ERC20 representation of a Cosmos originated denom so that a new ERC20
+// can be deployed, for example when the original contract was deployed with the wrong decimals. Once this
+// proposal passes new MsgSendToEth for the denom are refused until the new ERC20 is deployed, deposits from the
+// old contract are honored for migration_window blocks so holders can move their tokens to the new contract,
+// and a new ERC20DeploymentRequest is created for the denom
+type DeprecateERC20Proposal struct {
+	Title           string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom           string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	MigrationWindow uint64 `protobuf:"varint,4,opt,name=migration_window,json=migrationWindow,proto3" json:"migration_window,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
-
-var fileDescriptor_163831c23fcc179f = []byte{
-	// 747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbd, 0x6f, 0xdb, 0x46,
-	0x14, 0x17, 0x2d, 0xc9, 0xb6, 0x4e, 0x2a, 0xd4, 0xd2, 0x1f, 0x50, 0x6b, 0x94, 0x52, 0x35, 0x14,
-	0xea, 0x60, 0xd2, 0x52, 0x37, 0x77, 0x30, 0x24, 0xd5, 0x6d, 0x0d, 0xf4, 0xc3, 0x60, 0x5d, 0x03,
-	0xed, 0x42, 0x1c, 0xc9, 0x57, 0xea, 0x20, 0xf2, 0x4e, 0x38, 0x9e, 0xe4, 0x78, 0xca, 0x14, 0x20,
-	0x63, 0xc6, 0x8c, 0xde, 0xf2, 0x17, 0x64, 0xc8, 0x7f, 0xe0, 0xd1, 0x63, 0x90, 0xc1, 0x08, 0xec,
-	0x25, 0x40, 0xfe, 0x89, 0xe0, 0x3e, 0x24, 0xcb, 0xce, 0xe8, 0x89, 0xfc, 0xfd, 0xee, 0x7d, 0xfc,
-	0xde, 0xbb, 0xf7, 0x0e, 0x6d, 0x27, 0x1c, 0xcf, 0x88, 0x38, 0xf7, 0x66, 0x5d, 0x4f, 0x9c, 0x4f,
-	0x20, 0x77, 0x27, 0x9c, 0x09, 0x66, 0x23, 0xc3, 0xbb, 0xb3, 0xee, 0x37, 0x4e, 0xc4, 0xf2, 0x8c,
-	0xe5, 0x5e, 0x88, 0x73, 0xf0, 0x66, 0xdd, 0x10, 0x04, 0xee, 0x7a, 0x11, 0x23, 0x54, 0xdb, 0x2e,
-	0x9d, 0xd3, 0xf1, 0xe2, 0x5c, 0x02, 0x73, 0xbe, 0x99, 0xb0, 0x84, 0xa9, 0x5f, 0x4f, 0xfe, 0x69,
-	0xb6, 0xed, 0xa3, 0xfa, 0x80, 0x93, 0x38, 0x81, 0x53, 0x9c, 0x92, 0x18, 0x0b, 0xc6, 0xed, 0x4d,
-	0x54, 0x9e, 0xb0, 0x33, 0xe0, 0x0d, 0xab, 0x65, 0x75, 0x4a, 0xbe, 0x06, 0xf6, 0x0f, 0xe8, 0x4b,
-	0x10, 0x23, 0xe0, 0x30, 0xcd, 0x02, 0x1c, 0xc7, 0x1c, 0xf2, 0xbc, 0xb1, 0xd2, 0xb2, 0x3a, 0x15,
-	0xbf, 0x3e, 0xe7, 0xfb, 0x9a, 0x6e, 0x7f, 0xb4, 0xd0, 0xea, 0x29, 0x4e, 0x73, 0x10, 0x32, 0x16,
-	0x65, 0x34, 0x82, 0x79, 0x2c, 0x05, 0xec, 0x9f, 0xd0, 0x5a, 0x06, 0x59, 0x08, 0x5c, 0x86, 0x28,
-	0x76, 0xaa, 0xbd, 0x1d, 0xf7, 0xae, 0x50, 0xf7, 0x81, 0x9e, 0x41, 0xe9, 0xf2, 0xba, 0x59, 0xf0,
-	0xe7, 0x1e, 0xf6, 0x36, 0x5a, 0x1d, 0x01, 0x49, 0x46, 0xa2, 0x51, 0x54, 0x31, 0x0d, 0xb2, 0xff,
-	0x46, 0x5f, 0x70, 0x38, 0xc3, 0x3c, 0x0e, 0x70, 0xc6, 0xa6, 0x54, 0x34, 0x4a, 0x52, 0xdd, 0xc0,
-	0x95, 0xde, 0xef, 0xae, 0x9b, 0xdf, 0x27, 0x44, 0x8c, 0xa6, 0xa1, 0x1b, 0xb1, 0xcc, 0x33, 0x9d,
-	0xd2, 0x9f, 0xdd, 0x3c, 0x1e, 0x9b, 0xa6, 0x1f, 0x51, 0xe1, 0xd7, 0x74, 0x90, 0xbe, 0x8a, 0x61,
-	0x7f, 0x87, 0x0c, 0x0e, 0x04, 0x1b, 0x03, 0x6d, 0x94, 0x55, 0xc5, 0x55, 0xcd, 0x9d, 0x48, 0xaa,
-	0xfd, 0xcc, 0x42, 0xcd, 0xdf, 0x71, 0x2e, 0xfe, 0x0a, 0x73, 0xe0, 0x33, 0x88, 0x0f, 0x4d, 0x37,
-	0x06, 0x29, 0x8b, 0xc6, 0xbf, 0x69, 0x6d, 0x2e, 0xda, 0xd0, 0xc9, 0x82, 0x50, 0xb2, 0x81, 0x29,
-	0x40, 0x37, 0xe5, 0x2b, 0x7d, 0xb4, 0x6c, 0xdf, 0x43, 0x5b, 0x8b, 0x66, 0xdf, 0xf3, 0x58, 0x51,
-	0x1e, 0x1b, 0xf0, 0x79, 0x8e, 0xf6, 0x3e, 0xaa, 0x1d, 0xfa, 0xc3, 0xde, 0xde, 0x09, 0xfb, 0x19,
-	0x28, 0xcb, 0x64, 0xeb, 0x81, 0x47, 0xbd, 0x3d, 0x95, 0xa5, 0xe2, 0x6b, 0x20, 0xd9, 0x58, 0x1e,
-	0x9b, 0xbb, 0xd3, 0xa0, 0xfd, 0x14, 0x6d, 0xfe, 0x43, 0x47, 0x38, 0x15, 0xba, 0xf7, 0xc7, 0x9c,
-	0x4d, 0x58, 0x8e, 0x53, 0x69, 0x2d, 0x88, 0x48, 0x61, 0x1e, 0x43, 0x01, 0xbb, 0x85, 0xaa, 0x31,
-	0xe4, 0x11, 0x27, 0x13, 0x41, 0x18, 0x35, 0x91, 0x96, 0x29, 0xd9, 0x36, 0x81, 0x79, 0x02, 0x22,
-	0xd0, 0xb7, 0x5f, 0x52, 0xb2, 0xab, 0x9a, 0xfb, 0x53, 0x52, 0xfb, 0xb5, 0xe7, 0x17, 0xcd, 0xc2,
-	0xcb, 0x8b, 0x66, 0xe1, 0xc3, 0x45, 0xd3, 0x6a, 0xbf, 0xb2, 0x50, 0xbd, 0x4f, 0x78, 0xcc, 0xd9,
-	0xe4, 0xd1, 0xc9, 0x17, 0x25, 0x16, 0x97, 0x4a, 0xb4, 0x1d, 0x84, 0x38, 0x44, 0x64, 0x42, 0x80,
-	0x8a, 0x5c, 0x09, 0xaa, 0xf9, 0x4b, 0x8c, 0xdd, 0x40, 0x6b, 0x7a, 0x6e, 0xf2, 0x46, 0xb9, 0x55,
-	0xec, 0x94, 0xfc, 0x39, 0x7c, 0xa0, 0xf4, 0x8d, 0x85, 0x36, 0x8e, 0x06, 0xc3, 0x3f, 0x40, 0xe0,
-	0x18, 0x0b, 0xfc, 0x68, 0xb5, 0x07, 0x68, 0x3d, 0x33, 0xb1, 0x94, 0xe0, 0x6a, 0xef, 0x5b, 0x57,
-	0x0f, 0x84, 0xab, 0x96, 0xd7, 0x6c, 0xb2, 0x3b, 0x4f, 0x68, 0xd6, 0x61, 0xe1, 0x64, 0xef, 0xa0,
-	0x0a, 0x09, 0xa3, 0x40, 0x97, 0xac, 0x66, 0xde, 0x5f, 0x27, 0x61, 0xa4, 0x86, 0xe0, 0x9e, 0xf6,
-	0x42, 0xfb, 0xb5, 0x85, 0xb6, 0x8e, 0x81, 0xc6, 0x84, 0x26, 0x47, 0x61, 0xd4, 0x9f, 0x0a, 0xf6,
-	0x0b, 0xe3, 0x72, 0x92, 0xe5, 0x76, 0xff, 0xcf, 0x38, 0x90, 0x84, 0x06, 0x1c, 0x22, 0x20, 0x33,
-	0xb3, 0xfe, 0x15, 0xbf, 0x6e, 0x78, 0xdf, 0xd0, 0xb6, 0x87, 0xca, 0x7a, 0x17, 0x56, 0x94, 0xda,
-	0xaf, 0xef, 0xd4, 0xe6, 0xb0, 0x50, 0x3b, 0x64, 0x84, 0xfa, 0xda, 0xce, 0x6e, 0xa2, 0xaa, 0x14,
-	0x18, 0x8d, 0x30, 0xa5, 0x90, 0x9a, 0x5b, 0x41, 0x24, 0x8c, 0x86, 0x9a, 0x91, 0x06, 0x30, 0x03,
-	0x7a, 0x7f, 0x58, 0x90, 0xa2, 0xd4, 0xac, 0x0c, 0xfe, 0xbd, 0xbc, 0x71, 0xac, 0xab, 0x1b, 0xc7,
-	0x7a, 0x7f, 0xe3, 0x58, 0x2f, 0x6e, 0x9d, 0xc2, 0xd5, 0xad, 0x53, 0x78, 0x7b, 0xeb, 0x14, 0xfe,
-	0x3b, 0x58, 0xda, 0xea, 0x5f, 0xf5, 0x13, 0xb2, 0xab, 0x67, 0xf8, 0x21, 0xcc, 0x58, 0x3c, 0x4d,
-	0xc1, 0x7b, 0xe2, 0xcd, 0x9f, 0x5a, 0xb5, 0xf2, 0xe1, 0xaa, 0x7a, 0x06, 0x7f, 0xfc, 0x14, 0x00,
-	0x00, 0xff, 0xff, 0x11, 0x9a, 0x06, 0xeb, 0x82, 0x05, 0x00, 0x00,
+func (m *DeprecateERC20Proposal) Reset()      { *m = DeprecateERC20Proposal{} }
+func (*DeprecateERC20Proposal) ProtoMessage() {}
+func (*DeprecateERC20Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{13}
 }
-
-func (this *UnhaltBridgeProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DeprecateERC20Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeprecateERC20Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecateERC20Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *DeprecateERC20Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecateERC20Proposal.Merge(m, src)
+}
+func (m *DeprecateERC20Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecateERC20Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecateERC20Proposal.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*UnhaltBridgeProposal)
-	if !ok {
-		that2, ok := that.(UnhaltBridgeProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DeprecateERC20Proposal proto.InternalMessageInfo
+
+// ERC20Migration records the deprecation of a Cosmos originated denom's ERC20 representation
+type ERC20Migration struct {
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	OldErc20    string `protobuf:"bytes,2,opt,name=old_erc20,json=oldErc20,proto3" json:"old_erc20,omitempty"`
+	NewErc20    string `protobuf:"bytes,3,opt,name=new_erc20,json=newErc20,proto3" json:"new_erc20,omitempty"`
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *ERC20Migration) Reset()         { *m = ERC20Migration{} }
+func (m *ERC20Migration) String() string { return proto.CompactTextString(m) }
+func (*ERC20Migration) ProtoMessage()    {}
+func (*ERC20Migration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{14}
+}
+func (m *ERC20Migration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Migration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Migration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
+}
+func (m *ERC20Migration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Migration.Merge(m, src)
+}
+func (m *ERC20Migration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Migration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Migration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Migration proto.InternalMessageInfo
+
+func (m *ERC20Migration) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.Description != that1.Description {
-		return false
+	return ""
+}
+
+func (m *ERC20Migration) GetOldErc20() string {
+	if m != nil {
+		return m.OldErc20
 	}
-	if this.TargetNonce != that1.TargetNonce {
-		return false
+	return ""
+}
+
+func (m *ERC20Migration) GetNewErc20() string {
+	if m != nil {
+		return m.NewErc20
 	}
-	return true
+	return ""
+}
+
+func (m *ERC20Migration) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ERC20Migration) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// CircuitBreakerTrip records why the EndBlocker circuit breaker halted the bridge, while it is set the bridge
+// stays halted until an UnhaltBridgeProposal passes
+type CircuitBreakerTrip struct {
+	Reason        string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BlockHeight   uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *CircuitBreakerTrip) Reset()         { *m = CircuitBreakerTrip{} }
+func (m *CircuitBreakerTrip) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerTrip) ProtoMessage()    {}
+func (*CircuitBreakerTrip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{15}
+}
+func (m *CircuitBreakerTrip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerTrip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerTrip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerTrip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerTrip.Merge(m, src)
+}
+func (m *CircuitBreakerTrip) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerTrip) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerTrip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerTrip proto.InternalMessageInfo
+
+func (m *CircuitBreakerTrip) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CircuitBreakerTrip) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *CircuitBreakerTrip) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// PendingIbcAutoForward represents a SendToCosmos transaction with a foreign CosmosReceiver which will be added to the
+// PendingIbcAutoForward queue in attestation_handler and sent over IBC on some submission of a MsgExecuteIbcAutoForwards
+type PendingIbcAutoForward struct {
+	ForeignReceiver string      `protobuf:"bytes,1,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
+	Token           *types.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	IbcChannel      string      `protobuf:"bytes,3,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	EventNonce      uint64      `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *PendingIbcAutoForward) Reset()         { *m = PendingIbcAutoForward{} }
+func (m *PendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
+func (*PendingIbcAutoForward) ProtoMessage()    {}
+func (*PendingIbcAutoForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{16}
+}
+func (m *PendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIbcAutoForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingIbcAutoForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIbcAutoForward.Merge(m, src)
+}
+func (m *PendingIbcAutoForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIbcAutoForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIbcAutoForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingIbcAutoForward proto.InternalMessageInfo
+
+func (m *PendingIbcAutoForward) GetForeignReceiver() string {
+	if m != nil {
+		return m.ForeignReceiver
+	}
+	return ""
+}
+
+func (m *PendingIbcAutoForward) GetToken() *types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *PendingIbcAutoForward) GetIbcChannel() string {
+	if m != nil {
+		return m.IbcChannel
+	}
+	return ""
+}
+
+func (m *PendingIbcAutoForward) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+// BridgeSlashingEvent records a validator being slashed by the gravity module, either for failing to sign
+// a valset, batch or logic call or for submitting a bad Ethereum signature
+type BridgeSlashingEvent struct {
+	Validator   string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	SlashType   string `protobuf:"bytes,2,opt,name=slash_type,json=slashType,proto3" json:"slash_type,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *BridgeSlashingEvent) Reset()         { *m = BridgeSlashingEvent{} }
+func (m *BridgeSlashingEvent) String() string { return proto.CompactTextString(m) }
+func (*BridgeSlashingEvent) ProtoMessage()    {}
+func (*BridgeSlashingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{17}
+}
+func (m *BridgeSlashingEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeSlashingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeSlashingEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeSlashingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeSlashingEvent.Merge(m, src)
+}
+func (m *BridgeSlashingEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeSlashingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeSlashingEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeSlashingEvent proto.InternalMessageInfo
+
+func (m *BridgeSlashingEvent) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *BridgeSlashingEvent) GetSlashType() string {
+	if m != nil {
+		return m.SlashType
+	}
+	return ""
+}
+
+func (m *BridgeSlashingEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
+	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
+	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*UnhaltBridgeProposal)(nil), "gravity.v1.UnhaltBridgeProposal")
+	proto.RegisterType((*AirdropProposal)(nil), "gravity.v1.AirdropProposal")
+	proto.RegisterType((*AirdropV2Proposal)(nil), "gravity.v1.AirdropV2Proposal")
+	proto.RegisterType((*AirdropRecipient)(nil), "gravity.v1.AirdropRecipient")
+	proto.RegisterType((*MerkleAirdrop)(nil), "gravity.v1.MerkleAirdrop")
+	proto.RegisterType((*IBCMetadataProposal)(nil), "gravity.v1.IBCMetadataProposal")
+	proto.RegisterType((*ERC20MetadataProposal)(nil), "gravity.v1.ERC20MetadataProposal")
+	proto.RegisterType((*RequestERC20DeploymentProposal)(nil), "gravity.v1.RequestERC20DeploymentProposal")
+	proto.RegisterType((*ERC20DeploymentRequest)(nil), "gravity.v1.ERC20DeploymentRequest")
+	proto.RegisterType((*DeprecateERC20Proposal)(nil), "gravity.v1.DeprecateERC20Proposal")
+	proto.RegisterType((*ERC20Migration)(nil), "gravity.v1.ERC20Migration")
+	proto.RegisterType((*CircuitBreakerTrip)(nil), "gravity.v1.CircuitBreakerTrip")
+	proto.RegisterType((*PendingIbcAutoForward)(nil), "gravity.v1.PendingIbcAutoForward")
+	proto.RegisterType((*BridgeSlashingEvent)(nil), "gravity.v1.BridgeSlashingEvent")
+}
+
+func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
+
+var fileDescriptor_163831c23fcc179f = []byte{
+	// 1221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x4e, 0x1a, 0x3f, 0xa7, 0x71, 0xbb, 0x69, 0x23, 0x7f, 0xbf, 0x69, 0xed, 0xd4,
+	0x12, 0x34, 0x3d, 0xd4, 0x6e, 0xcc, 0xad, 0x1c, 0xaa, 0x38, 0x0d, 0x50, 0x89, 0x42, 0xb5, 0x0d,
+	0x45, 0x70, 0x59, 0xcd, 0xee, 0x3e, 0xec, 0x91, 0x77, 0x67, 0x96, 0xd9, 0xb1, 0x43, 0x24, 0x24,
+	0x4e, 0x48, 0x1c, 0x39, 0x01, 0x37, 0x2a, 0x2e, 0x08, 0xee, 0x1c, 0xb8, 0x83, 0xd4, 0x63, 0x8f,
+	0x88, 0x43, 0x41, 0xed, 0x05, 0x89, 0x7f, 0x02, 0xcd, 0x8f, 0x75, 0xd6, 0xa6, 0x85, 0x8a, 0xb6,
+	0x27, 0xfb, 0x7d, 0x66, 0xe6, 0xcd, 0xe7, 0x7d, 0x66, 0xde, 0x9b, 0xb7, 0xb0, 0x31, 0x14, 0x64,
+	0x4a, 0xe5, 0x51, 0x6f, 0xba, 0xd3, 0x93, 0x47, 0x29, 0x66, 0xdd, 0x54, 0x70, 0xc9, 0x5d, 0xb0,
+	0x78, 0x77, 0xba, 0xf3, 0xff, 0x56, 0xc8, 0xb3, 0x84, 0x67, 0xbd, 0x80, 0x64, 0xd8, 0x9b, 0xee,
+	0x04, 0x28, 0xc9, 0x4e, 0x2f, 0xe4, 0x94, 0x99, 0xb9, 0x85, 0x71, 0x36, 0x9e, 0x8d, 0x2b, 0xc3,
+	0x8e, 0x9f, 0x19, 0xf2, 0x21, 0xd7, 0x7f, 0x7b, 0xea, 0x9f, 0x41, 0x3b, 0x1e, 0x34, 0x06, 0x82,
+	0x46, 0x43, 0xbc, 0x43, 0x62, 0x1a, 0x11, 0xc9, 0x85, 0x7b, 0x06, 0x96, 0x52, 0x7e, 0x88, 0xa2,
+	0xe9, 0x6c, 0x39, 0xdb, 0x55, 0xcf, 0x18, 0xee, 0x25, 0x38, 0x85, 0x72, 0x84, 0x02, 0x27, 0x89,
+	0x4f, 0xa2, 0x48, 0x60, 0x96, 0x35, 0xcb, 0x5b, 0xce, 0x76, 0xcd, 0x6b, 0xe4, 0xf8, 0xae, 0x81,
+	0x3b, 0x7f, 0x3a, 0xb0, 0x7c, 0x87, 0xc4, 0x19, 0x4a, 0xe5, 0x8b, 0x71, 0x16, 0x62, 0xee, 0x4b,
+	0x1b, 0xee, 0xab, 0x70, 0x22, 0xc1, 0x24, 0x40, 0xa1, 0x5c, 0x54, 0xb6, 0xeb, 0xfd, 0xcd, 0xee,
+	0x71, 0xa0, 0xdd, 0x05, 0x3e, 0x83, 0xea, 0xbd, 0x07, 0xed, 0x92, 0x97, 0xaf, 0x70, 0x37, 0x60,
+	0x79, 0x84, 0x74, 0x38, 0x92, 0xcd, 0x8a, 0xf6, 0x69, 0x2d, 0xf7, 0x36, 0x9c, 0x14, 0x78, 0x48,
+	0x44, 0xe4, 0x93, 0x84, 0x4f, 0x98, 0x6c, 0x56, 0x15, 0xbb, 0x41, 0x57, 0xad, 0xfe, 0xf5, 0x41,
+	0xfb, 0xe5, 0x21, 0x95, 0xa3, 0x49, 0xd0, 0x0d, 0x79, 0xd2, 0xb3, 0x4a, 0x99, 0x9f, 0xcb, 0x59,
+	0x34, 0xb6, 0xa2, 0xdf, 0x60, 0xd2, 0x5b, 0x35, 0x4e, 0x76, 0xb5, 0x0f, 0xf7, 0x02, 0x58, 0xdb,
+	0x97, 0x7c, 0x8c, 0xac, 0xb9, 0xa4, 0x23, 0xae, 0x1b, 0xec, 0x40, 0x41, 0x9d, 0x4f, 0x1d, 0x68,
+	0xbf, 0x49, 0x32, 0xf9, 0x76, 0x90, 0xa1, 0x98, 0x62, 0xb4, 0x6f, 0xd5, 0x18, 0xc4, 0x3c, 0x1c,
+	0xbf, 0x61, 0xb8, 0x75, 0x61, 0xdd, 0x6c, 0xe6, 0x07, 0x0a, 0xf5, 0x6d, 0x00, 0x46, 0x94, 0xd3,
+	0x66, 0xa8, 0x38, 0xbf, 0x0f, 0x67, 0x67, 0x62, 0xcf, 0xad, 0x28, 0xeb, 0x15, 0xeb, 0xf8, 0xf7,
+	0x3d, 0x3a, 0x57, 0x61, 0x75, 0xdf, 0xdb, 0xeb, 0x5f, 0x39, 0xe0, 0xd7, 0x91, 0xf1, 0x44, 0x49,
+	0x8f, 0x22, 0xec, 0x5f, 0xd1, 0xbb, 0xd4, 0x3c, 0x63, 0x28, 0x34, 0x52, 0xc3, 0xf6, 0xec, 0x8c,
+	0xd1, 0xf9, 0x04, 0xce, 0xbc, 0xc3, 0x46, 0x24, 0x96, 0x46, 0xfb, 0x5b, 0x82, 0xa7, 0x3c, 0x23,
+	0xb1, 0x9a, 0x2d, 0xa9, 0x8c, 0x31, 0xf7, 0xa1, 0x0d, 0x77, 0x0b, 0xea, 0x11, 0x66, 0xa1, 0xa0,
+	0xa9, 0xa4, 0x9c, 0x59, 0x4f, 0x45, 0x48, 0xc9, 0x26, 0x89, 0x18, 0xa2, 0xf4, 0xcd, 0xe9, 0x57,
+	0x35, 0xed, 0xba, 0xc1, 0xde, 0x52, 0xd0, 0xd5, 0xd5, 0xcf, 0xee, 0xb6, 0x4b, 0x5f, 0xdd, 0x6d,
+	0x97, 0xfe, 0xb8, 0xdb, 0x76, 0x3a, 0xdf, 0x3a, 0xd0, 0xd8, 0xa5, 0x22, 0x12, 0x3c, 0x7d, 0xe6,
+	0xcd, 0x67, 0x21, 0x56, 0x0a, 0x21, 0xba, 0x2d, 0x00, 0x81, 0x21, 0x4d, 0x29, 0x32, 0x99, 0x69,
+	0x42, 0xab, 0x5e, 0x01, 0x71, 0x9b, 0x70, 0xc2, 0xdc, 0x9b, 0xac, 0xb9, 0xb4, 0x55, 0xd9, 0xae,
+	0x7a, 0xb9, 0xb9, 0xc0, 0xf4, 0xa7, 0x32, 0x9c, 0xb6, 0x4c, 0xef, 0xf4, 0x9f, 0x99, 0xeb, 0x60,
+	0x8e, 0x55, 0x45, 0x27, 0xc3, 0xb9, 0x62, 0x32, 0xd8, 0xad, 0xbc, 0x7c, 0x92, 0xcd, 0x86, 0x22,
+	0xf3, 0x36, 0xd4, 0x13, 0x14, 0xe3, 0x18, 0x7d, 0xc1, 0xb9, 0xbd, 0xf6, 0x1e, 0x18, 0xc8, 0xe3,
+	0x5c, 0xba, 0x04, 0x96, 0x24, 0x97, 0x24, 0xd6, 0x81, 0xd5, 0xfb, 0xff, 0xeb, 0x9a, 0x0b, 0xd7,
+	0x55, 0x95, 0xa4, 0x6b, 0x2b, 0x45, 0x77, 0x8f, 0x53, 0x36, 0xb8, 0xa2, 0x9c, 0x7f, 0xff, 0x5b,
+	0x7b, 0xfb, 0x29, 0x92, 0x45, 0x2d, 0xc8, 0x3c, 0xe3, 0x59, 0x1d, 0x78, 0x18, 0x13, 0x9a, 0xf8,
+	0x87, 0x94, 0x45, 0xfc, 0xb0, 0xb9, 0x6c, 0x0e, 0x5c, 0x63, 0xef, 0x6a, 0x68, 0x41, 0xc6, 0x2f,
+	0x1d, 0x38, 0xb5, 0x18, 0x9b, 0x3e, 0x03, 0x5b, 0x5a, 0x8c, 0x8e, 0xb9, 0xe9, 0x86, 0xb0, 0x6c,
+	0xb3, 0xba, 0xfc, 0xfc, 0x63, 0xb0, 0xae, 0xaf, 0x56, 0x35, 0xb3, 0xef, 0xca, 0x70, 0xf2, 0xa6,
+	0x16, 0xcf, 0xf2, 0x73, 0xd7, 0xa0, 0x4c, 0x23, 0x9b, 0xac, 0x65, 0x1a, 0x2d, 0x0a, 0x5e, 0x7e,
+	0xb2, 0xe0, 0x95, 0x17, 0x26, 0x38, 0xc2, 0x09, 0x2d, 0x2e, 0x46, 0xcd, 0xea, 0xf3, 0xdf, 0x24,
+	0xf7, 0xed, 0x9e, 0x07, 0x40, 0x16, 0xe5, 0xd5, 0x67, 0x49, 0x4b, 0x50, 0x43, 0x16, 0xd9, 0x9a,
+	0xf3, 0xa3, 0x03, 0xeb, 0x37, 0x06, 0x7b, 0x37, 0x51, 0x92, 0x88, 0x48, 0xf2, 0xcc, 0xe9, 0x70,
+	0x0d, 0x56, 0x12, 0xeb, 0x4b, 0x67, 0x6f, 0xbd, 0x7f, 0xfe, 0x38, 0x2c, 0x36, 0x9e, 0x85, 0x95,
+	0x6f, 0x68, 0xb3, 0x61, 0xb6, 0xc8, 0xdd, 0x84, 0x1a, 0x0d, 0x42, 0xdf, 0xe4, 0xbf, 0xc9, 0x84,
+	0x15, 0x1a, 0x84, 0xba, 0x22, 0xce, 0xdd, 0xc0, 0x52, 0xe7, 0x67, 0x07, 0xce, 0xea, 0x82, 0xf9,
+	0xdc, 0xd8, 0xbf, 0x04, 0x6b, 0xfa, 0x95, 0xf0, 0x43, 0xce, 0xa4, 0x20, 0xa1, 0xb4, 0x15, 0xe8,
+	0xa4, 0x46, 0xf7, 0x2c, 0x38, 0x17, 0x64, 0xf5, 0x3f, 0x04, 0xb9, 0x10, 0xc7, 0xc7, 0xd0, 0xf2,
+	0xf0, 0xc3, 0x09, 0x66, 0x52, 0x47, 0x73, 0x1d, 0xd3, 0x98, 0x1f, 0x25, 0xc8, 0xe4, 0x8b, 0x29,
+	0xa4, 0x0b, 0xbb, 0x7f, 0xe1, 0xc0, 0xc6, 0xc2, 0xbe, 0x96, 0xcd, 0xf1, 0x72, 0xa7, 0x58, 0x87,
+	0x2f, 0x42, 0x83, 0xa4, 0xa9, 0xe0, 0x53, 0x8c, 0xe6, 0x1f, 0xb5, 0xb5, 0x1c, 0xb6, 0x6f, 0xe0,
+	0xec, 0xfd, 0xaa, 0x14, 0xdf, 0xaf, 0x8b, 0xd0, 0x88, 0xf4, 0x4e, 0xc7, 0xcb, 0xcd, 0xe3, 0xb2,
+	0x96, 0xc3, 0xf6, 0x6a, 0x7e, 0xed, 0xc0, 0xc6, 0x75, 0x4c, 0x05, 0x86, 0x44, 0xa2, 0x66, 0xf8,
+	0x82, 0x1e, 0x96, 0x4b, 0x70, 0x2a, 0xa1, 0x43, 0x41, 0xd4, 0x94, 0xbc, 0xfc, 0x19, 0x4a, 0x8d,
+	0x19, 0xfe, 0x98, 0x12, 0x58, 0xea, 0x7c, 0xe3, 0xc0, 0x9a, 0xb9, 0x80, 0xf9, 0xb4, 0x27, 0x48,
+	0xb6, 0x09, 0x35, 0x1e, 0x47, 0xbe, 0x51, 0xc3, 0xf0, 0x5a, 0xe1, 0x71, 0xb4, 0xaf, 0x05, 0xd9,
+	0x84, 0x1a, 0xc3, 0x43, 0xbf, 0x28, 0xd5, 0x0a, 0xc3, 0x43, 0x33, 0x78, 0x01, 0x56, 0x33, 0x49,
+	0x84, 0x9c, 0x97, 0xaa, 0xae, 0x31, 0x2b, 0xf3, 0xbf, 0x64, 0xf8, 0x14, 0xdc, 0x3d, 0x2a, 0xc2,
+	0x09, 0x95, 0x03, 0x81, 0x64, 0x8c, 0xe2, 0x40, 0xd0, 0x54, 0xf5, 0x60, 0x02, 0x49, 0xc6, 0x99,
+	0x25, 0x6a, 0xad, 0xc7, 0x64, 0x40, 0xf9, 0x71, 0x19, 0x70, 0x01, 0x56, 0xe7, 0xba, 0x1a, 0xd3,
+	0xc8, 0xd5, 0x83, 0x42, 0x37, 0xf3, 0x83, 0x03, 0x67, 0x6f, 0x21, 0x8b, 0x28, 0x1b, 0xde, 0x08,
+	0xc2, 0xdd, 0x89, 0xe4, 0xaf, 0x71, 0xa1, 0x9a, 0x2e, 0xa5, 0xf7, 0x07, 0x5c, 0x20, 0x1d, 0x32,
+	0x5f, 0x60, 0x88, 0x74, 0x6a, 0x3b, 0xd5, 0x9a, 0xd7, 0xb0, 0xb8, 0x67, 0x61, 0xb7, 0x07, 0x4b,
+	0xa6, 0x6d, 0x2b, 0x6f, 0x39, 0xff, 0x58, 0x22, 0x3d, 0x33, 0x4f, 0x55, 0x76, 0x55, 0x3e, 0xc2,
+	0x11, 0x61, 0x0c, 0x63, 0x2b, 0x27, 0xd0, 0x20, 0xdc, 0x33, 0x88, 0x9a, 0x80, 0x53, 0x64, 0xf3,
+	0x7d, 0x0d, 0x68, 0x48, 0xb7, 0x35, 0x9d, 0x09, 0xac, 0x9b, 0x1e, 0xea, 0x76, 0x4c, 0xb2, 0x11,
+	0x65, 0xc3, 0x7d, 0x35, 0xe6, 0x9e, 0x83, 0xda, 0x34, 0x6f, 0x68, 0x2d, 0xdb, 0x63, 0x40, 0x9d,
+	0x41, 0xa6, 0xa6, 0xfb, 0xaa, 0x06, 0x5b, 0xc9, 0x6a, 0x1a, 0x39, 0x38, 0x4a, 0xf1, 0x29, 0xe4,
+	0x1a, 0xbc, 0x77, 0xef, 0x61, 0xcb, 0xb9, 0xff, 0xb0, 0xe5, 0xfc, 0xfe, 0xb0, 0xe5, 0x7c, 0xfe,
+	0xa8, 0x55, 0xba, 0xff, 0xa8, 0x55, 0xfa, 0xe5, 0x51, 0xab, 0xf4, 0xfe, 0xb5, 0x42, 0xd1, 0x7f,
+	0xdd, 0xf4, 0x15, 0x97, 0x0d, 0xc3, 0x45, 0x33, 0xe1, 0xd1, 0x24, 0xc6, 0xde, 0x47, 0xbd, 0xfc,
+	0x63, 0x44, 0xbf, 0x08, 0xc1, 0xb2, 0xfe, 0x50, 0x78, 0xe5, 0xaf, 0x01, 0x00, 0xe8, 0x85, 0xa1,
+	0xc5, 0xa4, 0x0c, 0x00, 0x00,
+}
+
+func (this *UnhaltBridgeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnhaltBridgeProposal)
+	if !ok {
+		that2, ok := that.(UnhaltBridgeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.TargetNonce != that1.TargetNonce {
+		return false
+	}
+	return true
 }
 func (this *AirdropProposal) Equal(that interface{}) bool {
 	if that == nil {
@@ -605,6 +1226,87 @@ func (this *AirdropProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AirdropV2Proposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AirdropV2Proposal)
+	if !ok {
+		that2, ok := that.(AirdropV2Proposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	if this.MerkleRoot != that1.MerkleRoot {
+		return false
+	}
+	if len(this.Total) != len(that1.Total) {
+		return false
+	}
+	for i := range this.Total {
+		if !this.Total[i].Equal(&that1.Total[i]) {
+			return false
+		}
+	}
+	if this.ClaimWindow != that1.ClaimWindow {
+		return false
+	}
+	return true
+}
+func (this *AirdropRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AirdropRecipient)
+	if !ok {
+		that2, ok := that.(AirdropRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -885,6 +1587,195 @@ func (m *AirdropProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AirdropV2Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropV2Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropV2Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IBCMetadataProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -939,7 +1830,7 @@ func (m *IBCMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
+func (m *ERC20MetadataProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -949,85 +1840,417 @@ func (m *PendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+func (m *ERC20MetadataProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ERC20MetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x20
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.IbcChannel) > 0 {
-		i -= len(m.IbcChannel)
-		copy(dAtA[i:], m.IbcChannel)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcChannel)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Token != nil {
-		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ForeignReceiver) > 0 {
-		i -= len(m.ForeignReceiver)
-		copy(dAtA[i:], m.ForeignReceiver)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RequestERC20DeploymentProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BridgeValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RequestERC20DeploymentProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestERC20DeploymentProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Valset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ERC20DeploymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20DeploymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20DeploymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	if m.DeployedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DeployedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ApprovedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ApprovedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeprecateERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecateERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeprecateERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrationWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MigrationWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Migration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Migration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Migration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewErc20) > 0 {
+		i -= len(m.NewErc20)
+		copy(dAtA[i:], m.NewErc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewErc20)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldErc20) > 0 {
+		i -= len(m.OldErc20)
+		copy(dAtA[i:], m.OldErc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OldErc20)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerTrip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerTrip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerTrip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IbcChannel) > 0 {
+		i -= len(m.IbcChannel)
+		copy(dAtA[i:], m.IbcChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForeignReceiver) > 0 {
+		i -= len(m.ForeignReceiver)
+		copy(dAtA[i:], m.ForeignReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeSlashingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeSlashingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeSlashingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SlashType) > 0 {
+		i -= len(m.SlashType)
+		copy(dAtA[i:], m.SlashType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SlashType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Valset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
 	}
 	if len(m.Members) > 0 {
 		for _, e := range m.Members {
@@ -1131,7 +2354,7 @@ func (m *AirdropProposal) Size() (n int) {
 	return n
 }
 
-func (m *IBCMetadataProposal) Size() (n int) {
+func (m *AirdropV2Proposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1145,11 +2368,235 @@ func (m *IBCMetadataProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ClaimWindow != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimWindow))
+	}
+	return n
+}
+
+func (m *AirdropRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *IBCMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20MetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *RequestERC20DeploymentProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20DeploymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ApprovedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ApprovedHeight))
+	}
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DeployedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.DeployedHeight))
+	}
+	return n
+}
+
+func (m *DeprecateERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MigrationWindow != 0 {
+		n += 1 + sovTypes(uint64(m.MigrationWindow))
+	}
+	return n
+}
+
+func (m *ERC20Migration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OldErc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewErc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *CircuitBreakerTrip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
 	}
 	return n
 }
@@ -1178,6 +2625,26 @@ func (m *PendingIbcAutoForward) Size() (n int) {
 	return n
 }
 
+func (m *BridgeSlashingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SlashType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1262,7 +2729,1681 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Valset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnhaltBridgeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnhaltBridgeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNonce", wireType)
+			}
+			m.TargetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipients == nil {
+				m.Recipients = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Amounts = append(m.Amounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Amounts) == 0 {
+					m.Amounts = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Amounts = append(m.Amounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropV2Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropV2Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropV2Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, AirdropRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimWindow", wireType)
+			}
+			m.ClaimWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20MetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20MetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20MetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1285,7 +4426,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Valset) Unmarshal(dAtA []byte) error {
+func (m *RequestERC20DeploymentProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1308,36 +4449,17 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestERC20DeploymentProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestERC20DeploymentProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1347,48 +4469,27 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1416,13 +4517,11 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1450,7 +4549,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1473,7 +4572,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *ERC20DeploymentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1496,17 +4595,17 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20DeploymentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20DeploymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1516,16 +4615,29 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedHeight", wireType)
 			}
-			m.EthereumBlockHeight = 0
+			m.ApprovedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1535,7 +4647,58 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				m.ApprovedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedHeight", wireType)
+			}
+			m.DeployedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeployedHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1561,7 +4724,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *DeprecateERC20Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1584,15 +4747,15 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeprecateERC20Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeprecateERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1620,9 +4783,41 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -1654,6 +4849,25 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationWindow", wireType)
+			}
+			m.MigrationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1675,7 +4889,7 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
+func (m *ERC20Migration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1698,15 +4912,15 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnhaltBridgeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20Migration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnhaltBridgeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20Migration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1734,11 +4948,43 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldErc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldErc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1766,13 +5012,32 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.NewErc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			m.TargetNonce = 0
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1782,7 +5047,7 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetNonce |= uint64(b&0x7F) << shift
+				m.EndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1808,7 +5073,7 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreakerTrip) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1831,15 +5096,15 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AirdropProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreakerTrip: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreakerTrip: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1867,11 +5132,11 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1899,45 +5164,13 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var byteLen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1947,102 +5180,11 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipients = append(m.Recipients[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipients == nil {
-				m.Recipients = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Amounts = append(m.Amounts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Amounts) == 0 {
-					m.Amounts = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Amounts = append(m.Amounts, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2064,7 +5206,7 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
+func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2087,15 +5229,15 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCMetadataProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingIbcAutoForward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2123,13 +5265,13 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2139,29 +5281,33 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Token == nil {
+				m.Token = &types.Coin{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2171,30 +5317,29 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2204,24 +5349,11 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2243,7 +5375,7 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
+func (m *BridgeSlashingEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2266,15 +5398,15 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingIbcAutoForward: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeSlashingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeSlashingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2302,47 +5434,11 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Token == nil {
-				m.Token = &types1.Coin{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2370,13 +5466,13 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcChannel = string(dAtA[iNdEx:postIndex])
+			m.SlashType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.EventNonce = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2386,7 +5482,7 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
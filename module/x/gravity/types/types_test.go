@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -229,3 +230,29 @@ func shuffled(v InternalBridgeValidators) InternalBridgeValidators {
 	})
 	return v
 }
+
+func TestGravityDenomMetadata(t *testing.T) {
+	token, err := NewEthAddress("0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e")
+	require.NoError(t, err)
+	denom := GravityDenom(*token)
+	// a valid symbol becomes the display unit, with the decimals as exponent
+	metadata := GravityDenomMetadata(*token, "Yearn", "YFI", 18)
+	require.NoError(t, metadata.Validate())
+	assert.Equal(t, "YFI", metadata.Display)
+	require.Len(t, metadata.DenomUnits, 2)
+	assert.Equal(t, denom, metadata.DenomUnits[0].Denom)
+	assert.Equal(t, banktypes.DenomUnit{Denom: "YFI", Exponent: 18}, *metadata.DenomUnits[1])
+
+	// otherwise the base unit is displayed
+	for _, symbol := range []string{"Y", "Yearn Finance", "ÿfi", "", denom} {
+		metadata := GravityDenomMetadata(*token, "", symbol, 18)
+		require.NoError(t, metadata.Validate(), symbol)
+		assert.Equal(t, denom, metadata.Display)
+		require.Len(t, metadata.DenomUnits, 1)
+		assert.Equal(t, denom, metadata.DenomUnits[0].Denom)
+	}
+	metadata = GravityDenomMetadata(*token, "Yearn", "YFI", 0)
+	require.NoError(t, metadata.Validate())
+	assert.Equal(t, denom, metadata.Display)
+	require.Len(t, metadata.DenomUnits, 1)
+}
@@ -15,6 +15,7 @@ use gravity_proto::cosmos_sdk_proto::cosmos::params::v1beta1::ParameterChangePro
 use gravity_proto::cosmos_sdk_proto::cosmos::upgrade::v1beta1::SoftwareUpgradeProposal;
 use gravity_proto::gravity::AirdropProposal as AirdropProposalMsg;
 use gravity_proto::gravity::IbcMetadataProposal;
+use gravity_proto::gravity::RequestErc20DeploymentProposal;
 use gravity_proto::gravity::UnhaltBridgeProposal;
 use serde::Deserialize;
 use serde::Serialize;
@@ -25,6 +26,8 @@ use std::time::Duration;
 pub const AIRDROP_PROPOSAL_TYPE_URL: &str = "/gravity.v1.AirdropProposal";
 pub const UNHALT_BRIDGE_PROPOSAL_TYPE_URL: &str = "/gravity.v1.UnhaltBridgeProposal";
 pub const IBC_METADATA_PROPOSAL_TYPE_URL: &str = "/gravity.v1.IBCMetadataProposal";
+pub const REQUEST_ERC20_DEPLOYMENT_PROPOSAL_TYPE_URL: &str =
+    "/gravity.v1.RequestERC20DeploymentProposal";
 
 // cosmos-sdk proposals
 pub const PARAMETER_CHANGE_PROPOSAL_TYPE_URL: &str =
@@ -272,3 +275,41 @@ pub async fn submit_ibc_metadata_proposal(
         .create_gov_proposal(any, deposit, fee, key, wait_timeout)
         .await
 }
+
+/// The proposal.json representation for requesting the deployment of an ERC20 representing
+/// a Cosmos originated denom
+#[derive(Serialize, Deserialize, Debug, Clone)]
+pub struct RequestErc20DeploymentProposalJson {
+    pub title: String,
+    pub description: String,
+    pub denom: String,
+}
+impl From<RequestErc20DeploymentProposalJson> for RequestErc20DeploymentProposal {
+    fn from(v: RequestErc20DeploymentProposalJson) -> Self {
+        RequestErc20DeploymentProposal {
+            title: v.title,
+            description: v.description,
+            denom: v.denom,
+        }
+    }
+}
+
+/// Encodes and submits a proposal approving the deployment of an ERC20 for a Cosmos originated
+/// denom, the chain only adopts an ERC20 deployed for a denom once such a proposal has passed
+pub async fn submit_request_erc20_deployment_proposal(
+    proposal: RequestErc20DeploymentProposal,
+    deposit: Coin,
+    fee: Coin,
+    contact: &Contact,
+    key: PrivateKey,
+    wait_timeout: Option<Duration>,
+) -> Result<TxResponse, CosmosGrpcError> {
+    // encode as a generic proposal
+    let any = encode_any(
+        proposal,
+        REQUEST_ERC20_DEPLOYMENT_PROPOSAL_TYPE_URL.to_string(),
+    );
+    contact
+        .create_gov_proposal(any, deposit, fee, key, wait_timeout)
+        .await
+}
@@ -155,7 +155,8 @@ pub struct EthToCosmosOpts {
 }
 
 /// Deploy an ERC20 representation of a Cosmos asset on the Ethereum chain
-/// this can only be run once for each time of Cosmos asset
+/// this can only be run once for each time of Cosmos asset, and only once a
+/// RequestErc20DeploymentProposal for the asset has passed (see gbt gov submit request-erc20-deployment)
 #[derive(Parser)]
 pub struct DeployErc20RepresentationOpts {
     /// (Optional) The Cosmos gRPC server that will be used to submit the transaction
@@ -258,6 +259,7 @@ pub enum GovSubmitSubcommand {
     Airdrop(AirdropProposalOpts),
     EmergencyBridgeHalt(EmergencyBridgeHaltProposalOpts),
     OracleUnhalt(OracleUnhaltProposalOpts),
+    RequestErc20Deployment(RequestErc20DeploymentProposalOpts),
 }
 
 #[derive(Parser)]
@@ -368,3 +370,25 @@ pub struct OracleUnhaltProposalOpts {
     #[clap(short, long, parse(try_from_str))]
     pub fees: Coin,
 }
+
+/// A Request ERC20 Deployment proposal approves the deployment of an ERC20 representing a Cosmos
+/// originated denom. The chain only adopts an ERC20 deployed with gbt client deploy-erc20-representation
+/// once this proposal has passed for the denom, the denom must already have metadata set
+#[derive(Parser)]
+pub struct RequestErc20DeploymentProposalOpts {
+    /// (Optional) The Cosmos gRPC server that will be used to submit the transaction
+    #[clap(long, default_value = "http://localhost:9090")]
+    pub cosmos_grpc: String,
+    /// The phrase for an address containing enough funds to submit the proposal.
+    #[clap(short, long, parse(try_from_str))]
+    pub cosmos_phrase: CosmosPrivateKey,
+    /// Path to the proposal.json
+    #[clap(short, long, parse(try_from_str))]
+    pub json: PathBuf,
+    /// The Cosmos Denom and amount to pay the governance proposal deposit
+    #[clap(short, long, parse(try_from_str))]
+    pub deposit: Coin,
+    /// The Cosmos Denom and amount to pay Cosmos chain fees
+    #[clap(short, long, parse(try_from_str))]
+    pub fees: Coin,
+}
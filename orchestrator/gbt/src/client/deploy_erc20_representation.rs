@@ -4,6 +4,7 @@ use cosmos_gravity::query::get_gravity_params;
 use ethereum_gravity::deploy_erc20::deploy_erc20;
 use gravity_proto::gravity::{
     MsgErc20DeployedClaim, QueryAttestationsRequest, QueryDenomToErc20Request,
+    QueryErc20DeploymentRequestsRequest,
 };
 use gravity_utils::connection_prep::{check_for_eth, create_rpc_connections};
 use prost::{bytes::BytesMut, Message};
@@ -59,6 +60,32 @@ pub async fn deploy_erc20_representation(
         exit(1);
     }
 
+    // the chain only adopts an ERC20 for a denom once governance has approved its deployment,
+    // deploying without an approved request would only waste gas
+    let res = grpc
+        .erc20_deployment_requests(QueryErc20DeploymentRequestsRequest {})
+        .await;
+    match res {
+        Ok(requests) => {
+            let requests = requests.into_inner();
+            if !requests.pending.iter().any(|r| r.denom == denom) {
+                error!(
+                    "Asset {} has no pending ERC20 deployment request, the chain will not adopt an ERC20 for it",
+                    denom
+                );
+                error!("A RequestErc20DeploymentProposal for this denom will need to pass before running this command, see gbt gov submit request-erc20-deployment");
+                exit(1);
+            }
+        }
+        Err(e) => {
+            error!(
+                "Unable to query ERC20 deployment requests, check grpc {:?}",
+                e
+            );
+            exit(1);
+        }
+    }
+
     let res = contact.get_denom_metadata(denom.clone()).await;
     match res {
         Ok(Some(metadata)) => {
@@ -1,11 +1,13 @@
 use crate::args::AirdropProposalOpts;
 use crate::args::EmergencyBridgeHaltProposalOpts;
 use crate::args::IbcMetadataProposalOpts;
+use crate::args::RequestErc20DeploymentProposalOpts;
 use crate::{args::OracleUnhaltProposalOpts, utils::TIMEOUT};
 use cosmos_gravity::proposals::AirdropProposalJsonUnparsed;
 use cosmos_gravity::proposals::{
     submit_airdrop_proposal, submit_ibc_metadata_proposal, submit_pause_bridge_proposal,
-    submit_unhalt_bridge_proposal, IbcMetadataProposalJson, PauseBridgeProposalJson,
+    submit_request_erc20_deployment_proposal, submit_unhalt_bridge_proposal,
+    IbcMetadataProposalJson, PauseBridgeProposalJson, RequestErc20DeploymentProposalJson,
     UnhaltBridgeProposalJson,
 };
 use gravity_utils::connection_prep::create_rpc_connections;
@@ -195,3 +197,52 @@ pub async fn submit_oracle_unhalt(opts: OracleUnhaltProposalOpts, prefix: String
         }
     }
 }
+
+pub async fn submit_request_erc20_deployment(
+    opts: RequestErc20DeploymentProposalOpts,
+    prefix: String,
+) {
+    let connections = create_rpc_connections(prefix, Some(opts.cosmos_grpc), None, TIMEOUT).await;
+    let contact = connections.contact.unwrap();
+
+    match fs::read_to_string(opts.json) {
+        Ok(file_contents) => {
+            let proposal: Result<RequestErc20DeploymentProposalJson, _> =
+                serde_json::from_str(&file_contents);
+            match proposal {
+                Ok(proposal_json) => {
+                    let res = submit_request_erc20_deployment_proposal(
+                        proposal_json.into(),
+                        opts.deposit,
+                        opts.fees,
+                        &contact,
+                        opts.cosmos_phrase,
+                        Some(TIMEOUT),
+                    )
+                    .await;
+                    match res {
+                        Ok(r) => info!("Successfully submitted proposal with txid {}", r.txhash),
+                        Err(e) => {
+                            error!("Failed to submit proposal with {:?}", e);
+                            exit(1);
+                        }
+                    }
+                }
+                Err(e) => {
+                    error!(
+                        "Failed to deserialize your proposal.json, check the contents! {:?}",
+                        e
+                    );
+                    exit(1);
+                }
+            }
+        }
+        Err(e) => {
+            error!(
+                "Failed to read your proposal.json check the file path! {:?}",
+                e
+            );
+            exit(1);
+        }
+    }
+}
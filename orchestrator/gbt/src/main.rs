@@ -16,6 +16,7 @@ use config::{get_home_dir, load_config};
 use env_logger::Env;
 use gov::proposals::{
     submit_airdrop, submit_emergency_bridge_halt, submit_ibc_metadata, submit_oracle_unhalt,
+    submit_request_erc20_deployment,
 };
 use gov::queries::query_airdrops;
 use keys::register_orchestrator_address::register_orchestrator_address;
@@ -94,6 +95,9 @@ async fn main() {
                 GovSubmitSubcommand::OracleUnhalt(opts) => {
                     submit_oracle_unhalt(opts, address_prefix).await
                 }
+                GovSubmitSubcommand::RequestErc20Deployment(opts) => {
+                    submit_request_erc20_deployment(opts, address_prefix).await
+                }
             },
             GovSubcommand::Query(query_opts) => match query_opts {
                 GovQuerySubcommand::Airdrop(opts) => query_airdrops(opts, address_prefix).await,
@@ -104,6 +104,33 @@ pub struct IbcMetadataProposal {
     #[prost(string, tag="4")]
     pub ibc_denom: ::prost::alloc::string::String,
 }
+/// RequestERC20DeploymentProposal whitelists a Cosmos originated denom for ERC20 deployment, once this
+/// proposal passes an ERC20DeployedClaim for the denom is accepted
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RequestErc20DeploymentProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub denom: ::prost::alloc::string::String,
+}
+/// ERC20DeploymentRequest records a governance approved request to deploy an ERC20 for a Cosmos originated
+/// denom, the request is pending until an ERC20DeployedClaim for the denom is observed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc20DeploymentRequest {
+    #[prost(string, tag="1")]
+    pub denom: ::prost::alloc::string::String,
+    /// the Cosmos block height at which governance approved the request
+    #[prost(uint64, tag="2")]
+    pub approved_height: u64,
+    /// the deployed ERC20, empty while the request is pending
+    #[prost(string, tag="3")]
+    pub erc20: ::prost::alloc::string::String,
+    /// the Cosmos block height at which the deployment was observed
+    #[prost(uint64, tag="4")]
+    pub deployed_height: u64,
+}
 /// PendingIbcAutoForward represents a SendToCosmos transaction with a foreign CosmosReceiver which will be added to the
 /// PendingIbcAutoForward queue in attestation_handler and sent over IBC on some submission of a MsgExecuteIbcAutoForwards
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -1469,6 +1496,18 @@ pub struct QueryDenomToErc20Response {
     #[prost(bool, tag="2")]
     pub cosmos_originated: bool,
 }
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryErc20DeploymentRequestsRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryErc20DeploymentRequestsResponse {
+    /// requests which are waiting for the ERC20 to be deployed
+    #[prost(message, repeated, tag="1")]
+    pub pending: ::prost::alloc::vec::Vec<Erc20DeploymentRequest>,
+    /// requests whose ERC20 has been deployed
+    #[prost(message, repeated, tag="2")]
+    pub completed: ::prost::alloc::vec::Vec<Erc20DeploymentRequest>,
+}
 /// QueryAttestationsRequest defines the request structure for getting recent
 /// attestations with optional query parameters. By default, a limited set of
 /// recent attestations will be returned, defined by 'limit'. These attestations
@@ -2017,6 +2056,28 @@ pub mod query_client {
             );
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn erc20_deployment_requests(
+            &mut self,
+            request: impl tonic::IntoRequest<super::QueryErc20DeploymentRequestsRequest>,
+        ) -> Result<
+                tonic::Response<super::QueryErc20DeploymentRequestsResponse>,
+                tonic::Status,
+            > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/gravity.v1.Query/ERC20DeploymentRequests",
+            );
+            self.inner.unary(request.into_request(), path, codec).await
+        }
         pub async fn get_delegate_key_by_validator(
             &mut self,
             request: impl tonic::IntoRequest<super::QueryDelegateKeysByValidatorAddress>,
@@ -1,5 +1,6 @@
 //! This is the happy path test for Cosmos to Ethereum asset transfers, meaning assets originated on Cosmos
 
+use crate::airdrop_proposal::wait_for_proposals_to_execute;
 use crate::utils::create_default_test_config;
 use crate::utils::footoken_metadata;
 use crate::utils::get_decimals;
@@ -8,12 +9,14 @@ use crate::utils::get_event_nonce_safe;
 use crate::utils::get_user_key;
 use crate::utils::send_one_eth;
 use crate::utils::start_orchestrators;
+use crate::utils::vote_yes_on_proposals;
 use crate::MINER_ADDRESS;
 use crate::MINER_PRIVATE_KEY;
 use crate::TOTAL_TIMEOUT;
-use crate::{get_fee, utils::ValidatorKeys};
+use crate::{get_deposit, get_fee, utils::ValidatorKeys};
 use clarity::Address as EthAddress;
 use clarity::Uint256;
+use cosmos_gravity::proposals::submit_request_erc20_deployment_proposal;
 use cosmos_gravity::send::send_to_eth;
 use deep_space::coin::Coin;
 use deep_space::Contact;
@@ -22,6 +25,7 @@ use ethereum_gravity::utils::get_valset_nonce;
 use gravity_proto::cosmos_sdk_proto::cosmos::bank::v1beta1::Metadata;
 use gravity_proto::gravity::{
     query_client::QueryClient as GravityQueryClient, QueryDenomToErc20Request,
+    RequestErc20DeploymentProposal,
 };
 use std::time::{Duration, Instant};
 use tokio::time::sleep as delay_for;
@@ -48,6 +52,8 @@ pub async fn happy_path_test_v2(
     let erc20_contract = deploy_cosmos_representing_erc20_and_check_adoption(
         gravity_address,
         web30,
+        contact,
+        &keys,
         Some(keys.clone()),
         &mut grpc_client,
         validator_out,
@@ -152,10 +158,14 @@ pub async fn happy_path_test_v2(
 
 /// This segment is broken out because it's used in two different tests
 /// once here where we verify that tokens bridge correctly and once in valset_rewards
-/// where we do a governance update to enable rewards
+/// where we do a governance update to enable rewards. The voters pass the proposal
+/// approving the deployment, without which the chain does not adopt the ERC20
+#[allow(clippy::too_many_arguments)]
 pub async fn deploy_cosmos_representing_erc20_and_check_adoption(
     gravity_address: EthAddress,
     web30: &Web3,
+    contact: &Contact,
+    voters: &[ValidatorKeys],
     keys: Option<Vec<ValidatorKeys>>,
     grpc_client: &mut GravityQueryClient<Channel>,
     validator_out: bool,
@@ -169,6 +179,9 @@ pub async fn deploy_cosmos_representing_erc20_and_check_adoption(
         .await
         .unwrap();
 
+    submit_and_pass_request_erc20_deployment_proposal(token_metadata.base.clone(), contact, voters)
+        .await;
+
     let cosmos_decimals = get_decimals(&token_metadata);
     deploy_erc20(
         token_metadata.base.clone(),
@@ -264,3 +277,28 @@ pub async fn deploy_cosmos_representing_erc20_and_check_adoption(
 
     erc20_contract
 }
+
+/// Submits and passes the proposal approving the deployment of an ERC20 for denom
+pub async fn submit_and_pass_request_erc20_deployment_proposal(
+    denom: String,
+    contact: &Contact,
+    keys: &[ValidatorKeys],
+) {
+    let proposal_content = RequestErc20DeploymentProposal {
+        title: format!("Proposal to deploy an ERC20 for {}", denom),
+        description: "ERC20 DEPLOYMENT!".to_string(),
+        denom,
+    };
+    let res = submit_request_erc20_deployment_proposal(
+        proposal_content,
+        get_deposit(),
+        get_fee(None),
+        contact,
+        keys[0].validator_key,
+        Some(TOTAL_TIMEOUT),
+    )
+    .await;
+    vote_yes_on_proposals(contact, keys, None).await;
+    wait_for_proposals_to_execute(contact).await;
+    trace!("Gov proposal executed with {:?}", res);
+}
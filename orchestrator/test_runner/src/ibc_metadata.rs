@@ -115,7 +115,9 @@ pub async fn ibc_metadata_proposal_test(
     deploy_cosmos_representing_erc20_and_check_adoption(
         gravity_address,
         web30,
-        Some(keys),
+        contact,
+        &keys,
+        Some(keys.clone()),
         &mut grpc_client,
         false,
         found,
@@ -111,6 +111,8 @@ pub async fn invalid_events(
     let _ = deploy_cosmos_representing_erc20_and_check_adoption(
         gravity_address,
         web30,
+        contact,
+        &keys,
         None,
         &mut grpc_client,
         false,
@@ -31,6 +31,8 @@ pub async fn valset_rewards_test(
     let erc20_contract = deploy_cosmos_representing_erc20_and_check_adoption(
         gravity_address,
         web30,
+        contact,
+        &keys,
         Some(keys.clone()),
         &mut grpc_client,
         false,